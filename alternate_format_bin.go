@@ -0,0 +1,14 @@
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+var _alternateFormatData atomic.Value
+
+func getAlternateFormatData() string {
+	if _alternateFormatData.Load() == nil {
+		_alternateFormatData.Store("H4sIAAAAAAAA/wMAAAAAAAAAAAA=")
+	}
+	return _alternateFormatData.Load().(string)
+}
\ No newline at end of file
@@ -0,0 +1,685 @@
+package phonenumbers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ----------------------------------------------------------------------------
+// Golang port of:
+// https://github.com/googlei18n/libphonenumber/blob/master/java/libphonenumber/src/com/google/i18n/phonenumbers/AsYouTypeFormatter.java
+// ----------------------------------------------------------------------------
+
+const (
+	// Character used when appropriate to separate a prefix, such as a
+	// long NDD or a country calling code, from the national number.
+	SEPARATOR_BEFORE_NATIONAL_NUMBER = ' '
+
+	// The minimum length of national number accrued that is required to
+	// trigger the formatter. The first element of the leadingDigitsPattern
+	// of each numberFormat contains a regular expression that matches up
+	// to this number of digits.
+	MIN_LEADING_DIGITS_LENGTH = 3
+
+	// The digits that have not been entered yet will be represented by a
+	// \u2008, the punctuation space.
+	DIGIT_PLACEHOLDER = "\u2008"
+)
+
+var (
+	// The metadata used when a region has no metadata of its own.
+	EMPTY_METADATA = &PhoneMetadata{
+		Id:                  &[]string{"<ignored>"}[0],
+		InternationalPrefix: &[]string{"NA"}[0],
+	}
+
+	// A pattern that is used to determine if a numberFormat under
+	// availableFormats is eligible to be used by the AYTF. It is eligible
+	// when the format element under numberFormat contains groups of the
+	// dollar sign followed by a single digit, separated by valid phone
+	// number punctuation. This prevents invalid punctuation (such as the
+	// star sign in Israeli star numbers) getting into the output of the
+	// AYTF. We require that the first group is present in the output
+	// pattern to ensure no data is lost while formatting; when we format
+	// as you type, this should always be the case.
+	ELIGIBLE_FORMAT_PATTERN = regexp.MustCompile(
+		"^[" + VALID_PUNCTUATION + "]*" +
+			"\\$1" + "[" + VALID_PUNCTUATION + "]*(\\$\\d" +
+			"[" + VALID_PUNCTUATION + "]*)*$")
+
+	// A set of characters that, if found in a national prefix formatting
+	// rule, are an indicator to us that we should separate the national
+	// prefix from the number when formatting.
+	NATIONAL_PREFIX_SEPARATORS_PATTERN = regexp.MustCompile("[- ]")
+)
+
+// AsYouTypeFormatter formats phone numbers on-the-fly as users enter
+// each digit. It is created for the region the number is being entered
+// in and can be reused for other numbers by calling Clear.
+//
+//	formatter := NewAsYouTypeFormatter("US")
+//	for _, digit := range "6502530000" {
+//		output = formatter.InputDigit(digit)
+//	}
+//	// output is "(650) 253-0000"
+//
+// An AsYouTypeFormatter is not safe for concurrent use.
+type AsYouTypeFormatter struct {
+	currentOutput                 string
+	formattingTemplate            string
+	currentFormattingPattern      string
+	accruedInput                  *Builder
+	accruedInputWithoutFormatting *Builder
+	// This indicates whether AsYouTypeFormatter is currently doing the
+	// formatting.
+	ableToFormat bool
+	// Set to true when users enter their own formatting. AsYouTypeFormatter
+	// will do no formatting at all when this is set to true.
+	inputHasFormatting bool
+	// This is set to true when we know the user is entering a full
+	// national significant number, since we have either detected a
+	// national prefix or an international dialing prefix. When this is
+	// true, we will no longer use local number formatting patterns.
+	isCompleteNumber              bool
+	isExpectingCountryCallingCode bool
+	defaultCountry                string
+
+	// the metadata the formatter was created with
+	metadata *Metadata
+
+	defaultMetadata *PhoneMetadata
+	currentMetadata *PhoneMetadata
+
+	lastMatchPosition int
+	// The position of a digit upon which InputDigitAndRememberPosition
+	// is most recently invoked, as found in the original sequence of
+	// characters the user entered.
+	originalPosition int
+	// The position of a digit upon which InputDigitAndRememberPosition
+	// is most recently invoked, as found in accruedInputWithoutFormatting.
+	positionToRemember int
+	// This contains anything that has been entered so far preceding the
+	// national significant number, and it is formatted (e.g. with space
+	// inserted). For example, this can contain IDD, country code, and/or
+	// NDD, etc.
+	prefixBeforeNationalNumber        *Builder
+	shouldAddSpaceAfterNationalPrefix bool
+	// This contains the national prefix that has been extracted. It
+	// contains only digits without formatting.
+	extractedNationalPrefix string
+	nationalNumber          *Builder
+	possibleFormats         []*NumberFormat
+}
+
+// NewAsYouTypeFormatter creates an AsYouTypeFormatter for the region
+// the number is being entered in. This is the region used to decide
+// which international dialing prefix and national prefix to look for.
+func NewAsYouTypeFormatter(regionCode string) *AsYouTypeFormatter {
+	return CurrentMetadata().NewAsYouTypeFormatter(regionCode)
+}
+
+// NewAsYouTypeFormatter is the same as the package level
+// NewAsYouTypeFormatter, using this metadata.
+func (md *Metadata) NewAsYouTypeFormatter(regionCode string) *AsYouTypeFormatter {
+	f := &AsYouTypeFormatter{
+		metadata:                      md,
+		accruedInput:                  NewBuilder(nil),
+		accruedInputWithoutFormatting: NewBuilder(nil),
+		prefixBeforeNationalNumber:    NewBuilder(nil),
+		nationalNumber:                NewBuilder(nil),
+		ableToFormat:                  true,
+		defaultCountry:                regionCode,
+	}
+	f.currentMetadata = f.getMetadataForRegion(regionCode)
+	f.defaultMetadata = f.currentMetadata
+	return f
+}
+
+// The metadata needed by this class is the same for all regions sharing
+// the same country calling code. Therefore, we return the metadata for
+// "main" region for this country calling code.
+func (f *AsYouTypeFormatter) getMetadataForRegion(regionCode string) *PhoneMetadata {
+	countryCallingCode := f.metadata.GetCountryCodeForRegion(regionCode)
+	mainCountry := f.metadata.GetRegionCodeForCountryCode(countryCallingCode)
+	metadata := f.metadata.getMetadataForRegion(mainCountry)
+	if metadata != nil {
+		return metadata
+	}
+	// Set to a default instance of the metadata. This allows us to
+	// function with an incorrect region code, even if formatting only
+	// works for numbers specified with "+".
+	return EMPTY_METADATA
+}
+
+// Returns true if a new template is created as opposed to reusing the
+// existing template.
+func (f *AsYouTypeFormatter) maybeCreateNewTemplate() bool {
+	// When there are multiple available formats, the formatter uses the
+	// first format where a formatting template could be created.
+	for len(f.possibleFormats) > 0 {
+		numberFormat := f.possibleFormats[0]
+		pattern := numberFormat.GetPattern()
+		if f.currentFormattingPattern == pattern {
+			return false
+		}
+		if f.createFormattingTemplate(numberFormat) {
+			f.currentFormattingPattern = pattern
+			f.shouldAddSpaceAfterNationalPrefix =
+				NATIONAL_PREFIX_SEPARATORS_PATTERN.MatchString(
+					numberFormat.GetNationalPrefixFormattingRule())
+			// With a new formatting template, the matched position using
+			// the old template needs to be reset.
+			f.lastMatchPosition = 0
+			return true
+		}
+		// Remove the current number format from possibleFormats.
+		f.possibleFormats = f.possibleFormats[1:]
+	}
+	f.ableToFormat = false
+	return false
+}
+
+func (f *AsYouTypeFormatter) getAvailableFormats(leadingDigits string) {
+	// First decide whether we should use international or national
+	// number rules.
+	isInternationalNumber := f.isCompleteNumber && len(f.extractedNationalPrefix) == 0
+	formatList := f.currentMetadata.GetNumberFormat()
+	if isInternationalNumber && len(f.currentMetadata.GetIntlNumberFormat()) > 0 {
+		formatList = f.currentMetadata.GetIntlNumberFormat()
+	}
+	for _, format := range formatList {
+		// Discard a few formats that we know are not relevant based on
+		// the presence of the national prefix.
+		if len(f.extractedNationalPrefix) > 0 &&
+			formattingRuleHasFirstGroupOnly(format.GetNationalPrefixFormattingRule()) &&
+			!format.GetNationalPrefixOptionalWhenFormatting() &&
+			format.DomesticCarrierCodeFormattingRule == nil {
+			// If it is a national number that had a national prefix, any
+			// rules that aren't valid with a national prefix should be
+			// excluded. A rule that has a carrier-code formatting rule is
+			// kept since the national prefix might actually be an extracted
+			// carrier code - we don't distinguish between these when
+			// extracting it in the AYTF.
+			continue
+		} else if len(f.extractedNationalPrefix) == 0 &&
+			!f.isCompleteNumber &&
+			!formattingRuleHasFirstGroupOnly(format.GetNationalPrefixFormattingRule()) &&
+			!format.GetNationalPrefixOptionalWhenFormatting() {
+			// This number was entered without a national prefix, and this
+			// formatting rule requires one, so we discard it.
+			continue
+		}
+		if ELIGIBLE_FORMAT_PATTERN.MatchString(format.GetFormat()) {
+			f.possibleFormats = append(f.possibleFormats, format)
+		}
+	}
+	f.narrowDownPossibleFormats(leadingDigits)
+}
+
+func (f *AsYouTypeFormatter) narrowDownPossibleFormats(leadingDigits string) {
+	indexOfLeadingDigitsPattern := len(leadingDigits) - MIN_LEADING_DIGITS_LENGTH
+	if indexOfLeadingDigitsPattern < 0 {
+		indexOfLeadingDigitsPattern = 0
+	}
+	remaining := f.possibleFormats[:0]
+	for _, format := range f.possibleFormats {
+		patterns := format.GetLeadingDigitsPattern()
+		if len(patterns) == 0 {
+			// Keep everything that isn't restricted by leading digits.
+			remaining = append(remaining, format)
+			continue
+		}
+		lastLeadingDigitsPattern := indexOfLeadingDigitsPattern
+		if len(patterns)-1 < lastLeadingDigitsPattern {
+			lastLeadingDigitsPattern = len(patterns) - 1
+		}
+		leadingDigitsPattern := regexFor("^(?:" + patterns[lastLeadingDigitsPattern] + ")")
+		if leadingDigitsPattern.MatchString(leadingDigits) {
+			remaining = append(remaining, format)
+		}
+	}
+	f.possibleFormats = remaining
+}
+
+func (f *AsYouTypeFormatter) createFormattingTemplate(format *NumberFormat) bool {
+	f.formattingTemplate = f.getFormattingTemplate(format.GetPattern(), format.GetFormat())
+	return len(f.formattingTemplate) > 0
+}
+
+// Gets a formatting template which can be used to efficiently format a
+// partial number where digits are added one by one.
+func (f *AsYouTypeFormatter) getFormattingTemplate(numberPattern, numberFormat string) string {
+	// Creates a phone number consisting only of the digit 9 that matches
+	// the numberPattern by applying the pattern to the longestPhoneNumber
+	// string.
+	longestPhoneNumber := "999999999999999"
+	aPhoneNumber := regexFor(numberPattern).FindString(longestPhoneNumber)
+	// No formatting template can be created if the number of digits
+	// entered so far is longer than the maximum the current formatting
+	// rule can accommodate.
+	if len(aPhoneNumber) < f.nationalNumber.Len() {
+		return ""
+	}
+	// Formats the number according to numberFormat
+	template := regexFor(numberPattern).ReplaceAllString(aPhoneNumber, numberFormat)
+	// Replaces each digit with character DIGIT_PLACEHOLDER
+	template = strings.Replace(template, "9", DIGIT_PLACEHOLDER, -1)
+	return template
+}
+
+// Clear clears the internal state of the formatter, so it can be reused.
+func (f *AsYouTypeFormatter) Clear() {
+	f.currentOutput = ""
+	f.accruedInput.Reset()
+	f.accruedInputWithoutFormatting.Reset()
+	f.formattingTemplate = ""
+	f.lastMatchPosition = 0
+	f.currentFormattingPattern = ""
+	f.prefixBeforeNationalNumber.Reset()
+	f.extractedNationalPrefix = ""
+	f.nationalNumber.Reset()
+	f.ableToFormat = true
+	f.inputHasFormatting = false
+	f.positionToRemember = 0
+	f.originalPosition = 0
+	f.isCompleteNumber = false
+	f.isExpectingCountryCallingCode = false
+	f.possibleFormats = nil
+	f.shouldAddSpaceAfterNationalPrefix = false
+	if f.currentMetadata != f.defaultMetadata {
+		f.currentMetadata = f.getMetadataForRegion(f.defaultCountry)
+	}
+}
+
+// InputDigit formats a phone number on-the-fly as each digit is entered.
+// nextChar is the most recently entered digit of a phone number.
+// Formatting characters are allowed, but as soon as they are encountered
+// this method formats the number as entered and not "as you type"
+// anymore. Full width digits and Arabic-indic digits are allowed, and
+// will be shown as they are. The partially formatted phone number is
+// returned.
+func (f *AsYouTypeFormatter) InputDigit(nextChar rune) string {
+	f.currentOutput = f.inputDigitWithOptionToRememberPosition(nextChar, false)
+	return f.currentOutput
+}
+
+// InputDigitAndRememberPosition is the same as InputDigit, but remembers
+// the position where nextChar is inserted, so that it can be retrieved
+// later by using GetRememberedPosition. The remembered position will be
+// automatically adjusted if additional formatting characters are later
+// inserted/removed in front of nextChar.
+func (f *AsYouTypeFormatter) InputDigitAndRememberPosition(nextChar rune) string {
+	f.currentOutput = f.inputDigitWithOptionToRememberPosition(nextChar, true)
+	return f.currentOutput
+}
+
+func (f *AsYouTypeFormatter) inputDigitWithOptionToRememberPosition(nextChar rune, rememberPosition bool) string {
+	f.accruedInput.WriteRune(nextChar)
+	if rememberPosition {
+		f.originalPosition = utf8.RuneCount(f.accruedInput.Bytes())
+	}
+	// We do formatting on-the-fly only when each character entered is
+	// either a digit, or a plus sign (accepted at the start of the number
+	// only).
+	if !f.isDigitOrLeadingPlusSign(nextChar) {
+		f.ableToFormat = false
+		f.inputHasFormatting = true
+	} else {
+		nextChar = f.normalizeAndAccrueDigitsAndPlusSign(nextChar, rememberPosition)
+	}
+	if !f.ableToFormat {
+		// When we are unable to format because of reasons other than
+		// that formatting chars have been entered, it can be due to
+		// really long IDDs or NDDs. If that is the case, we might be able
+		// to do formatting again after extracting them.
+		if f.inputHasFormatting {
+			return f.accruedInput.String()
+		} else if f.attemptToExtractIdd() {
+			if f.attemptToExtractCountryCallingCode() {
+				return f.attemptToChoosePatternWithPrefixExtracted()
+			}
+		} else if f.ableToExtractLongerNdd() {
+			// Add an additional space to separate long NDD and national
+			// significant number for readability. We don't set
+			// shouldAddSpaceAfterNationalPrefix to true, since we don't
+			// want this to change later when we choose formatting templates.
+			f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+			return f.attemptToChoosePatternWithPrefixExtracted()
+		}
+		return f.accruedInput.String()
+	}
+
+	// We start to attempt to format only when at least
+	// MIN_LEADING_DIGITS_LENGTH digits (the plus sign is counted as a
+	// digit as well for this purpose) have been entered.
+	switch f.accruedInputWithoutFormatting.Len() {
+	case 0, 1, 2:
+		return f.accruedInput.String()
+	case 3:
+		if !f.attemptToExtractIdd() {
+			// No IDD or plus sign is found, might be entering in national format.
+			f.extractedNationalPrefix = f.removeNationalPrefixFromNationalNumber()
+			return f.attemptToChooseFormattingPattern()
+		}
+		f.isExpectingCountryCallingCode = true
+	}
+
+	if f.isExpectingCountryCallingCode {
+		if f.attemptToExtractCountryCallingCode() {
+			f.isExpectingCountryCallingCode = false
+		}
+		return f.prefixBeforeNationalNumber.String() + f.nationalNumber.String()
+	}
+	if len(f.possibleFormats) == 0 {
+		return f.attemptToChooseFormattingPattern()
+	}
+
+	// The formatting patterns are already chosen.
+	tempNationalNumber := f.inputDigitHelper(nextChar)
+	// See if the accrued digits can be formatted properly already. If
+	// not, use the results from inputDigitHelper, which does formatting
+	// based on the formatting pattern chosen.
+	formattedNumber := f.attemptToFormatAccruedDigits()
+	if len(formattedNumber) > 0 {
+		return formattedNumber
+	}
+	f.narrowDownPossibleFormats(f.nationalNumber.String())
+	if f.maybeCreateNewTemplate() {
+		return f.inputAccruedNationalNumber()
+	}
+	if f.ableToFormat {
+		return f.appendNationalNumber(tempNationalNumber)
+	}
+	return f.accruedInput.String()
+}
+
+func (f *AsYouTypeFormatter) attemptToChoosePatternWithPrefixExtracted() string {
+	f.ableToFormat = true
+	f.isExpectingCountryCallingCode = false
+	f.possibleFormats = nil
+	f.lastMatchPosition = 0
+	f.formattingTemplate = ""
+	f.currentFormattingPattern = ""
+	return f.attemptToChooseFormattingPattern()
+}
+
+// Some national prefixes are a substring of others. If extracting the
+// shorter NDD doesn't result in a number we can format, we try to see if
+// we can extract a longer version here.
+func (f *AsYouTypeFormatter) ableToExtractLongerNdd() bool {
+	if len(f.extractedNationalPrefix) > 0 {
+		// Put the extracted NDD back to the national number before
+		// attempting to extract a new NDD.
+		f.nationalNumber.InsertString(0, f.extractedNationalPrefix)
+		// Remove the previously extracted NDD from
+		// prefixBeforeNationalNumber. We cannot simply set it to empty
+		// string because people sometimes incorrectly enter national
+		// prefix after the country code, e.g. +44 (0)20-1234-5678.
+		indexOfPreviousNdd := strings.LastIndex(
+			f.prefixBeforeNationalNumber.String(), f.extractedNationalPrefix)
+		if indexOfPreviousNdd >= 0 {
+			f.prefixBeforeNationalNumber.Truncate(indexOfPreviousNdd)
+		}
+	}
+	return f.extractedNationalPrefix != f.removeNationalPrefixFromNationalNumber()
+}
+
+func (f *AsYouTypeFormatter) isDigitOrLeadingPlusSign(nextChar rune) bool {
+	return unicode.IsDigit(nextChar) ||
+		(utf8.RuneCount(f.accruedInput.Bytes()) == 1 &&
+			PLUS_CHARS_PATTERN.MatchString(string(nextChar)))
+}
+
+// Checks to see if there is an exact pattern match for these digits. If
+// so, we should use this instead of any other formatting template whose
+// leadingDigitsPattern also matches the input.
+func (f *AsYouTypeFormatter) attemptToFormatAccruedDigits() string {
+	nationalNumber := f.nationalNumber.String()
+	for _, numberFormat := range f.possibleFormats {
+		m := regexFor("^(?:" + numberFormat.GetPattern() + ")$")
+		if m.MatchString(nationalNumber) {
+			f.shouldAddSpaceAfterNationalPrefix =
+				NATIONAL_PREFIX_SEPARATORS_PATTERN.MatchString(
+					numberFormat.GetNationalPrefixFormattingRule())
+			formattedNumber := m.ReplaceAllString(nationalNumber, numberFormat.GetFormat())
+			// Check that we did not remove nor add any extra digits when
+			// we matched this formatting pattern. This usually happens
+			// after we entered the last digit during AYTF. Eg: In case of
+			// MX, we swallow mobile token (1) when formatted but AYTF should
+			// retain all the number entered and not change in order to
+			// match a format (of same leading digits and length) display
+			// in that way.
+			fullOutput := f.appendNationalNumber(formattedNumber)
+			formattedNumberDigitsOnly := normalizeDiallableCharsOnly(fullOutput)
+			if formattedNumberDigitsOnly == f.accruedInputWithoutFormatting.String() {
+				// If it's the same (i.e entered number and format is
+				// same), then it's safe to return this in formatted number
+				// as nothing is lost / added.
+				return fullOutput
+			}
+		}
+	}
+	return ""
+}
+
+// GetRememberedPosition returns the current position in the partially
+// formatted phone number of the character which was previously passed
+// in as the parameter of InputDigitAndRememberPosition. The position is
+// counted in runes.
+func (f *AsYouTypeFormatter) GetRememberedPosition() int {
+	if !f.ableToFormat {
+		return f.originalPosition
+	}
+	accruedInputWithoutFormatting := f.accruedInputWithoutFormatting.Bytes()
+	currentOutput := []rune(f.currentOutput)
+	accruedInputIndex, currentOutputIndex := 0, 0
+	for accruedInputIndex < f.positionToRemember && currentOutputIndex < len(currentOutput) {
+		if rune(accruedInputWithoutFormatting[accruedInputIndex]) == currentOutput[currentOutputIndex] {
+			accruedInputIndex++
+		}
+		currentOutputIndex++
+	}
+	return currentOutputIndex
+}
+
+// Combines the national number with any prefix (IDD/+ and country code
+// or national prefix) that was collected. A space will be inserted
+// between them if the current formatting template indicates this to be
+// suitable.
+func (f *AsYouTypeFormatter) appendNationalNumber(nationalNumber string) string {
+	prefixBeforeNationalNumber := f.prefixBeforeNationalNumber.String()
+	prefixBeforeNationalNumberLength := len(prefixBeforeNationalNumber)
+	if f.shouldAddSpaceAfterNationalPrefix && prefixBeforeNationalNumberLength > 0 &&
+		prefixBeforeNationalNumber[prefixBeforeNationalNumberLength-1] != SEPARATOR_BEFORE_NATIONAL_NUMBER {
+		// We want to add a space after the national prefix if the
+		// national prefix formatting rule indicates that this would
+		// normally be done, with the exception of the case where we
+		// already appended a space because the NDD was surprisingly long.
+		return prefixBeforeNationalNumber + string(SEPARATOR_BEFORE_NATIONAL_NUMBER) + nationalNumber
+	}
+	return prefixBeforeNationalNumber + nationalNumber
+}
+
+// Attempts to set the formatting template and returns a string which
+// contains the formatted version of the digits entered so far.
+func (f *AsYouTypeFormatter) attemptToChooseFormattingPattern() string {
+	// We start to attempt to format only when at least
+	// MIN_LEADING_DIGITS_LENGTH digits of national number (excluding
+	// national prefix) have been entered.
+	if f.nationalNumber.Len() < MIN_LEADING_DIGITS_LENGTH {
+		return f.appendNationalNumber(f.nationalNumber.String())
+	}
+
+	f.getAvailableFormats(f.nationalNumber.String())
+	// See if the accrued digits can be formatted properly already.
+	formattedNumber := f.attemptToFormatAccruedDigits()
+	if len(formattedNumber) > 0 {
+		return formattedNumber
+	}
+	if f.maybeCreateNewTemplate() {
+		return f.inputAccruedNationalNumber()
+	}
+	return f.accruedInput.String()
+}
+
+// Invokes inputDigitHelper on each digit of the national number
+// accrued, and returns a formatted string in the end.
+func (f *AsYouTypeFormatter) inputAccruedNationalNumber() string {
+	nationalNumber := f.nationalNumber.String()
+	if len(nationalNumber) == 0 {
+		return f.prefixBeforeNationalNumber.String()
+	}
+	tempNationalNumber := ""
+	for _, digit := range nationalNumber {
+		tempNationalNumber = f.inputDigitHelper(digit)
+	}
+	if f.ableToFormat {
+		return f.appendNationalNumber(tempNationalNumber)
+	}
+	return f.accruedInput.String()
+}
+
+// Returns true if the current country is a NANPA country and the
+// national number begins with the national prefix.
+func (f *AsYouTypeFormatter) isNanpaNumberWithNationalPrefix() bool {
+	// For NANPA numbers beginning with 1[2-9], treat the 1 as the
+	// national prefix. The reason is that national significant numbers
+	// in NANPA always start with [2-9] after the national prefix. Numbers
+	// beginning with 1[01] can only be short/emergency numbers, which
+	// don't need the national prefix.
+	nationalNumber := f.nationalNumber.String()
+	return f.currentMetadata.GetCountryCode() == NANPA_COUNTRY_CODE &&
+		len(nationalNumber) > 1 && nationalNumber[0] == '1' &&
+		nationalNumber[1] != '0' && nationalNumber[1] != '1'
+}
+
+// Returns the national prefix extracted, or an empty string if it is
+// not present.
+func (f *AsYouTypeFormatter) removeNationalPrefixFromNationalNumber() string {
+	nationalNumber := f.nationalNumber.String()
+	startOfNationalNumber := 0
+	if f.isNanpaNumberWithNationalPrefix() {
+		startOfNationalNumber = 1
+		f.prefixBeforeNationalNumber.WriteString("1")
+		f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+		f.isCompleteNumber = true
+	} else if f.currentMetadata.NationalPrefixForParsing != nil {
+		nationalPrefixForParsing := regexFor("^(?:" + f.currentMetadata.GetNationalPrefixForParsing() + ")")
+		// Since some national prefix patterns are entirely optional,
+		// check that a national prefix could actually be extracted.
+		loc := nationalPrefixForParsing.FindStringIndex(nationalNumber)
+		if loc != nil && loc[1] > 0 {
+			// When the national prefix is detected, we use international
+			// formatting rules instead of national ones, because national
+			// formatting rules could contain local formatting rules for
+			// numbers entered without area code.
+			f.isCompleteNumber = true
+			startOfNationalNumber = loc[1]
+			f.prefixBeforeNationalNumber.WriteString(nationalNumber[:startOfNationalNumber])
+		}
+	}
+	f.nationalNumber.ResetWithString(nationalNumber[startOfNationalNumber:])
+	return nationalNumber[:startOfNationalNumber]
+}
+
+// Extracts IDD and plus sign to prefixBeforeNationalNumber when they are
+// available, and places the remaining input into nationalNumber. Returns
+// true when accruedInputWithoutFormatting begins with the plus sign or
+// valid IDD for defaultCountry.
+func (f *AsYouTypeFormatter) attemptToExtractIdd() bool {
+	internationalPrefix := regexFor("^(?:\\" + string(PLUS_SIGN) + "|" +
+		f.currentMetadata.GetInternationalPrefix() + ")")
+	accruedInputWithoutFormatting := f.accruedInputWithoutFormatting.String()
+	loc := internationalPrefix.FindStringIndex(accruedInputWithoutFormatting)
+	if loc == nil {
+		return false
+	}
+	f.isCompleteNumber = true
+	startOfCountryCallingCode := loc[1]
+	f.nationalNumber.ResetWithString(accruedInputWithoutFormatting[startOfCountryCallingCode:])
+	f.prefixBeforeNationalNumber.ResetWithString(accruedInputWithoutFormatting[:startOfCountryCallingCode])
+	if accruedInputWithoutFormatting[0] != PLUS_SIGN {
+		f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+	}
+	return true
+}
+
+// Extracts the country calling code from the beginning of nationalNumber
+// to prefixBeforeNationalNumber when they are available, and places the
+// remaining input into nationalNumber. Returns true when a valid country
+// calling code can be found.
+func (f *AsYouTypeFormatter) attemptToExtractCountryCallingCode() bool {
+	if f.nationalNumber.Len() == 0 {
+		return false
+	}
+	numberWithoutCountryCallingCode := NewBuilder(nil)
+	countryCode := f.metadata.extractCountryCode(f.nationalNumber, numberWithoutCountryCallingCode)
+	if countryCode == 0 {
+		return false
+	}
+	f.nationalNumber.ResetWith(numberWithoutCountryCallingCode.Bytes())
+	newRegionCode := f.metadata.GetRegionCodeForCountryCode(countryCode)
+	if REGION_CODE_FOR_NON_GEO_ENTITY == newRegionCode {
+		f.currentMetadata = f.metadata.getMetadataForNonGeographicalRegion(countryCode)
+	} else if newRegionCode != f.defaultCountry {
+		f.currentMetadata = f.getMetadataForRegion(newRegionCode)
+	}
+	if f.currentMetadata == nil {
+		f.currentMetadata = EMPTY_METADATA
+	}
+	f.prefixBeforeNationalNumber.WriteString(strconv.Itoa(countryCode))
+	f.prefixBeforeNationalNumber.WriteRune(SEPARATOR_BEFORE_NATIONAL_NUMBER)
+	// When we have successfully extracted the IDD, the previously
+	// extracted NDD should be cleared because it is no longer valid.
+	f.extractedNationalPrefix = ""
+	return true
+}
+
+// Accrues digits and the plus sign to accruedInputWithoutFormatting for
+// later use. If nextChar contains a digit in non-ASCII format (e.g. the
+// full-width version of digits), it is first normalized to the ASCII
+// version. The return value is nextChar itself, or its normalized
+// version, if nextChar is a digit in non-ASCII format. This method
+// assumes its input is either a digit or the plus sign.
+func (f *AsYouTypeFormatter) normalizeAndAccrueDigitsAndPlusSign(nextChar rune, rememberPosition bool) rune {
+	var normalizedChar rune
+	if PLUS_CHARS_PATTERN.MatchString(string(nextChar)) {
+		normalizedChar = PLUS_SIGN
+		f.accruedInputWithoutFormatting.WriteRune(normalizedChar)
+	} else {
+		normalizedChar = []rune(NormalizeDigitsOnly(string(nextChar)))[0]
+		f.accruedInputWithoutFormatting.WriteRune(normalizedChar)
+		f.nationalNumber.WriteRune(normalizedChar)
+	}
+	if rememberPosition {
+		f.positionToRemember = f.accruedInputWithoutFormatting.Len()
+	}
+	return normalizedChar
+}
+
+func (f *AsYouTypeFormatter) inputDigitHelper(nextChar rune) string {
+	// Note that formattingTemplate is not guaranteed to have a value, it
+	// could be empty, e.g. when the next digit is entered after
+	// extracting an IDD or NDD.
+	index := -1
+	if f.lastMatchPosition <= len(f.formattingTemplate) {
+		index = strings.Index(f.formattingTemplate[f.lastMatchPosition:], DIGIT_PLACEHOLDER)
+	}
+	if index >= 0 {
+		f.lastMatchPosition += index
+		f.formattingTemplate = f.formattingTemplate[:f.lastMatchPosition] +
+			string(nextChar) +
+			f.formattingTemplate[f.lastMatchPosition+len(DIGIT_PLACEHOLDER):]
+		return f.formattingTemplate[:f.lastMatchPosition+utf8.RuneLen(nextChar)]
+	}
+	if len(f.possibleFormats) == 1 {
+		// More digits are entered than we could handle, and there are no
+		// other valid patterns to try.
+		f.ableToFormat = false
+	} // else, we just reset the formatting pattern.
+	f.currentFormattingPattern = ""
+	return f.accruedInput.String()
+}
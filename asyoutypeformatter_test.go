@@ -0,0 +1,126 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAsYouTypeFormatter(t *testing.T) {
+	var tests = []struct {
+		region string
+		input  string
+		output []string
+	}{
+		{
+			region: "US",
+			input:  "6502532222",
+			output: []string{"6", "65", "650", "650-2", "650-25", "650-253", "650-2532",
+				"(650) 253-22", "(650) 253-222", "(650) 253-2222"},
+		}, {
+			// national prefix for NANPA numbers
+			region: "US",
+			input:  "16502532222",
+			output: []string{"1", "16", "1 65", "1 (650", "1 (650) 2", "1 (650) 25", "1 (650) 253",
+				"1 (650) 253-2", "1 (650) 253-22", "1 (650) 253-222", "1 (650) 253-2222"},
+		}, {
+			region: "US",
+			input:  "+16502532222",
+			output: []string{"+", "+1", "+1 6", "+1 65", "+1 650", "+1 650-2", "+1 650-25", "+1 650-253",
+				"+1 650-253-2", "+1 650-253-22", "+1 650-253-222", "+1 650-253-2222"},
+		}, {
+			// IDD followed by a number in another region
+			region: "US",
+			input:  "011442070313000",
+			output: []string{"0", "01", "011 ", "011 4", "011 44 ", "011 44 2", "011 44 20", "011 44 20 7",
+				"011 44 20 70", "011 44 20 703", "011 44 20 7031", "011 44 20 7031 3", "011 44 20 7031 30",
+				"011 44 20 7031 300", "011 44 20 7031 3000"},
+		}, {
+			// national prefix extracted
+			region: "GB",
+			input:  "02070313000",
+			output: []string{"0", "02", "020", "020 7", "020 70", "020 703", "020 7031", "020 7031 3",
+				"020 7031 30", "020 7031 300", "020 7031 3000"},
+		}, {
+			region: "DE",
+			input:  "+4930123456",
+			output: []string{"+", "+4", "+49 ", "+49 3", "+49 30", "+49 30 1", "+49 30 12", "+49 30 123",
+				"+49 30 1234", "+49 30 12345", "+49 30 123456"},
+		}, {
+			// formatting entered by the user stops formatting
+			region: "US",
+			input:  "650-253",
+			output: []string{"6", "65", "650", "650-", "650-2", "650-25", "650-253"},
+		}, {
+			// full width digits are normalized but shown as entered until formatted
+			region: "US",
+			input:  "+４８８８１２３",
+			output: []string{"+", "+４", "+48 ", "+48 8", "+48 88", "+48 881", "+48 881 2", "+48 881 23"},
+		}, {
+			// unknown regions only format numbers entered with a plus sign
+			region: "ZZ",
+			input:  "+16502532222",
+			output: []string{"+", "+1", "+1 6", "+1 65", "+1 650", "+1 650-2", "+1 650-25", "+1 650-253",
+				"+1 650-253-2", "+1 650-253-22", "+1 650-253-222", "+1 650-253-2222"},
+		}, {
+			region: "ZZ",
+			input:  "6502532222",
+			output: []string{"6", "65", "650", "6502", "65025", "650253", "6502532",
+				"65025322", "650253222", "6502532222"},
+		},
+	}
+
+	for _, test := range tests {
+		formatter := NewAsYouTypeFormatter(test.region)
+		output := make([]string, 0, len(test.output))
+		for _, digit := range test.input {
+			output = append(output, formatter.InputDigit(digit))
+		}
+		if !reflect.DeepEqual(output, test.output) {
+			t.Errorf("[%s] %s: expected %q, got %q", test.region, test.input, test.output, output)
+		}
+	}
+}
+
+func TestAsYouTypeFormatterClear(t *testing.T) {
+	formatter := NewAsYouTypeFormatter("US")
+	for _, digit := range "+442070313000" {
+		formatter.InputDigit(digit)
+	}
+	formatter.Clear()
+
+	var output string
+	for _, digit := range "6502532222" {
+		output = formatter.InputDigit(digit)
+	}
+	if output != "(650) 253-2222" {
+		t.Errorf("expected (650) 253-2222 after clearing, got %s", output)
+	}
+}
+
+func TestAsYouTypeFormatterRememberedPosition(t *testing.T) {
+	formatter := NewAsYouTypeFormatter("US")
+	for i, digit := range "6502532222" {
+		if i == 3 {
+			formatter.InputDigitAndRememberPosition(digit)
+			if pos := formatter.GetRememberedPosition(); pos != 5 {
+				t.Errorf("expected remembered position 5, got %d", pos)
+			}
+		} else {
+			formatter.InputDigit(digit)
+		}
+	}
+	// the remembered digit has moved behind the parentheses and space
+	if pos := formatter.GetRememberedPosition(); pos != 7 {
+		t.Errorf("expected remembered position 7, got %d", pos)
+	}
+
+	// once the user enters formatting, positions are relative to the input
+	formatter.Clear()
+	for _, digit := range "650-" {
+		formatter.InputDigit(digit)
+	}
+	formatter.InputDigitAndRememberPosition('2')
+	if pos := formatter.GetRememberedPosition(); pos != 5 {
+		t.Errorf("expected remembered position 5, got %d", pos)
+	}
+}
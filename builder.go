@@ -33,13 +33,43 @@ func BuildPhoneMetadataCollection(inputXML []byte, liteBuild bool, specialBuild
 	metadata := &PhoneNumberMetadataE{}
 	err := xml.Unmarshal(inputXML, metadata)
 	if err != nil {
-		panic(fmt.Sprintf("Error unmarshalling XML: %s", err))
+		return nil, fmt.Errorf("error unmarshalling XML: %s", err)
 	}
 	isShortNumberMetadata := false
 	isAlternateFormatsMetadata := false
 	return buildPhoneMetadataFromElement(metadata, liteBuild, specialBuild, isShortNumberMetadata, isAlternateFormatsMetadata)
 }
 
+// BuildAlternateFormatsCollection builds the metadata collection for
+// PhoneNumberAlternateFormats.xml. The territories in that file are keyed by
+// country calling code only and carry nothing but number formats, which are
+// used when checking the grouping of numbers found in text.
+func BuildAlternateFormatsCollection(inputXML []byte) (*PhoneMetadataCollection, error) {
+	metadata := &PhoneNumberMetadataE{}
+	err := xml.Unmarshal(inputXML, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling XML: %s", err)
+	}
+	isShortNumberMetadata := false
+	isAlternateFormatsMetadata := true
+	return buildPhoneMetadataFromElement(metadata, false, false, isShortNumberMetadata, isAlternateFormatsMetadata)
+}
+
+// BuildShortNumberMetadataCollection builds the metadata collection for
+// ShortNumberMetadata.xml. Short number territories are keyed by region
+// code and carry the short code, emergency, cost and carrier specific
+// descriptions instead of the regular number types.
+func BuildShortNumberMetadataCollection(inputXML []byte) (*PhoneMetadataCollection, error) {
+	metadata := &PhoneNumberMetadataE{}
+	err := xml.Unmarshal(inputXML, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling XML: %s", err)
+	}
+	isShortNumberMetadata := true
+	isAlternateFormatsMetadata := false
+	return buildPhoneMetadataFromElement(metadata, false, false, isShortNumberMetadata, isAlternateFormatsMetadata)
+}
+
 func buildPhoneMetadataFromElement(document *PhoneNumberMetadataE, liteBuild bool, specialBuild bool, isShortNumberMetadata bool, isAlternateFormatsMetadata bool) (*PhoneMetadataCollection, error) {
 	collection := PhoneMetadataCollection{}
 	numOfTerritories := len(document.Territories)
@@ -564,6 +594,7 @@ func setRelevantDescPatterns(metadata *PhoneMetadata, element *TerritoryE, isSho
 		metadata.ShortCode = processPhoneNumberDescElement(generalDesc, element.ShortCode)
 		metadata.CarrierSpecific = processPhoneNumberDescElement(generalDesc, element.CarrierSpecific)
 		metadata.Emergency = processPhoneNumberDescElement(generalDesc, element.Emergency)
+		metadata.SmsServices = processPhoneNumberDescElement(generalDesc, element.SMSServices)
 		metadata.TollFree = processPhoneNumberDescElement(generalDesc, element.TollFree)
 		metadata.PremiumRate = processPhoneNumberDescElement(generalDesc, element.PremiumRate)
 	}
@@ -670,11 +701,14 @@ type TerritoryE struct {
 	// <!ELEMENT voicemail (nationalNumberPattern, possibleLengths, exampleNumber)>
 	ShortCode *PhoneNumberDescE `xml:"shortCode"`
 
-	// <!ELEMENT uan (nationalNumberPattern, possibleLengths, exampleNumber)>
-	Emergency *PhoneNumberDescE `xml:"Emergency"`
+	// <!ELEMENT emergency (nationalNumberPattern, possibleLengths, exampleNumber)>
+	Emergency *PhoneNumberDescE `xml:"emergency"`
 
-	// <!ELEMENT voicemail (nationalNumberPattern, possibleLengths, exampleNumber)>
+	// <!ELEMENT carrierSpecific (nationalNumberPattern, possibleLengths, exampleNumber)>
 	CarrierSpecific *PhoneNumberDescE `xml:"carrierSpecific"`
+
+	// <!ELEMENT smsServices (nationalNumberPattern, possibleLengths, exampleNumber)>
+	SMSServices *PhoneNumberDescE `xml:"smsServices"`
 }
 
 // <!ELEMENT numberFormat (leadingDigits*, format, intlFormat*)>
@@ -718,4 +752,8 @@ type PhoneNumberDescE struct {
 
 	// <!ELEMENT exampleNumber (#PCDATA)>
 	ExampleNumber string `xml:"exampleNumber"`
+
+	// Only used by metadata overrides, whether the pattern and possible
+	// lengths replace those of the type rather than being added to them.
+	Replace bool `xml:"replace,attr"`
 }
@@ -0,0 +1,58 @@
+package phonenumbers
+
+// GetNameForValidNumber returns the name of the carrier the passed in number
+// was originally allocated to, in the language of the passed in BCP 47
+// locale, without checking the number is valid. This is the same as
+// GetCarrierForNumber, for callers who have already validated the number.
+func GetNameForValidNumber(number *PhoneNumber, lang string) (string, error) {
+	return CurrentMetadata().GetNameForValidNumber(number, lang)
+}
+
+// GetNameForValidNumber is the same as the package level
+// GetNameForValidNumber, using this metadata.
+func (md *Metadata) GetNameForValidNumber(number *PhoneNumber, lang string) (string, error) {
+	return md.getValueForLocale(md.carrierPrefixMap, lang, 10, number)
+}
+
+// GetNameForNumber returns the name of the carrier the passed in number was
+// originally allocated to, as for GetNameForValidNumber, but only for
+// numbers of mobile types. Other numbers, including invalid ones, have no
+// carrier name.
+func GetNameForNumber(number *PhoneNumber, lang string) (string, error) {
+	return CurrentMetadata().GetNameForNumber(number, lang)
+}
+
+// GetNameForNumber is the same as the package level GetNameForNumber,
+// using this metadata.
+func (md *Metadata) GetNameForNumber(number *PhoneNumber, lang string) (string, error) {
+	if !isMobileNumberType(md.GetNumberType(number)) {
+		return "", nil
+	}
+	return md.GetNameForValidNumber(number, lang)
+}
+
+// GetSafeDisplayName returns the name of the carrier the passed in number
+// was originally allocated to, as for GetNameForNumber, but only when the
+// number is from a region without mobile number portability. In regions
+// with portability the number may have moved to another carrier, so the
+// name would be misleading and an empty string is returned instead.
+func GetSafeDisplayName(number *PhoneNumber, lang string) (string, error) {
+	return CurrentMetadata().GetSafeDisplayName(number, lang)
+}
+
+// GetSafeDisplayName is the same as the package level GetSafeDisplayName,
+// using this metadata.
+func (md *Metadata) GetSafeDisplayName(number *PhoneNumber, lang string) (string, error) {
+	if md.IsMobileNumberPortableRegion(md.GetRegionCodeForNumber(number)) {
+		return "", nil
+	}
+	return md.GetNameForNumber(number, lang)
+}
+
+// Returns whether numbers of the passed in type may be allocated to a
+// mobile carrier.
+func isMobileNumberType(numberType PhoneNumberType) bool {
+	return numberType == MOBILE ||
+		numberType == FIXED_LINE_OR_MOBILE ||
+		numberType == PAGER
+}
@@ -0,0 +1,47 @@
+package phonenumbers
+
+import (
+	"testing"
+)
+
+func TestGetSafeDisplayName(t *testing.T) {
+	if len(getCarrierMapData()) == 0 {
+		t.Skip("built without carrier data")
+	}
+
+	var tests = []struct {
+		number string
+		lang   string
+		valid  string
+		name   string
+		safe   string
+	}{
+		{"+8613702032331", "en", "China Mobile", "China Mobile", "China Mobile"},
+		{"+8613702032331", "zh", "中国移动", "中国移动", "中国移动"},
+		{"+380501234567", "en", "Vodafone", "Vodafone", "Vodafone"},
+		// numbers in regions with number portability may have moved carrier
+		{"+447912345678", "en", "O2", "O2", ""},
+		{"+61491570156", "en", "Telstra", "Telstra", ""},
+		// only mobile numbers have carriers
+		{"+86137020323", "en", "China Mobile", "", ""},
+		{"+3805012345678", "en", "Vodafone", "", ""},
+		{"+442070313000", "en", "", "", ""},
+	}
+
+	for i, test := range tests {
+		number, err := Parse(test.number, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if valid, err := GetNameForValidNumber(number, test.lang); err != nil || valid != test.valid {
+			t.Errorf("[test %d:valid] failed: %s != %s, %v\n", i, valid, test.valid, err)
+		}
+		if name, err := GetNameForNumber(number, test.lang); err != nil || name != test.name {
+			t.Errorf("[test %d:name] failed: %s != %s, %v\n", i, name, test.name, err)
+		}
+		if safe, err := GetSafeDisplayName(number, test.lang); err != nil || safe != test.safe {
+			t.Errorf("[test %d:safe] failed: %s != %s, %v\n", i, safe, test.safe, err)
+		}
+	}
+}
@@ -1,19 +1,18 @@
 package main
 
 import (
-	"bufio"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
+	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
 	"math"
 	"net/http"
 	"os"
-	"os/exec"
-	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -25,36 +24,57 @@ import (
 )
 
 type prefixBuild struct {
-	url     string
-	dir     string
-	srcPath string
-	varName string
+	dir      string
+	srcPath  string
+	varName  string
+	buildTag string
 }
 
 const (
-	metadataURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/PhoneNumberMetadata.xml"
-	metadataPath = "metadata_bin.go"
+	metadataResource = "PhoneNumberMetadata.xml"
+	metadataPath     = "metadata_bin.go"
 
-	tzURL  = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/timezones/map_data.txt"
-	tzPath = "prefix_to_timezone_bin.go"
-	tzVar  = "timezoneMapData"
+	alternateFormatsResource = "PhoneNumberAlternateFormats.xml"
+	alternateFormatsPath     = "alternate_format_bin.go"
+	alternateFormatsVar      = "alternateFormatData"
+
+	shortNumberResource = "ShortNumberMetadata.xml"
+	shortNumberPath     = "short_number_metadata_bin.go"
+	shortNumberVar      = "shortNumberMetadataData"
+
+	tzResource = "timezones/map_data.txt"
+	tzPath     = "prefix_to_timezone_bin.go"
+	tzVar      = "timezoneMapData"
+	tzTag      = "phonenumbers_notimezone"
 
 	regionPath = "countrycode_to_region_bin.go"
 	regionVar  = "regionMapData"
+
+	regionNamesURL  = "https://raw.githubusercontent.com/unicode-org/cldr-json/main/cldr-json/cldr-localenames-full/main/%s/territories.json"
+	regionNamesPath = "region_names_bin.go"
+	regionNamesVar  = "regionNameData"
+	regionNamesTag  = "phonenumbers_nogeocoding"
 )
 
+// CLDR locales for the languages whose geocoding files upstream names
+// after a legacy or Java style locale
+var cldrLocales = map[string]string{
+	"iw":      "he",
+	"zh_Hant": "zh-Hant",
+}
+
 var carrier = prefixBuild{
-	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/carrier",
-	dir:     "carrier",
-	srcPath: "prefix_to_carriers_bin.go",
-	varName: "carrierMapData",
+	dir:      "carrier",
+	srcPath:  "prefix_to_carriers_bin.go",
+	varName:  "carrierMapData",
+	buildTag: "phonenumbers_nocarrier",
 }
 
 var geocoding = prefixBuild{
-	url:     "https://github.com/googlei18n/libphonenumber/trunk/resources/geocoding",
-	dir:     "geocoding",
-	srcPath: "prefix_to_geocodings_bin.go",
-	varName: "geocodingMapData",
+	dir:      "geocoding",
+	srcPath:  "prefix_to_geocodings_bin.go",
+	varName:  "geocodingMapData",
+	buildTag: "phonenumbers_nogeocoding",
 }
 
 func fetchURL(url string) []byte {
@@ -71,45 +91,23 @@ func fetchURL(url string) []byte {
 	return body
 }
 
-func svnExport(dir string, url string) {
-	os.RemoveAll(dir)
-	cmd := exec.Command(
-		"/bin/bash",
-		"-c",
-		fmt.Sprintf("svn export %s --force", url),
-	)
+// where upstream resources are read from
+var source phonenumbers.MetadataSource
 
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		log.Fatal(err)
-	}
-	stderr, err := cmd.StderrPipe()
+func readResource(name string) []byte {
+	body, err := source.ReadFile(context.Background(), name)
 	if err != nil {
-		log.Fatal(err)
-	}
-	if err = cmd.Start(); err != nil {
-		log.Fatal(err)
-	}
-	data, err := ioutil.ReadAll(stderr)
-	if err != nil {
-		log.Fatal(err, string(data))
-	}
-	outputBuf := bufio.NewReader(stdout)
-
-	for {
-		output, _, err := outputBuf.ReadLine()
-		if err != nil {
-			if err != io.EOF {
-				log.Fatal(err)
-			}
-			break
-		}
-		log.Println(string(output))
+		log.Fatalf("Error reading '%s': %s", name, err)
 	}
+	return body
+}
 
-	if err = cmd.Wait(); err != nil {
-		log.Fatal(err)
+func readResourceDir(name string) []string {
+	entries, err := source.ReadDir(context.Background(), name)
+	if err != nil {
+		log.Fatalf("Error reading '%s': %s", name, err)
 	}
+	return entries
 }
 
 func writeFile(filePath string, data []byte) {
@@ -128,12 +126,12 @@ func writeFile(filePath string, data []byte) {
 func buildRegions(metadata *phonenumbers.PhoneMetadataCollection) {
 	log.Println("Building region map")
 	regionMap := phonenumbers.BuildCountryCodeToRegionMap(metadata)
-	writeIntStringArrayMap(regionPath, regionVar, regionMap)
+	writeIntStringArrayMap(regionPath, regionVar, "", regionMap)
 }
 
 func buildTimezones() {
 	log.Println("Building timezone map")
-	body := fetchURL(tzURL)
+	body := readResource(tzResource)
 
 	// build our map of prefix to timezones
 	prefixMap := make(map[int][]string)
@@ -165,10 +163,10 @@ func buildTimezones() {
 	}
 
 	// then write our file
-	writeIntStringArrayMap(tzPath, tzVar, prefixMap)
+	writeIntStringArrayMap(tzPath, tzVar, tzTag, prefixMap)
 }
 
-func writeIntStringArrayMap(path string, varName string, prefixMap map[int][]string) {
+func writeIntStringArrayMap(path string, varName string, buildTag string, prefixMap map[int][]string) {
 	// build lists of our keys and values
 	keys := make([]int, 0, len(prefixMap))
 	values := make([]string, 0, 255)
@@ -240,12 +238,12 @@ func writeIntStringArrayMap(path string, varName string, prefixMap map[int][]str
 	}
 
 	// then write our file
-	writeFile(path, generateBinFile(varName, data.Bytes()))
+	writeFile(path, append(buildConstraint(buildTag), generateBinFile(varName, data.Bytes())...))
 }
 
 func buildMetadata() *phonenumbers.PhoneMetadataCollection {
-	log.Println("Fetching PhoneNumberMetadata.xml from Github")
-	body := fetchURL(metadataURL)
+	log.Println("Reading PhoneNumberMetadata.xml")
+	body := readResource(metadataResource)
 
 	log.Println("Building new metadata collection")
 	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false)
@@ -264,6 +262,46 @@ func buildMetadata() *phonenumbers.PhoneMetadataCollection {
 	return collection
 }
 
+func buildShortNumberMetadata() {
+	log.Println("Reading ShortNumberMetadata.xml")
+	body := readResource(shortNumberResource)
+
+	log.Println("Building new short number metadata collection")
+	collection, err := phonenumbers.BuildShortNumberMetadataCollection(body)
+	if err != nil {
+		log.Fatalf("Error converting XML: %s", err)
+	}
+
+	// write it out as a protobuf
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		log.Fatalf("Error marshalling short number metadata: %v", err)
+	}
+
+	log.Println("Writing new short_number_metadata_bin.go")
+	writeFile(shortNumberPath, generateBinFile(shortNumberVar, data))
+}
+
+func buildAlternateFormats() {
+	log.Println("Reading PhoneNumberAlternateFormats.xml")
+	body := readResource(alternateFormatsResource)
+
+	log.Println("Building new alternate formats collection")
+	collection, err := phonenumbers.BuildAlternateFormatsCollection(body)
+	if err != nil {
+		log.Fatalf("Error converting XML: %s", err)
+	}
+
+	// write it out as a protobuf
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		log.Fatalf("Error marshalling alternate formats: %v", err)
+	}
+
+	log.Println("Writing new alternate_format_bin.go")
+	writeFile(alternateFormatsPath, generateBinFile(alternateFormatsVar, data))
+}
+
 // generates the file contents for a data file
 func generateBinFile(variableName string, data []byte) []byte {
 	var compressed bytes.Buffer
@@ -295,37 +333,42 @@ func get%s() string {
 	return output.Bytes()
 }
 
-func buildPrefixData(build *prefixBuild) {
-	log.Println("Fetching " + build.url + " from Github")
-	svnExport(build.dir, build.url)
+// Returns the constraint which leaves a data file out of builds with the
+// passed in tag, or nothing if there is no tag.
+func buildConstraint(buildTag string) []byte {
+	if buildTag == "" {
+		return nil
+	}
+	return []byte(fmt.Sprintf("//go:build !%s\n// +build !%s\n\n", buildTag, buildTag))
+}
+
+func buildPrefixData(build *prefixBuild) []string {
+	log.Println("Reading " + build.dir)
 
 	// get our top level language directories
-	dirs, err := filepath.Glob(fmt.Sprintf("%s/*", build.dir))
-	if err != nil {
-		log.Fatal(err)
-	}
+	entries := readResourceDir(build.dir)
 
 	// for each directory
 	languageMappings := make(map[string]map[int]string)
-	for _, dir := range dirs {
+	for _, entry := range entries {
 		// only look at directories
-		fi, _ := os.Stat(dir)
-		if !fi.IsDir() {
-			log.Printf("Ignoring directory: %s\n", dir)
+		if !strings.HasSuffix(entry, "/") {
+			log.Printf("Ignoring file: %s\n", entry)
 			continue
 		}
 
 		// get our language code
-		parts := strings.Split(dir, "/")
+		lang := strings.TrimSuffix(entry, "/")
 
 		// build a map for that directory
-		mappings := readMappingsForDir(dir)
+		mappings := readMappingsForDir(build.dir + "/" + lang)
 
 		// save it for our language
-		languageMappings[parts[1]] = mappings
+		languageMappings[lang] = mappings
 	}
 
 	output := bytes.Buffer{}
+	output.Write(buildConstraint(build.buildTag))
 	_, _ = fmt.Fprintf(&output, `package phonenumbers
 
 import (
@@ -372,6 +415,7 @@ func get%s() map[string]string {
 
 		// write our map
 		data := &bytes.Buffer{}
+		var err error
 
 		// first write our values, as length of string and raw bytes
 		joinedValues := strings.Join(values, "\n")
@@ -424,25 +468,103 @@ func get%s() map[string]string {
 }`, build.varName)
 
 	writeFile(build.srcPath, output.Bytes())
+
+	languages := make([]string, 0, len(languageMappings))
+	for lang := range languageMappings {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// buildRegionNames writes the CLDR names of the regions in our metadata
+// in each of the passed in languages
+func buildRegionNames(metadata *phonenumbers.PhoneMetadataCollection, languages []string) {
+	log.Println("Building region names")
+	regions := make([]string, 0, len(metadata.GetMetadata()))
+	for _, meta := range metadata.GetMetadata() {
+		if meta.GetId() != "001" {
+			regions = append(regions, meta.GetId())
+		}
+	}
+	sort.Strings(regions)
+
+	output := bytes.Buffer{}
+	output.Write(buildConstraint(regionNamesTag))
+	_, _ = fmt.Fprintf(&output, `package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+var _%s atomic.Value
+
+func get%s() map[string]string {
+	if _%s.Load() == nil {
+		_%s.Store(`, regionNamesVar, strings.Title(regionNamesVar), regionNamesVar, regionNamesVar)
+	output.WriteString("map[string]string {\n")
+
+	for _, lang := range languages {
+		locale, found := cldrLocales[lang]
+		if !found {
+			locale = lang
+		}
+		log.Printf("Fetching region names for: %s\n", locale)
+		body := fetchURL(fmt.Sprintf(regionNamesURL, locale))
+
+		var territories struct {
+			Main map[string]struct {
+				LocaleDisplayNames struct {
+					Territories map[string]string `json:"territories"`
+				} `json:"localeDisplayNames"`
+			} `json:"main"`
+		}
+		if err := json.Unmarshal(body, &territories); err != nil {
+			log.Fatalf("Invalid region names for %s: %s", locale, err)
+		}
+
+		// one line per region, a tab between the region code and its name
+		data := &bytes.Buffer{}
+		for _, names := range territories.Main {
+			for _, region := range regions {
+				if name, found := names.LocaleDisplayNames.Territories[region]; found {
+					data.WriteString(region)
+					data.WriteString("\t")
+					data.WriteString(name)
+					data.WriteString("\n")
+				}
+			}
+		}
+
+		var compressed bytes.Buffer
+		w := gzip.NewWriter(&compressed)
+		w.Write(data.Bytes())
+		w.Close()
+		c := base64.StdEncoding.EncodeToString(compressed.Bytes())
+		output.WriteString("\t")
+		output.WriteString(strconv.Quote(lang))
+		output.WriteString(": ")
+		output.WriteString(strconv.Quote(c))
+		output.WriteString(",\n")
+	}
+
+	_, _ = fmt.Fprintf(&output, `})
+	}
+	return _%s.Load().(map[string]string)
+}`, regionNamesVar)
+
+	writeFile(regionNamesPath, output.Bytes())
 }
 
 func readMappingsForDir(dir string) map[int]string {
 	log.Printf("Building map for: %s\n", dir)
 	mappings := make(map[int]string)
 
-	files, err := filepath.Glob(dir + "/*.txt")
-	if err != nil {
-		log.Fatal(err)
-	}
-	for _, file := range files {
-		body, err := ioutil.ReadFile(file)
-		if err != nil {
-			log.Fatal(err)
-		}
-		items := strings.Split(file, "/")
-		if len(items) != 3 {
-			log.Fatalf("file name %s not correct", file)
+	for _, file := range readResourceDir(dir) {
+		if !strings.HasSuffix(file, ".txt") {
+			continue
 		}
+		body := readResource(dir + "/" + file)
 
 		for _, line := range strings.Split(string(body), "\n") {
 			if strings.HasPrefix(line, "#") {
@@ -479,9 +601,21 @@ func readMappingsForDir(dir string) map[int]string {
 }
 
 func main() {
+	location := flag.String("source", phonenumbers.DEFAULT_METADATA_SOURCE, "libphonenumber checkout, resources directory, archive or mirror URL to read from")
+	flag.Parse()
+
+	var err error
+	source, err = phonenumbers.OpenMetadataSource(*location)
+	if err != nil {
+		log.Fatalf("Error opening source '%s': %s", *location, err)
+	}
+
 	metadata := buildMetadata()
 	buildRegions(metadata)
+	buildAlternateFormats()
+	buildShortNumberMetadata()
 	buildTimezones()
 	buildPrefixData(&carrier)
-	buildPrefixData(&geocoding)
+	languages := buildPrefixData(&geocoding)
+	buildRegionNames(metadata, languages)
 }
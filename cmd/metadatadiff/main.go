@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// the location which means the metadata built in to the package
+const builtinLocation = "builtin"
+
+// Reads the metadata, and the prefix data if asked for, at the passed in
+// location.
+func loadMetadata(ctx context.Context, location string, prefixes bool) (*phonenumbers.PhoneMetadataCollection, *phonenumbers.PrefixData) {
+	if location == builtinLocation {
+		collection, err := phonenumbers.MetadataCollection()
+		if err != nil {
+			log.Fatalf("Error loading built in metadata: %s", err)
+		}
+		if !prefixes {
+			return collection, nil
+		}
+		prefixData, err := phonenumbers.CurrentMetadata().PrefixData()
+		if err != nil {
+			log.Fatalf("Error loading built in prefix data: %s", err)
+		}
+		return collection, prefixData
+	}
+
+	source, err := phonenumbers.OpenMetadataSource(location)
+	if err != nil {
+		log.Fatalf("Error opening source '%s': %s", location, err)
+	}
+	body, err := source.ReadFile(ctx, "PhoneNumberMetadata.xml")
+	if err != nil {
+		log.Fatalf("Error reading metadata from '%s': %s", location, err)
+	}
+	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false)
+	if err != nil {
+		log.Fatalf("Error building metadata from '%s': %s", location, err)
+	}
+	if !prefixes {
+		return collection, nil
+	}
+	prefixData, err := phonenumbers.LoadPrefixData(ctx, source)
+	if err != nil {
+		log.Fatalf("Error reading prefix data from '%s': %s", location, err)
+	}
+	return collection, prefixData
+}
+
+func main() {
+	oldLocation := flag.String("old", builtinLocation, "libphonenumber checkout, resources directory, archive or mirror URL of the old metadata, or builtin")
+	newLocation := flag.String("new", phonenumbers.DEFAULT_METADATA_SOURCE, "libphonenumber checkout, resources directory, archive or mirror URL of the new metadata, or builtin")
+	prefixes := flag.Bool("prefixes", true, "whether to compare carrier, geocoding and timezone data")
+	asJSON := flag.Bool("json", false, "whether to write the changes as JSON")
+	flag.Parse()
+
+	ctx := context.Background()
+	oldCollection, oldPrefixData := loadMetadata(ctx, *oldLocation, *prefixes)
+	newCollection, newPrefixData := loadMetadata(ctx, *newLocation, *prefixes)
+
+	diff := phonenumbers.DiffMetadata(oldCollection, newCollection)
+	if *prefixes {
+		diff.PrefixChanges = phonenumbers.DiffPrefixData(oldPrefixData, newPrefixData)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diff); err != nil {
+			log.Fatalf("Error writing JSON: %s", err)
+		}
+		return
+	}
+	fmt.Print(diff)
+}
@@ -0,0 +1,333 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetadataDiff is what changed between two versions of metadata, as
+// returned by DiffMetadata, with the changes to prefix data returned by
+// DiffPrefixData if they were compared too. Non-geographical entities are
+// identified by 001 and their country calling code, such as 001/800.
+type MetadataDiff struct {
+	AddedRegions   []string       `json:"added_regions,omitempty"`
+	RemovedRegions []string       `json:"removed_regions,omitempty"`
+	ChangedRegions []RegionDiff   `json:"changed_regions,omitempty"`
+	PrefixChanges  []PrefixChange `json:"prefix_changes,omitempty"`
+}
+
+// RegionDiff is what changed in the metadata of a region in both versions.
+type RegionDiff struct {
+	Region  string        `json:"region"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange is a change to a field of the metadata of a region, such as
+// "mobile.nationalNumberPattern" or "numberFormat". Old is empty for values
+// which were added and New for values which were removed.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// PrefixChange is a change to the value of a prefix in the carrier,
+// geocoding or timezone data. Timezones are joined by &, as in upstream's
+// map_data.txt.
+type PrefixChange struct {
+	Data     string `json:"data"`
+	Language string `json:"language,omitempty"`
+	Prefix   int    `json:"prefix"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+}
+
+// DiffMetadata returns the regions added to and removed from the old
+// metadata in the new, and the patterns, possible lengths, example numbers,
+// formats and other fields which changed in the regions in both.
+func DiffMetadata(old, new *PhoneMetadataCollection) *MetadataDiff {
+	oldRegions := metadataByRegion(old)
+	newRegions := metadataByRegion(new)
+
+	diff := &MetadataDiff{}
+	for _, region := range sortedRegionKeys(oldRegions) {
+		if _, found := newRegions[region]; !found {
+			diff.RemovedRegions = append(diff.RemovedRegions, region)
+		}
+	}
+	for _, region := range sortedRegionKeys(newRegions) {
+		oldMetadata, found := oldRegions[region]
+		if !found {
+			diff.AddedRegions = append(diff.AddedRegions, region)
+			continue
+		}
+		if changes := diffRegionMetadata(oldMetadata, newRegions[region]); len(changes) > 0 {
+			diff.ChangedRegions = append(diff.ChangedRegions, RegionDiff{Region: region, Changes: changes})
+		}
+	}
+	return diff
+}
+
+// DiffPrefixData returns the prefixes of the carrier, geocoding and timezone
+// data which were added, removed or changed value from the old data to the
+// new, sorted by data, language and prefix.
+func DiffPrefixData(old, new *PrefixData) []PrefixChange {
+	if old == nil {
+		old = &PrefixData{}
+	}
+	if new == nil {
+		new = &PrefixData{}
+	}
+
+	var changes []PrefixChange
+	changes = append(changes, diffLanguagePrefixMaps("carrier", old.Carriers, new.Carriers)...)
+	changes = append(changes, diffLanguagePrefixMaps("geocoding", old.Geocodings, new.Geocodings)...)
+	changes = append(changes, diffPrefixMap("timezone", "", joinTimezones(old.Timezones), joinTimezones(new.Timezones))...)
+	return changes
+}
+
+// IsEmpty returns whether nothing changed.
+func (d *MetadataDiff) IsEmpty() bool {
+	return len(d.AddedRegions) == 0 && len(d.RemovedRegions) == 0 &&
+		len(d.ChangedRegions) == 0 && len(d.PrefixChanges) == 0
+}
+
+// String returns the changes in a form for people to review, with removed
+// values prefixed by - and added ones by +.
+func (d *MetadataDiff) String() string {
+	if d.IsEmpty() {
+		return "no changes\n"
+	}
+
+	b := &strings.Builder{}
+	if len(d.AddedRegions) > 0 {
+		fmt.Fprintf(b, "added regions: %s\n", strings.Join(d.AddedRegions, " "))
+	}
+	if len(d.RemovedRegions) > 0 {
+		fmt.Fprintf(b, "removed regions: %s\n", strings.Join(d.RemovedRegions, " "))
+	}
+	for _, region := range d.ChangedRegions {
+		fmt.Fprintf(b, "\n%s:\n", region.Region)
+		for _, change := range region.Changes {
+			fmt.Fprintf(b, "  %s\n", change.Field)
+			writeChangedValues(b, "    ", change.Old, change.New)
+		}
+	}
+	if len(d.PrefixChanges) > 0 {
+		fmt.Fprintf(b, "\nprefixes:\n")
+		for _, change := range d.PrefixChanges {
+			data := change.Data
+			if change.Language != "" {
+				data += "/" + change.Language
+			}
+			fmt.Fprintf(b, "  %s %d\n", data, change.Prefix)
+			writeChangedValues(b, "    ", change.Old, change.New)
+		}
+	}
+	return b.String()
+}
+
+func writeChangedValues(b *strings.Builder, indent string, old, new string) {
+	if old != "" {
+		fmt.Fprintf(b, "%s- %s\n", indent, old)
+	}
+	if new != "" {
+		fmt.Fprintf(b, "%s+ %s\n", indent, new)
+	}
+}
+
+// Returns the metadata of the passed in collection by region, with
+// non-geographical entities by 001 and their country calling code.
+func metadataByRegion(collection *PhoneMetadataCollection) map[string]*PhoneMetadata {
+	regions := make(map[string]*PhoneMetadata)
+	for _, metadata := range collection.GetMetadata() {
+		region := metadata.GetId()
+		if region == REGION_CODE_FOR_NON_GEO_ENTITY {
+			region += "/" + strconv.Itoa(int(metadata.GetCountryCode()))
+		}
+		regions[region] = metadata
+	}
+	return regions
+}
+
+func sortedRegionKeys(regions map[string]*PhoneMetadata) []string {
+	keys := make([]string, 0, len(regions))
+	for region := range regions {
+		keys = append(keys, region)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns the changes between the passed in metadata of the same region.
+func diffRegionMetadata(old, new *PhoneMetadata) []FieldChange {
+	var changes []FieldChange
+	addChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"countryCode", strconv.Itoa(int(old.GetCountryCode())), strconv.Itoa(int(new.GetCountryCode()))},
+		{"internationalPrefix", old.GetInternationalPrefix(), new.GetInternationalPrefix()},
+		{"preferredInternationalPrefix", old.GetPreferredInternationalPrefix(), new.GetPreferredInternationalPrefix()},
+		{"nationalPrefix", old.GetNationalPrefix(), new.GetNationalPrefix()},
+		{"preferredExtnPrefix", old.GetPreferredExtnPrefix(), new.GetPreferredExtnPrefix()},
+		{"nationalPrefixForParsing", old.GetNationalPrefixForParsing(), new.GetNationalPrefixForParsing()},
+		{"nationalPrefixTransformRule", old.GetNationalPrefixTransformRule(), new.GetNationalPrefixTransformRule()},
+		{"leadingDigits", old.GetLeadingDigits(), new.GetLeadingDigits()},
+		{"mainCountryForCode", strconv.FormatBool(old.GetMainCountryForCode()), strconv.FormatBool(new.GetMainCountryForCode())},
+		{"mobileNumberPortableRegion", strconv.FormatBool(old.GetMobileNumberPortableRegion()), strconv.FormatBool(new.GetMobileNumberPortableRegion())},
+	}
+	for _, field := range fields {
+		addChange(field.name, field.old, field.new)
+	}
+
+	descs := []struct {
+		name     string
+		old, new *PhoneNumberDesc
+	}{
+		{"generalDesc", old.GetGeneralDesc(), new.GetGeneralDesc()},
+		{"fixedLine", old.GetFixedLine(), new.GetFixedLine()},
+		{"mobile", old.GetMobile(), new.GetMobile()},
+		{"tollFree", old.GetTollFree(), new.GetTollFree()},
+		{"premiumRate", old.GetPremiumRate(), new.GetPremiumRate()},
+		{"sharedCost", old.GetSharedCost(), new.GetSharedCost()},
+		{"personalNumber", old.GetPersonalNumber(), new.GetPersonalNumber()},
+		{"voip", old.GetVoip(), new.GetVoip()},
+		{"pager", old.GetPager(), new.GetPager()},
+		{"uan", old.GetUan(), new.GetUan()},
+		{"voicemail", old.GetVoicemail(), new.GetVoicemail()},
+		{"noInternationalDialling", old.GetNoInternationalDialling(), new.GetNoInternationalDialling()},
+	}
+	for _, desc := range descs {
+		addChange(desc.name+".nationalNumberPattern", desc.old.GetNationalNumberPattern(), desc.new.GetNationalNumberPattern())
+		addChange(desc.name+".possibleLengths", lengthsString(desc.old.GetPossibleLength()), lengthsString(desc.new.GetPossibleLength()))
+		addChange(desc.name+".possibleLengthsLocalOnly", lengthsString(desc.old.GetPossibleLengthLocalOnly()), lengthsString(desc.new.GetPossibleLengthLocalOnly()))
+		addChange(desc.name+".exampleNumber", desc.old.GetExampleNumber(), desc.new.GetExampleNumber())
+	}
+
+	changes = append(changes, diffNumberFormats("numberFormat", old.GetNumberFormat(), new.GetNumberFormat())...)
+	changes = append(changes, diffNumberFormats("intlNumberFormat", old.GetIntlNumberFormat(), new.GetIntlNumberFormat())...)
+	return changes
+}
+
+func lengthsString(lengths []int32) string {
+	strs := make([]string, len(lengths))
+	for i, length := range lengths {
+		strs[i] = strconv.Itoa(int(length))
+	}
+	return strings.Join(strs, ",")
+}
+
+// Returns the formats removed from and added to the old formats in the new,
+// or a single change with all of them if only their order changed, which
+// matters as the first format that matches a number is used.
+func diffNumberFormats(field string, old, new []*NumberFormat) []FieldChange {
+	oldFormats := make([]string, len(old))
+	oldSet := make(map[string]bool, len(old))
+	for i, format := range old {
+		oldFormats[i] = numberFormatString(format)
+		oldSet[oldFormats[i]] = true
+	}
+	newFormats := make([]string, len(new))
+	newSet := make(map[string]bool, len(new))
+	for i, format := range new {
+		newFormats[i] = numberFormatString(format)
+		newSet[newFormats[i]] = true
+	}
+
+	var changes []FieldChange
+	for _, format := range oldFormats {
+		if !newSet[format] {
+			changes = append(changes, FieldChange{Field: field, Old: format})
+		}
+	}
+	for _, format := range newFormats {
+		if !oldSet[format] {
+			changes = append(changes, FieldChange{Field: field, New: format})
+		}
+	}
+
+	if len(changes) == 0 {
+		oldOrder, newOrder := strings.Join(oldFormats, "; "), strings.Join(newFormats, "; ")
+		if oldOrder != newOrder {
+			changes = append(changes, FieldChange{Field: field + ".order", Old: oldOrder, New: newOrder})
+		}
+	}
+	return changes
+}
+
+// Returns the passed in format as a single line, such as:
+//
+//	pattern=(\d{3})(\d{4}) format=$1 $2 leadingDigits=[2-9]
+func numberFormatString(format *NumberFormat) string {
+	parts := []string{
+		"pattern=" + format.GetPattern(),
+		"format=" + format.GetFormat(),
+	}
+	for _, leadingDigits := range format.GetLeadingDigitsPattern() {
+		parts = append(parts, "leadingDigits="+leadingDigits)
+	}
+	if rule := format.GetNationalPrefixFormattingRule(); rule != "" {
+		parts = append(parts, "nationalPrefixFormattingRule="+rule)
+	}
+	if format.GetNationalPrefixOptionalWhenFormatting() {
+		parts = append(parts, "nationalPrefixOptionalWhenFormatting=true")
+	}
+	if rule := format.GetDomesticCarrierCodeFormattingRule(); rule != "" {
+		parts = append(parts, "carrierCodeFormattingRule="+rule)
+	}
+	return strings.Join(parts, " ")
+}
+
+func diffLanguagePrefixMaps(data string, old, new map[string]map[int]string) []PrefixChange {
+	languages := make(map[string]bool)
+	for language := range old {
+		languages[language] = true
+	}
+	for language := range new {
+		languages[language] = true
+	}
+	sorted := make([]string, 0, len(languages))
+	for language := range languages {
+		sorted = append(sorted, language)
+	}
+	sort.Strings(sorted)
+
+	var changes []PrefixChange
+	for _, language := range sorted {
+		changes = append(changes, diffPrefixMap(data, language, old[language], new[language])...)
+	}
+	return changes
+}
+
+func diffPrefixMap(data, language string, old, new map[int]string) []PrefixChange {
+	var changes []PrefixChange
+	for prefix, oldValue := range old {
+		if newValue := new[prefix]; newValue != oldValue {
+			changes = append(changes, PrefixChange{Data: data, Language: language, Prefix: prefix, Old: oldValue, New: newValue})
+		}
+	}
+	for prefix, newValue := range new {
+		if _, found := old[prefix]; !found {
+			changes = append(changes, PrefixChange{Data: data, Language: language, Prefix: prefix, New: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Prefix < changes[j].Prefix })
+	return changes
+}
+
+func joinTimezones(timezones map[int][]string) map[int]string {
+	joined := make(map[int]string, len(timezones))
+	for prefix, zones := range timezones {
+		joined[prefix] = strings.Join(zones, "&")
+	}
+	return joined
+}
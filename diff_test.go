@@ -0,0 +1,182 @@
+package phonenumbers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestDiffMetadata(t *testing.T) {
+	old := &PhoneMetadataCollection{}
+	new := &PhoneMetadataCollection{}
+	for _, metadata := range getCurrMetadataColl().GetMetadata() {
+		switch metadata.GetId() {
+		case "GB":
+			old.Metadata = append(old.Metadata, metadata)
+
+			changed := proto.Clone(metadata).(*PhoneMetadata)
+			changed.Mobile.NationalNumberPattern = proto.String(metadata.GetMobile().GetNationalNumberPattern() + "|60\\d{8}")
+			changed.Mobile.PossibleLength = []int32{9, 10}
+			changed.NumberFormat = append([]*NumberFormat{{
+				Pattern: proto.String(`(\d{4})(\d{6})`),
+				Format:  proto.String("$1 $2"),
+			}}, changed.NumberFormat...)
+			new.Metadata = append(new.Metadata, changed)
+		case "US":
+			old.Metadata = append(old.Metadata, metadata)
+		case "FR", REGION_CODE_FOR_NON_GEO_ENTITY:
+			old.Metadata = append(old.Metadata, metadata)
+			new.Metadata = append(new.Metadata, metadata)
+		}
+	}
+	new.Metadata = append(new.Metadata, &PhoneMetadata{Id: proto.String("XP"), CountryCode: proto.Int32(999)})
+
+	diff := DiffMetadata(old, new)
+	if !reflect.DeepEqual(diff.AddedRegions, []string{"XP"}) {
+		t.Errorf("[test added] failed: %v\n", diff.AddedRegions)
+	}
+	if !reflect.DeepEqual(diff.RemovedRegions, []string{"US"}) {
+		t.Errorf("[test removed] failed: %v\n", diff.RemovedRegions)
+	}
+	if len(diff.ChangedRegions) != 1 || diff.ChangedRegions[0].Region != "GB" {
+		t.Fatalf("[test changed] failed: %v\n", diff.ChangedRegions)
+	}
+
+	fields := make(map[string]FieldChange)
+	for _, change := range diff.ChangedRegions[0].Changes {
+		fields[change.Field] = change
+	}
+	tests := []struct {
+		field string
+		old   string
+		new   string
+	}{
+		{"mobile.possibleLengths", "10", "9,10"},
+		{"numberFormat", "", `pattern=(\d{4})(\d{6}) format=$1 $2`},
+	}
+	for i, test := range tests {
+		change, found := fields[test.field]
+		if !found || change.Old != test.old || change.New != test.new {
+			t.Errorf("[test %d:%s] failed: %v\n", i, test.field, change)
+		}
+	}
+	if change := fields["mobile.nationalNumberPattern"]; !strings.HasSuffix(change.New, "|60\\d{8}") {
+		t.Errorf("[test pattern] failed: %v\n", change)
+	}
+	if len(fields) != 3 {
+		t.Errorf("[test fields] failed: %d != 3\n", len(fields))
+	}
+
+	if !DiffMetadata(old, old).IsEmpty() {
+		t.Errorf("[test unchanged] failed: %v\n", DiffMetadata(old, old))
+	}
+
+	// non-geographical entities are told apart by their calling code
+	removed := strings.Join(DiffMetadata(old, &PhoneMetadataCollection{}).RemovedRegions, " ")
+	if !strings.Contains(removed, "001/800 001/808") {
+		t.Errorf("[test non-geographical] failed: %s\n", removed)
+	}
+
+	output := diff.String()
+	for _, expected := range []string{"added regions: XP\n", "removed regions: US\n", "\nGB:\n", "  mobile.possibleLengths\n    - 10\n    + 9,10\n"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("[test string] failed: %q not in %q\n", expected, output)
+		}
+	}
+
+	encoded, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &MetadataDiff{}
+	if err := json.Unmarshal(encoded, decoded); err != nil || !reflect.DeepEqual(decoded, diff) {
+		t.Errorf("[test json] failed: %v, %s\n", err, encoded)
+	}
+}
+
+func TestDiffNumberFormatsOrder(t *testing.T) {
+	first := &NumberFormat{Pattern: proto.String(`(\d{2})(\d{2})`), Format: proto.String("$1 $2")}
+	second := &NumberFormat{Pattern: proto.String(`(\d{3})(\d{3})`), Format: proto.String("$1-$2")}
+
+	changes := diffNumberFormats("numberFormat", []*NumberFormat{first, second}, []*NumberFormat{second, first})
+	if len(changes) != 1 || changes[0].Field != "numberFormat.order" {
+		t.Errorf("[test order] failed: %v\n", changes)
+	}
+	if changes := diffNumberFormats("numberFormat", []*NumberFormat{first, second}, []*NumberFormat{first, second}); len(changes) != 0 {
+		t.Errorf("[test same] failed: %v\n", changes)
+	}
+}
+
+func TestDiffPrefixData(t *testing.T) {
+	old := &PrefixData{
+		Carriers:   map[string]map[int]string{"en": {447400: "O2", 447700: "Vodafone"}},
+		Geocodings: map[string]map[int]string{"en": {4420: "London"}},
+		Timezones:  map[int][]string{1212: {"America/New_York"}},
+	}
+	new := &PrefixData{
+		Carriers:   map[string]map[int]string{"en": {447400: "EE", 447500: "Three"}, "de": {49151: "T-Mobile"}},
+		Geocodings: map[string]map[int]string{"en": {4420: "London"}},
+		Timezones:  map[int][]string{1212: {"America/New_York", "America/Chicago"}},
+	}
+
+	expected := []PrefixChange{
+		{Data: "carrier", Language: "de", Prefix: 49151, New: "T-Mobile"},
+		{Data: "carrier", Language: "en", Prefix: 447400, Old: "O2", New: "EE"},
+		{Data: "carrier", Language: "en", Prefix: 447500, New: "Three"},
+		{Data: "carrier", Language: "en", Prefix: 447700, Old: "Vodafone"},
+		{Data: "timezone", Prefix: 1212, Old: "America/New_York", New: "America/New_York&America/Chicago"},
+	}
+	if changes := DiffPrefixData(old, new); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("[test changes] failed: %v != %v\n", changes, expected)
+	}
+	if changes := DiffPrefixData(old, old); len(changes) != 0 {
+		t.Errorf("[test unchanged] failed: %v\n", changes)
+	}
+	if changes := DiffPrefixData(nil, old); len(changes) != 4 {
+		t.Errorf("[test nil] failed: %v\n", changes)
+	}
+}
+
+func TestLoadPrefixData(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestResources(t, dir)
+
+	prefixData, err := LoadPrefixData(context.Background(), NewDirectorySource(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &PrefixData{
+		Carriers: map[string]map[int]string{
+			"en": {1212: "Verizon", 447700: "Vodafone"},
+			"de": {49151: "T-Mobile"},
+		},
+		Geocodings: map[string]map[int]string{"en": {4420: "London"}},
+		Timezones:  map[int][]string{1: {"America/New_York"}},
+	}
+	if !reflect.DeepEqual(prefixData, expected) {
+		t.Errorf("[test load] failed: %v != %v\n", prefixData, expected)
+	}
+
+	// the prefix data of metadata can be compared with it
+	util, err := NewUtil(getCurrMetadataColl(), prefixData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	utilPrefixData, err := util.PrefixData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes := DiffPrefixData(prefixData, utilPrefixData); len(changes) != 0 {
+		t.Errorf("[test metadata] failed: %v\n", changes)
+	}
+}
@@ -0,0 +1,231 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidENUMDomain is returned, wrapped with details, when a domain
+// isn't an ENUM domain under the expected suffix.
+var ErrInvalidENUMDomain = errors.New("invalid ENUM domain")
+
+const (
+	// The suffix of ENUM domains in the public DNS, see RFC 6116
+	ENUM_DEFAULT_SUFFIX = "e164.arpa"
+
+	// The ENUM service prefix of NAPTR records, see RFC 6116
+	ENUM_SERVICE_PREFIX = "E2U"
+
+	// The flag for NAPTR records whose result is a URI
+	NAPTR_TERMINAL_FLAG = "u"
+)
+
+var (
+	// Back references in the replacement part of a NAPTR regexp
+	NAPTR_BACKREF_PATTERN = regexp.MustCompile(`\\([0-9])`)
+)
+
+// FormatENUMDomain returns the ENUM domain of the passed in number, that
+// is the digits of its E164 format in reverse order, separated by dots,
+// followed by the passed in suffix. If suffix is empty, e164.arpa is
+// used. For example +1 415 555 0100 becomes 0.0.1.0.5.5.5.5.1.4.1.e164.arpa
+func FormatENUMDomain(number *PhoneNumber, suffix string) string {
+	return CurrentMetadata().FormatENUMDomain(number, suffix)
+}
+
+// FormatENUMDomain is the same as the package level FormatENUMDomain,
+// using this metadata.
+func (md *Metadata) FormatENUMDomain(number *PhoneNumber, suffix string) string {
+	if suffix == "" {
+		suffix = ENUM_DEFAULT_SUFFIX
+	}
+	digits := strings.TrimPrefix(md.Format(number, E164), string(PLUS_SIGN))
+
+	domain := NewBuilder(nil)
+	for i := len(digits) - 1; i >= 0; i-- {
+		domain.WriteByte(digits[i])
+		domain.WriteByte('.')
+	}
+	domain.WriteString(strings.Trim(suffix, "."))
+	return domain.String()
+}
+
+// ParseENUMDomain parses the number of the passed in ENUM domain, which
+// must end with the passed in suffix, or e164.arpa if suffix is empty.
+// Domains are compared case-insensitively and may be fully qualified.
+func ParseENUMDomain(domain, suffix string) (*PhoneNumber, error) {
+	return CurrentMetadata().ParseENUMDomain(domain, suffix)
+}
+
+// ParseENUMDomain is the same as the package level ParseENUMDomain, using
+// this metadata.
+func (md *Metadata) ParseENUMDomain(domain, suffix string) (*PhoneNumber, error) {
+	if suffix == "" {
+		suffix = ENUM_DEFAULT_SUFFIX
+	}
+	suffix = "." + strings.Trim(suffix, ".")
+	name := strings.TrimSuffix(domain, ".")
+	if len(name) <= len(suffix) || !strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return nil, fmt.Errorf("%w %s: missing suffix %s", ErrInvalidENUMDomain, strconv.Quote(domain), suffix[1:])
+	}
+
+	labels := strings.Split(name[:len(name)-len(suffix)], ".")
+	digits := NewBuilderString(string(PLUS_SIGN))
+	for i := len(labels) - 1; i >= 0; i-- {
+		label := labels[i]
+		if len(label) != 1 || label[0] < '0' || label[0] > '9' {
+			return nil, fmt.Errorf("%w %s: label %s isn't a single digit", ErrInvalidENUMDomain, strconv.Quote(domain), strconv.Quote(label))
+		}
+		digits.WriteString(label)
+	}
+	return md.Parse(digits.String(), UNKNOWN_REGION)
+}
+
+// NAPTRRecord is a DNS NAPTR resource record, as described by RFC 3403.
+type NAPTRRecord struct {
+	Order       uint16
+	Preference  uint16
+	Flags       string
+	Service     string
+	Regexp      string
+	Replacement string
+}
+
+// NAPTRResolver looks up the NAPTR records of a domain. The standard
+// library has no NAPTR support, so callers plug in their own DNS client.
+// A domain without records should return no records and no error.
+type NAPTRResolver interface {
+	LookupNAPTR(ctx context.Context, domain string) ([]*NAPTRRecord, error)
+}
+
+// ENUMRecord is the result of applying a NAPTR record of an ENUM domain
+// to its number.
+type ENUMRecord struct {
+	Order      uint16
+	Preference uint16
+
+	// Services are the enumservices of the record, such as "sip" or
+	// "voice:tel", without the E2U prefix
+	Services []string
+
+	// URI is the result of the regexp of the record, such as
+	// "sip:info@example.com"
+	URI string
+}
+
+// ENUMClient looks up the ENUM records of numbers using its resolver.
+type ENUMClient struct {
+	Resolver NAPTRResolver
+
+	// Suffix is the domain ENUM domains are under, e164.arpa if empty
+	Suffix string
+
+	// Metadata is used to format numbers, the metadata in use when each
+	// lookup starts if nil
+	Metadata *Metadata
+}
+
+// NewENUMClient returns a new ENUMClient which looks up e164.arpa domains
+// with the passed in resolver.
+func NewENUMClient(resolver NAPTRResolver) *ENUMClient {
+	return &ENUMClient{Resolver: resolver, Suffix: ENUM_DEFAULT_SUFFIX}
+}
+
+// Lookup returns the ENUM records of the passed in number, sorted by order
+// and preference. Only terminal E2U records are returned, records which
+// are malformed or delegate to another domain are skipped.
+func (c *ENUMClient) Lookup(ctx context.Context, number *PhoneNumber) ([]*ENUMRecord, error) {
+	md := c.Metadata
+	if md == nil {
+		md = CurrentMetadata()
+	}
+	naptrs, err := c.Resolver.LookupNAPTR(ctx, md.FormatENUMDomain(number, c.Suffix))
+	if err != nil {
+		return nil, err
+	}
+
+	aus := md.Format(number, E164)
+	records := make([]*ENUMRecord, 0, len(naptrs))
+	for _, naptr := range naptrs {
+		record, err := parseENUMRecord(naptr, aus)
+		if err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Order != records[j].Order {
+			return records[i].Order < records[j].Order
+		}
+		return records[i].Preference < records[j].Preference
+	})
+	return records, nil
+}
+
+// Builds the ENUM record for the passed in NAPTR record by applying its
+// regexp to the application unique string, which is the E164 number.
+func parseENUMRecord(naptr *NAPTRRecord, aus string) (*ENUMRecord, error) {
+	if !strings.EqualFold(naptr.Flags, NAPTR_TERMINAL_FLAG) {
+		return nil, errors.New("not a terminal record")
+	}
+	services := strings.Split(naptr.Service, "+")
+	if len(services) < 2 || !strings.EqualFold(services[0], ENUM_SERVICE_PREFIX) {
+		return nil, errors.New("not an E2U record")
+	}
+	for _, service := range services[1:] {
+		if service == "" {
+			return nil, errors.New("empty enumservice")
+		}
+	}
+
+	uri, err := applyNAPTRRegexp(naptr.Regexp, aus)
+	if err != nil {
+		return nil, err
+	}
+	return &ENUMRecord{
+		Order:      naptr.Order,
+		Preference: naptr.Preference,
+		Services:   services[1:],
+		URI:        uri,
+	}, nil
+}
+
+// Applies a NAPTR substitution expression, delim-char ERE delim-char repl
+// delim-char flags, to the passed in string. The only flag is i, for case
+// insensitive matching.
+func applyNAPTRRegexp(expression, input string) (string, error) {
+	if len(expression) < 3 {
+		return "", errors.New("regexp too short")
+	}
+	delim := expression[:1]
+	if strings.ContainsAny(delim, `\123456789`) {
+		return "", errors.New("invalid regexp delimiter")
+	}
+	parts := strings.Split(expression[1:], delim)
+	if len(parts) != 3 || (parts[2] != "" && parts[2] != "i") {
+		return "", errors.New("invalid regexp")
+	}
+
+	ere := parts[0]
+	if parts[2] == "i" {
+		ere = "(?i)" + ere
+	}
+	pattern, err := regexp.Compile(ere)
+	if err != nil {
+		return "", err
+	}
+	match := pattern.FindStringSubmatchIndex(input)
+	if match == nil {
+		return "", errors.New("regexp doesn't match")
+	}
+
+	// convert \n back references to the Go template syntax, escaping any $
+	template := NAPTR_BACKREF_PATTERN.ReplaceAllString(strings.Replace(parts[1], "$", "$$", -1), "$${$1}")
+	result := pattern.ExpandString(nil, template, input, match)
+	return input[:match[0]] + string(result) + input[match[1]:], nil
+}
@@ -0,0 +1,141 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFormatENUMDomain(t *testing.T) {
+	var tests = []struct {
+		number string
+		region string
+		suffix string
+		output string
+	}{
+		{"+14155550100", "ZZ", "", "0.0.1.0.5.5.5.5.1.4.1.e164.arpa"},
+		{"020 7031 3000", "GB", "e164.example.com.", "0.0.0.3.1.3.0.7.0.2.4.4.e164.example.com"},
+		{"+1 415 555 0100 ext. 12", "ZZ", "e164.arpa", "0.0.1.0.5.5.5.5.1.4.1.e164.arpa"},
+	}
+
+	for i, test := range tests {
+		number, err := Parse(test.number, test.region)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if output := FormatENUMDomain(number, test.suffix); output != test.output {
+			t.Errorf("[test %d:output] failed: %s != %s\n", i, output, test.output)
+		}
+	}
+}
+
+func TestParseENUMDomain(t *testing.T) {
+	var tests = []struct {
+		domain string
+		suffix string
+		cc     int32
+		nn     uint64
+		err    error
+	}{
+		{"0.0.1.0.5.5.5.5.1.4.1.e164.arpa", "", 1, 4155550100, nil},
+		{"0.0.1.0.5.5.5.5.1.4.1.E164.ARPA.", "e164.arpa", 1, 4155550100, nil},
+		{"0.0.0.3.1.3.0.7.0.2.4.4.e164.example.com", "e164.example.com", 44, 2070313000, nil},
+		{"0.0.1.0.5.5.5.5.1.4.1.e164.example.com", "", 0, 0, ErrInvalidENUMDomain},
+		{"0.0.10.5.5.5.5.1.4.1.e164.arpa", "", 0, 0, ErrInvalidENUMDomain},
+		{"0.0.1.0.5.5.5.5.1.4.1..e164.arpa", "", 0, 0, ErrInvalidENUMDomain},
+		{"a.1.e164.arpa", "", 0, 0, ErrInvalidENUMDomain},
+		{"e164.arpa", "", 0, 0, ErrInvalidENUMDomain},
+		{"1.e164.arpa", "", 0, 0, ErrNotANumber},
+	}
+
+	for i, test := range tests {
+		number, err := ParseENUMDomain(test.domain, test.suffix)
+		if test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if number.GetCountryCode() != test.cc || number.GetNationalNumber() != test.nn {
+			t.Errorf("[test %d:number] failed: %v != +%d %d\n", i, number, test.cc, test.nn)
+		}
+	}
+}
+
+type testNAPTRResolver map[string][]*NAPTRRecord
+
+func (r testNAPTRResolver) LookupNAPTR(ctx context.Context, domain string) ([]*NAPTRRecord, error) {
+	return r[domain], nil
+}
+
+type failingNAPTRResolver struct{}
+
+func (r failingNAPTRResolver) LookupNAPTR(ctx context.Context, domain string) ([]*NAPTRRecord, error) {
+	return nil, errors.New("server failure")
+}
+
+func TestENUMClientLookup(t *testing.T) {
+	resolver := testNAPTRResolver{
+		"0.0.1.0.5.5.5.5.1.4.1.e164.arpa": {
+			{Order: 100, Preference: 20, Flags: "u", Service: "E2U+voice:tel", Regexp: `!^\+1(.*)$!tel:+1-\1!`},
+			{Order: 100, Preference: 10, Flags: "U", Service: "e2u+sip", Regexp: `!^.*$!sip:info@example.com!`},
+			{Order: 50, Preference: 10, Flags: "u", Service: "E2U+sip+voice:tel", Regexp: `/^\+(1415)(.*)$/sip:\2@\1.example.com/i`},
+			// non-terminal, not ENUM or malformed records are skipped
+			{Order: 10, Preference: 10, Flags: "", Service: "E2U+sip", Replacement: "example.com"},
+			{Order: 10, Preference: 10, Flags: "u", Service: "SIP+D2U", Regexp: `!^.*$!sip:other@example.com!`},
+			{Order: 10, Preference: 10, Flags: "u", Service: "E2U+", Regexp: `!^.*$!sip:other@example.com!`},
+			{Order: 10, Preference: 10, Flags: "u", Service: "E2U+sip", Regexp: `!^.*$!sip:other@example.com`},
+			{Order: 10, Preference: 10, Flags: "u", Service: "E2U+sip", Regexp: `!^\+44!sip:other@example.com!`},
+			{Order: 10, Preference: 10, Flags: "u", Service: "E2U+sip", Regexp: `!(!sip:other@example.com!`},
+		},
+	}
+	client := NewENUMClient(resolver)
+
+	number, _ := Parse("+14155550100", "ZZ")
+	records, err := client.Lookup(context.Background(), number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*ENUMRecord{
+		{Order: 50, Preference: 10, Services: []string{"sip", "voice:tel"}, URI: "sip:5550100@1415.example.com"},
+		{Order: 100, Preference: 10, Services: []string{"sip"}, URI: "sip:info@example.com"},
+		{Order: 100, Preference: 20, Services: []string{"voice:tel"}, URI: "tel:+1-4155550100"},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		for _, record := range records {
+			t.Logf("%+v", record)
+		}
+		t.Errorf("unexpected ENUM records")
+	}
+
+	// numbers without records have none
+	number, _ = Parse("+442070313000", "ZZ")
+	records, err = client.Lookup(context.Background(), number)
+	if err != nil || len(records) != 0 {
+		t.Errorf("expected no records, got %v, %v", records, err)
+	}
+
+	// clients can be bound to metadata
+	util, err := NewUtil(getCurrMetadataColl(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = &ENUMClient{Resolver: resolver, Metadata: util.Metadata}
+	number, _ = Parse("+14155550100", "ZZ")
+	records, err = client.Lookup(context.Background(), number)
+	if err != nil || !reflect.DeepEqual(records, expected) {
+		t.Errorf("expected records with bound metadata, got %v, %v", records, err)
+	}
+
+	// resolver errors are returned
+	client = NewENUMClient(failingNAPTRResolver{})
+	if _, err := client.Lookup(context.Background(), number); err == nil || err.Error() != "server failure" {
+		t.Errorf("expected resolver error, got %v", err)
+	}
+}
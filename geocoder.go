@@ -0,0 +1,231 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var (
+	// region names by language, decoded from getRegionNameData on first use
+	regionNames      = make(map[string]map[string]string)
+	regionNamesMutex sync.Mutex
+)
+
+// Returns the names of regions in the passed in data language, decoding
+// them if this is their first use. Returns nil if there are none.
+func getRegionNamesForLanguage(language string) (map[string]string, error) {
+	data, ok := getRegionNameData()[language]
+	if !ok {
+		return nil, nil
+	}
+
+	regionNamesMutex.Lock()
+	defer regionNamesMutex.Unlock()
+
+	names, ok := regionNames[language]
+	if !ok {
+		rawBytes, err := decodeUnzipString(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load RegionNameData for lang:%s, err:%s", language, err)
+		}
+
+		// one line per region, a tab between the region code and its name
+		names = make(map[string]string)
+		for _, line := range strings.Split(string(rawBytes), "\n") {
+			fields := strings.SplitN(line, "\t", 2)
+			if len(fields) == 2 {
+				names[fields[0]] = fields[1]
+			}
+		}
+		regionNames[language] = names
+	}
+	return names, nil
+}
+
+// GetRegionDisplayName returns the name of the passed in region in the
+// language of the passed in BCP 47 locale, such as "Royaume-Uni" for GB in
+// "fr-CA". If there is no name in that locale, the language and script,
+// the language alone and then English are tried. Returns an empty string
+// for unknown and non-geographical regions.
+func GetRegionDisplayName(regionCode, locale string) (string, error) {
+	if regionCode == "" || regionCode == UNKNOWN_REGION || regionCode == REGION_CODE_FOR_NON_GEO_ENTITY {
+		return "", nil
+	}
+	for _, language := range localeFallbackChain(locale) {
+		names, err := getRegionNamesForLanguage(language)
+		if err != nil {
+			return "", err
+		}
+		if name := names[regionCode]; name != "" {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// Returns the name of the country the number is from in the passed in
+// locale. When its country calling code is shared by several regions, the
+// number must be valid for exactly one of them, otherwise an empty string
+// is returned.
+func (md *Metadata) getCountryNameForNumber(number *PhoneNumber, locale string) (string, error) {
+	regionCodes := md.GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	if len(regionCodes) == 1 {
+		return GetRegionDisplayName(regionCodes[0], locale)
+	}
+
+	regionWhereNumberIsValid := UNKNOWN_REGION
+	for _, regionCode := range regionCodes {
+		if md.IsValidNumberForRegion(number, regionCode) {
+			// If the number has already been found valid for one region,
+			// then we don't know which region it belongs to so we return
+			// nothing.
+			if regionWhereNumberIsValid != UNKNOWN_REGION {
+				return "", nil
+			}
+			regionWhereNumberIsValid = regionCode
+		}
+	}
+	return GetRegionDisplayName(regionWhereNumberIsValid, locale)
+}
+
+// GetDescriptionForValidNumber returns a text description for the passed in
+// number, in the language of the passed in BCP 47 locale, without checking
+// the number is valid. The description is the name of the area the number
+// is from, or the name of its country when there is no area for it.
+//
+// If userRegion is set, it's the region the user is in, and numbers from
+// other regions are only described by the name of their country.
+func GetDescriptionForValidNumber(number *PhoneNumber, locale string, userRegion string) (string, error) {
+	return CurrentMetadata().GetDescriptionForValidNumber(number, locale, userRegion)
+}
+
+// GetDescriptionForValidNumber is the same as the package level
+// GetDescriptionForValidNumber, using this metadata.
+func (md *Metadata) GetDescriptionForValidNumber(number *PhoneNumber, locale string, userRegion string) (string, error) {
+	if userRegion != "" {
+		// If the user region matches the number's region, then we just show
+		// the lower-level description, falling back to the country name.
+		regionCode := md.GetRegionCodeForNumber(number)
+		if userRegion != regionCode {
+			// Otherwise, we just show the region(country) name for now.
+			return GetRegionDisplayName(regionCode, locale)
+		}
+	}
+
+	// In some countries, eg. Argentina, mobile numbers have a mobile token
+	// before the national destination code, this should be removed before
+	// geocoding.
+	lookupNumber := number
+	mobileToken := GetCountryMobileToken(int(number.GetCountryCode()))
+	nationalNumber := GetNationalSignificantNumber(number)
+	if mobileToken != "" && strings.HasPrefix(nationalNumber, mobileToken) {
+		region := md.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+		copiedNumber, err := md.Parse(nationalNumber[len(mobileToken):], region)
+		if err == nil {
+			lookupNumber = copiedNumber
+		}
+	}
+
+	areaDescription, err := md.GetGeocodingForNumber(lookupNumber, locale)
+	if err != nil || areaDescription != "" {
+		return areaDescription, err
+	}
+	return md.getCountryNameForNumber(number, locale)
+}
+
+// GetDescriptionForNumber returns a text description for the passed in
+// number, in the language of the passed in BCP 47 locale, as for
+// GetDescriptionForValidNumber. Numbers which aren't valid have no
+// description, and numbers of types which aren't geographical, such as
+// mobile numbers in most countries, are described by their country name.
+func GetDescriptionForNumber(number *PhoneNumber, locale string, userRegion string) (string, error) {
+	return CurrentMetadata().GetDescriptionForNumber(number, locale, userRegion)
+}
+
+// GetDescriptionForNumber is the same as the package level
+// GetDescriptionForNumber, using this metadata.
+func (md *Metadata) GetDescriptionForNumber(number *PhoneNumber, locale string, userRegion string) (string, error) {
+	numberType := md.GetNumberType(number)
+	if numberType == UNKNOWN {
+		return "", nil
+	} else if !isNumberTypeGeographical(numberType, int(number.GetCountryCode())) {
+		return md.getCountryNameForNumber(number, locale)
+	}
+	return md.GetDescriptionForValidNumber(number, locale, userRegion)
+}
+
+// RegionListEntry is a region as shown to users picking their region.
+type RegionListEntry struct {
+	RegionCode  string
+	DisplayName string
+	CountryCode int
+
+	// ExampleNumber is an example fixed line number, or mobile number for
+	// regions without fixed lines, nil if there is neither
+	ExampleNumber *PhoneNumber
+}
+
+// GetRegionListForLocale returns all supported regions with their names in
+// the language of the passed in BCP 47 locale, sorted by name. Regions
+// without a name in any language are listed under their region code.
+func GetRegionListForLocale(locale string) ([]*RegionListEntry, error) {
+	return CurrentMetadata().GetRegionListForLocale(locale)
+}
+
+// GetRegionListForLocale is the same as the package level
+// GetRegionListForLocale, using this metadata.
+func (md *Metadata) GetRegionListForLocale(locale string) ([]*RegionListEntry, error) {
+	regions := make([]*RegionListEntry, 0, len(md.supportedRegions))
+	for regionCode := range md.supportedRegions {
+		name, err := GetRegionDisplayName(regionCode, locale)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name = regionCode
+		}
+
+		example := md.GetExampleNumber(regionCode)
+		if example == nil {
+			example = md.GetExampleNumberForType(regionCode, MOBILE)
+		}
+		regions = append(regions, &RegionListEntry{
+			RegionCode:    regionCode,
+			DisplayName:   name,
+			CountryCode:   md.GetCountryCodeForRegion(regionCode),
+			ExampleNumber: example,
+		})
+	}
+
+	sortKeys := make(map[string]string, len(regions))
+	for _, region := range regions {
+		sortKeys[region.RegionCode] = regionSortKey(region.DisplayName)
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		keyI, keyJ := sortKeys[regions[i].RegionCode], sortKeys[regions[j].RegionCode]
+		if keyI != keyJ {
+			return keyI < keyJ
+		}
+		return regions[i].RegionCode < regions[j].RegionCode
+	})
+	return regions, nil
+}
+
+// The base letters of the Latin-1 Supplement and Latin Extended-A letters,
+// from U+00C0 to U+017F, with * for the symbols in that range.
+const LATIN_BASE_LETTERS = "AAAAAAACEEEEIIIIDNOOOOO*OUUUUYTsaaaaaaaceeeeiiiidnooooo*ouuuuyty" +
+	"AaAaAaCcCcCcCcDdDdEeEeEeEeEeGgGgGgGgHhHhIiIiIiIiIiIiJjKkkLlLlLlLlLlNnNnNnnNnOoOoOoOoRrRrRrSsSsSsSsTtTtTtUuUuUuUuUuUuWwYyYZzZzZzs"
+
+// Returns the key region names are sorted by, which ignores case and the
+// accents of Latin letters, so that "Åland" sorts before "Albania".
+func regionSortKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 0xC0 && r <= 0x17F && LATIN_BASE_LETTERS[r-0xC0] != '*' {
+			r = rune(LATIN_BASE_LETTERS[r-0xC0])
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
@@ -0,0 +1,124 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetDescriptionForNumber(t *testing.T) {
+	if len(getRegionNameData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
+	var tests = []struct {
+		number      string
+		locale      string
+		userRegion  string
+		description string
+		valid       string
+	}{
+		{"+16502530000", "en", "", "Mountain View, CA", "Mountain View, CA"},
+		{"+16502530000", "en", "US", "Mountain View, CA", "Mountain View, CA"},
+		// users in other regions only see the country
+		{"+16502530000", "en", "CH", "United States", "United States"},
+		{"+16502530000", "de", "CH", "Vereinigte Staaten", "Vereinigte Staaten"},
+		{"+41446681800", "it", "", "Zurigo", "Zurigo"},
+		// countries are the fallback when there is no area
+		{"+61236618300", "fr", "", "Australie", "Australie"},
+		// mobile numbers aren't geographical in most countries
+		{"+447912345678", "en", "", "United Kingdom", "United Kingdom"},
+		{"+447912345678", "fr", "GB", "Royaume-Uni", "Royaume-Uni"},
+		// but are in China
+		{"+8613702032331", "zh", "", "天津市", "天津市"},
+		{"+8613702032331", "en", "US", "China", "China"},
+		// shared country calling codes use the region the number is valid for
+		{"+12423570000", "en", "", "Bahamas", "Bahamas"},
+		{"+12423570000", "de", "BS", "Bahamas", "Bahamas"},
+		// invalid numbers have no description
+		{"+18001234567", "en", "", "", ""},
+		{"+80012345678", "en", "", "", ""},
+	}
+
+	for i, test := range tests {
+		number, err := Parse(test.number, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		description, err := GetDescriptionForNumber(number, test.locale, test.userRegion)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+		} else if description != test.description {
+			t.Errorf("[test %d:description] failed: %s != %s\n", i, description, test.description)
+		}
+		valid, err := GetDescriptionForValidNumber(number, test.locale, test.userRegion)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+		} else if valid != test.valid {
+			t.Errorf("[test %d:valid] failed: %s != %s\n", i, valid, test.valid)
+		}
+	}
+}
+
+func TestGetRegionDisplayName(t *testing.T) {
+	if len(getRegionNameData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
+	var tests = []struct {
+		region string
+		locale string
+		name   string
+	}{
+		{"GB", "en", "United Kingdom"},
+		{"GB", "fr-CA", "Royaume-Uni"},
+		{"DE", "de", "Deutschland"},
+		{"TW", "zh-TW", "台灣"},
+		{"CH", "xx", "Switzerland"},
+		{"ZZ", "en", ""},
+		{"001", "en", ""},
+		{"", "en", ""},
+	}
+
+	for i, test := range tests {
+		name, err := GetRegionDisplayName(test.region, test.locale)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+		} else if name != test.name {
+			t.Errorf("[test %d:name] failed: %s != %s\n", i, name, test.name)
+		}
+	}
+}
+
+func TestGetRegionListForLocale(t *testing.T) {
+	if len(getRegionNameData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
+
+	regions, err := GetRegionListForLocale("de-AT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != len(GetSupportedRegions()) {
+		t.Errorf("expected %d regions, got %d", len(GetSupportedRegions()), len(regions))
+	}
+
+	// accents are ignored when sorting
+	names := make([]string, 0, 4)
+	for _, region := range regions[:4] {
+		names = append(names, region.DisplayName)
+	}
+	if !reflect.DeepEqual(names, []string{"Afghanistan", "Ägypten", "Ålandinseln", "Albanien"}) {
+		t.Errorf("unexpected first regions: %v", names)
+	}
+
+	for _, region := range regions {
+		if region.RegionCode != "GB" {
+			continue
+		}
+		if region.DisplayName != "Vereinigtes Königreich" || region.CountryCode != 44 {
+			t.Errorf("unexpected entry for GB: %+v", region)
+		}
+		if region.ExampleNumber == nil || !IsValidNumberForRegion(region.ExampleNumber, "GB") {
+			t.Errorf("expected valid example number for GB, got %v", region.ExampleNumber)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package phonenumbers
+
+import (
+	"strings"
+)
+
+// Languages whose data files use a legacy code, mirroring the normalization
+// done by Java's Locale, which upstream's data files are named for.
+var LEGACY_LANGUAGE_CODES = map[string]string{
+	"he": "iw",
+	"id": "in",
+	"yi": "ji",
+}
+
+// The script implied by a language and region when a locale doesn't have
+// one, used for the data files upstream names by script.
+var IMPLIED_SCRIPTS = map[string]string{
+	"zh_TW": "Hant",
+	"zh_HK": "Hant",
+	"zh_MO": "Hant",
+}
+
+// localeFallbackChain returns the data file keys to try for the passed in
+// BCP 47 language tag, such as "pt-BR" or "zh_Hant_TW", in order: the full
+// tag, the language and script, the language alone and finally English.
+// Variants and extensions of the tag are ignored.
+func localeFallbackChain(locale string) []string {
+	language, script, region := parseLocale(locale)
+	if script == "" {
+		script = IMPLIED_SCRIPTS[language+"_"+region]
+	}
+
+	candidates := make([]string, 0, 5)
+	if language != "" {
+		if script != "" && region != "" {
+			candidates = append(candidates, language+"_"+script+"_"+region)
+		}
+		if region != "" {
+			candidates = append(candidates, language+"_"+region)
+		}
+		if script != "" {
+			candidates = append(candidates, language+"_"+script)
+		}
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, "en")
+
+	// legacy language codes are tried after the current ones
+	chain := make([]string, 0, len(candidates)*2)
+	seen := make(map[string]bool, len(candidates)*2)
+	for _, candidate := range candidates {
+		for _, key := range []string{candidate, legacyLocaleKey(candidate)} {
+			if !seen[key] {
+				seen[key] = true
+				chain = append(chain, key)
+			}
+		}
+	}
+	return chain
+}
+
+// Splits a BCP 47 language tag, or a Java style locale using underscores,
+// into its lowercase language, titlecase script and uppercase region.
+func parseLocale(locale string) (language, script, region string) {
+	subtags := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || !isAlpha(subtags[0]) || len(subtags[0]) < 2 || len(subtags[0]) > 8 {
+		return "", "", ""
+	}
+	language = strings.ToLower(subtags[0])
+
+	i := 1
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		script = strings.ToUpper(subtags[i][:1]) + strings.ToLower(subtags[i][1:])
+		i++
+	}
+	if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+		region = strings.ToUpper(subtags[i])
+	}
+	return language, script, region
+}
+
+// Returns the key with its language replaced by the legacy code upstream
+// uses for it, if any.
+func legacyLocaleKey(key string) string {
+	language, rest := key, ""
+	if i := strings.IndexByte(key, '_'); i >= 0 {
+		language, rest = key[:i], key[i:]
+	}
+	if legacy, ok := LEGACY_LANGUAGE_CODES[language]; ok {
+		return legacy + rest
+	}
+	return key
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocaleFallbackChain(t *testing.T) {
+	var tests = []struct {
+		locale string
+		chain  []string
+	}{
+		{"en", []string{"en"}},
+		{"", []string{"en"}},
+		{"pt-BR", []string{"pt_BR", "pt", "en"}},
+		{"de_CH", []string{"de_CH", "de", "en"}},
+		{"zh_Hant", []string{"zh_Hant", "zh", "en"}},
+		{"zh-TW", []string{"zh_Hant_TW", "zh_TW", "zh_Hant", "zh", "en"}},
+		{"zh-hans-cn", []string{"zh_Hans_CN", "zh_CN", "zh_Hans", "zh", "en"}},
+		{"ZH-HK", []string{"zh_Hant_HK", "zh_HK", "zh_Hant", "zh", "en"}},
+		{"es-419", []string{"es_419", "es", "en"}},
+		{"sr-Latn-RS-x-private", []string{"sr_Latn_RS", "sr_RS", "sr_Latn", "sr", "en"}},
+		{"he-IL", []string{"he_IL", "iw_IL", "he", "iw", "en"}},
+		{"1234", []string{"en"}},
+	}
+
+	for i, test := range tests {
+		chain := localeFallbackChain(test.locale)
+		if !reflect.DeepEqual(chain, test.chain) {
+			t.Errorf("[test %d:chain] failed: %v != %v\n", i, chain, test.chain)
+		}
+	}
+}
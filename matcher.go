@@ -1,20 +1,455 @@
 package phonenumbers
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
+// ----------------------------------------------------------------------------
+// Golang port of:
+// https://github.com/googlei18n/libphonenumber/blob/master/java/libphonenumber/src/com/google/i18n/phonenumbers/PhoneNumberMatcher.java
+// ----------------------------------------------------------------------------
+
+var (
+	// The phone number pattern used by find(), similar to
+	// VALID_PHONE_NUMBER, but with the following differences:
+	//   - All captures are limited in order to place an upper bound to
+	//     the text matched by the pattern.
+	//
+	// Leading punctuation / plus signs are limited.
+	// Consecutive occurrences of punctuation are limited.
+	// Number of digits is limited.
+	//   - No whitespace is allowed at the start or end.
+	//   - No alpha digits (vanity numbers such as 1-800-SIX-FLAGS) are
+	//     currently supported.
+	MATCHER_PATTERN *regexp.Regexp
+
+	// Matches strings that look like publication pages. Example:
+	// Computing Complete Answers to Queries in the Presence of Limited
+	// Access Patterns. Chen Li. VLDB J. 12(3): 211-227 (2003).
+	//
+	// The string "211-227 (2003)" is not a telephone number.
+	PUB_PAGES = regexp.MustCompile(`\d{1,5}-+\d{1,5}\s{0,4}\(\d{1,4}`)
+
+	// Matches strings that look like dates using "/" as a separator.
+	// Examples: 3/10/2011, 31/10/96 or 08/31/95.
+	SLASH_SEPARATED_DATES = regexp.MustCompile(
+		`(?:(?:[0-3]?\d/[01]?\d)|(?:[01]?\d/[0-3]?\d))/(?:[12]\d)?\d{2}`)
+
+	// Matches timestamps. Examples: "2012-01-02 08:00". Note that the
+	// reg-ex does not include the trailing ":\d\d" -- that is covered
+	// by TIME_STAMPS_SUFFIX.
+	TIME_STAMPS        = regexp.MustCompile(`[12]\d{3}[-/]?[01]\d[-/]?[0-3]\d +[0-2]\d$`)
+	TIME_STAMPS_SUFFIX = regexp.MustCompile(`^:[0-5]\d`)
+
+	// Pattern to check that brackets match. Opening brackets should be
+	// closed within a phone number. This also checks that there is
+	// something inside the brackets. Having no brackets at all is also
+	// fine.
+	MATCHING_BRACKETS *regexp.Regexp
+
+	// Patterns used to extract phone numbers from a larger phone-number-like
+	// pattern. These are ordered according to specificity. For example,
+	// white-space is last since that is frequently used in numbers, not
+	// just to separate two numbers. We have separate patterns since we
+	// don't want to break up the phone-number-like text on more than one
+	// different kind of symbol at one time, although symbols of the same
+	// type (e.g. space) can be safely grouped together.
+	//
+	// Note that if there is a match, we will always check any text found
+	// up to the first match as well.
+	INNER_MATCHES = []*regexp.Regexp{
+		// Breaks on the slash - e.g. "651-234-2345/332-445-1234"
+		regexp.MustCompile(`/+(.*)`),
+		// Note that the bracket here is inside the capturing group, since
+		// we consider it part of the phone number. Will match a pattern
+		// like "(650) 223 3345 (754) 223 3321".
+		regexp.MustCompile(`(\([^(]*)`),
+		// Breaks on a hyphen - e.g. "12345 - 332-445-1234 is my number."
+		// We require a space on either side of the hyphen for it to be
+		// considered a separator.
+		regexp.MustCompile(`(?:\p{Z}-|-\p{Z})\p{Z}*(.+)`),
+		// Various types of wide hyphens. Note we have decided not to
+		// enforce a space here, since it's possible that it's supposed to
+		// be used to break two numbers without spaces, and we haven't
+		// seen many instances of it used within a number.
+		regexp.MustCompile("[\u2012-\u2015\uFF0D]\\p{Z}*(.+)"),
+		// Breaks on a full stop - e.g. "12345. 332-445-1234 is my number."
+		regexp.MustCompile(`\.+\p{Z}*([^.]+)`),
+		// Breaks on space - e.g. "3324451234 8002341234"
+		regexp.MustCompile(`\p{Z}+(\P{Z}+)`),
+	}
+
+	// Punctuation that may be at the start of a phone number - brackets
+	// and plus signs.
+	LEAD_CLASS_PATTERN *regexp.Regexp
+)
+
+func init() {
+	openingParens := "(\\[\uFF08\uFF3B"
+	closingParens := ")\\]\uFF09\uFF3D"
+	nonParens := "[^" + openingParens + closingParens + "]"
+
+	// Limit on the number of pairs of brackets in a phone number.
+	bracketPairLimit := limit(0, 3)
+	MATCHING_BRACKETS = regexp.MustCompile(
+		"^(?:(?:[" + openingParens + "])?" + "(?:" + nonParens + "+" + "[" + closingParens + "])?" +
+			nonParens + "+" +
+			"(?:[" + openingParens + "]" + nonParens + "+[" + closingParens + "])" + bracketPairLimit +
+			nonParens + "*)$")
+
+	// Limit on the number of leading (plus) characters.
+	leadLimit := limit(0, 2)
+	// Limit on the number of consecutive punctuation characters.
+	punctuationLimit := limit(0, 4)
+	// The maximum number of digits allowed in a digit-separated block.
+	// As we allow all digits in a single block, set high enough to
+	// accommodate the entire national number and the international
+	// country code.
+	digitBlockLimit := MAX_LENGTH_FOR_NSN + MAX_LENGTH_COUNTRY_CODE
+	// Limit on the number of blocks separated by punctuation. Uses
+	// digitBlockLimit since some formats use spaces to separate each digit.
+	blockLimit := limit(0, digitBlockLimit)
+
+	// A punctuation sequence allowing white space.
+	punctuation := "[" + VALID_PUNCTUATION + "]" + punctuationLimit
+	// A digits block without punctuation.
+	digitSequence := "\\p{Nd}" + limit(1, digitBlockLimit)
+
+	leadClassChars := openingParens + PLUS_CHARS
+	leadClass := "[" + leadClassChars + "]"
+	LEAD_CLASS_PATTERN = regexp.MustCompile("^" + leadClass)
+
+	// Phone number pattern allowing optional punctuation.
+	MATCHER_PATTERN = regexp.MustCompile(
+		"(?i)(?:" + leadClass + punctuation + ")" + leadLimit +
+			digitSequence + "(?:" + punctuation + digitSequence + ")" + blockLimit +
+			"(?:" + EXTN_PATTERNS_FOR_MATCHING + ")?")
+}
+
+// Returns a regular expression quantifier with an upper and lower limit.
+func limit(lower, upper int) string {
+	if lower < 0 || upper <= 0 || upper < lower {
+		panic("invalid limit")
+	}
+	return "{" + strconv.Itoa(lower) + "," + strconv.Itoa(upper) + "}"
+}
+
+// PhoneNumberMatch is a single phone number match found in a piece of
+// text by a PhoneNumberMatcher. Start and End are byte offsets into the
+// searched text, so RawString is always text[Start:End].
+type PhoneNumberMatch struct {
+	// The start index into the text.
+	Start int
+	// The exclusive end index into the text.
+	End int
+	// The raw substring matched.
+	RawString string
+	// The matched phone number.
+	Number *PhoneNumber
+}
+
+func newPhoneNumberMatch(start int, rawString string, number *PhoneNumber) *PhoneNumberMatch {
+	return &PhoneNumberMatch{
+		Start:     start,
+		End:       start + len(rawString),
+		RawString: rawString,
+		Number:    number,
+	}
+}
+
+// The potential states of a PhoneNumberMatcher.
+type matcherState int
+
+const (
+	matcherNotReady matcherState = iota
+	matcherReady
+	matcherDone
+)
+
+// PhoneNumberMatcher is a stateful iterator over the phone numbers found
+// in a piece of text. Vanity numbers (phone numbers using alphabetic
+// digits such as 1-800-SIX-FLAGS) are not found.
+//
+//	matcher := NewPhoneNumberMatcher(text, "US", VALID, math.MaxInt64)
+//	for matcher.HasNext() {
+//	    match := matcher.Next()
+//	    // ... use match.Number, match.Start and match.End ...
+//	}
 type PhoneNumberMatcher struct {
+	// The metadata numbers are parsed and verified with.
+	metadata *Metadata
+	// The text searched for phone numbers.
+	text string
+	// The region (country) to assume for phone numbers without an
+	// international prefix, possibly empty.
+	preferredRegion string
+	// The degree of validation requested.
+	leniency Leniency
+	// The maximum number of retries after matching an invalid number.
+	maxTries int64
+
+	// The iteration tristate.
+	state matcherState
+	// The last successful match, nil unless in matcherReady.
+	lastMatch *PhoneNumberMatch
+	// The next index to start searching at. Undefined in matcherDone.
+	searchIndex int
+}
+
+// NewPhoneNumberMatcher creates a new matcher over text. Numbers written
+// without an international prefix are parsed as if dialled from
+// defaultRegion, and every candidate must pass the given leniency to be
+// returned. maxTries caps the number of invalid candidates inspected
+// before the matcher gives up, which bounds the work done on large texts.
+func NewPhoneNumberMatcher(text, defaultRegion string, leniency Leniency, maxTries int64) *PhoneNumberMatcher {
+	return CurrentMetadata().NewPhoneNumberMatcher(text, defaultRegion, leniency, maxTries)
 }
 
-func NewPhoneNumberMatcher(seq string) *PhoneNumberMatcher {
-	// TODO(ttacon): to be implemented
+// NewPhoneNumberMatcher is the same as the package level
+// NewPhoneNumberMatcher, using this metadata.
+func (md *Metadata) NewPhoneNumberMatcher(text, defaultRegion string, leniency Leniency, maxTries int64) *PhoneNumberMatcher {
+	if maxTries < 0 {
+		maxTries = 0
+	}
+	return &PhoneNumberMatcher{
+		metadata:        md,
+		text:            text,
+		preferredRegion: defaultRegion,
+		leniency:        leniency,
+		maxTries:        maxTries,
+		state:           matcherNotReady,
+	}
+}
+
+// FindNumbers returns all the phone numbers found in text. This is a
+// shortcut for draining a PhoneNumberMatcher created with the same
+// arguments.
+func FindNumbers(text, defaultRegion string, leniency Leniency, maxTries int64) []*PhoneNumberMatch {
+	return CurrentMetadata().FindNumbers(text, defaultRegion, leniency, maxTries)
+}
+
+// FindNumbers is the same as the package level FindNumbers, using this
+// metadata.
+func (md *Metadata) FindNumbers(text, defaultRegion string, leniency Leniency, maxTries int64) []*PhoneNumberMatch {
+	var matches []*PhoneNumberMatch
+	matcher := md.NewPhoneNumberMatcher(text, defaultRegion, leniency, maxTries)
+	for matcher.HasNext() {
+		matches = append(matches, matcher.Next())
+	}
+	return matches
+}
+
+// HasNext returns true if there is another match in the text.
+func (m *PhoneNumberMatcher) HasNext() bool {
+	if m.state == matcherNotReady {
+		m.lastMatch = m.find(m.searchIndex)
+		if m.lastMatch == nil {
+			m.state = matcherDone
+		} else {
+			m.searchIndex = m.lastMatch.End
+			m.state = matcherReady
+		}
+	}
+	return m.state == matcherReady
+}
+
+// Next returns the next match in the text, or nil if there are no more
+// matches.
+func (m *PhoneNumberMatcher) Next() *PhoneNumberMatch {
+	// Check the state and find the next match as a side-effect if necessary.
+	if !m.HasNext() {
+		return nil
+	}
+	// Don't retain that memory any longer than necessary.
+	result := m.lastMatch
+	m.lastMatch = nil
+	m.state = matcherNotReady
+	return result
+}
+
+// Attempts to find the next subsequence in the searched sequence on or
+// after index that represents a phone number. Returns the next match,
+// nil if none was found.
+func (m *PhoneNumberMatcher) find(index int) *PhoneNumberMatch {
+	for m.maxTries > 0 && index <= len(m.text) {
+		loc := MATCHER_PATTERN.FindStringIndex(m.text[index:])
+		if loc == nil {
+			break
+		}
+		start := index + loc[0]
+		candidate := m.text[start : index+loc[1]]
+
+		// Check for extra numbers at the end.
+		// TODO: This is the place to start when trying to support
+		// extraction of multiple phone number from split notations
+		// (+41 79 123 45 67 / 68).
+		candidate = trimAfterFirstMatch(SECOND_NUMBER_START_PATTERN, candidate)
+
+		match := m.extractMatch(candidate, start)
+		if match != nil {
+			return match
+		}
+
+		index = start + len(candidate)
+		if len(candidate) == 0 {
+			// Make sure we always make progress through the text.
+			_, size := utf8.DecodeRuneInString(m.text[index:])
+			index += size
+		}
+		m.maxTries--
+	}
+	return nil
+}
+
+// Trims away any characters after the first match of pattern in
+// candidate, returning the trimmed version.
+func trimAfterFirstMatch(pattern *regexp.Regexp, candidate string) string {
+	loc := pattern.FindStringIndex(candidate)
+	if loc != nil {
+		candidate = candidate[:loc[0]]
+	}
+	return candidate
+}
+
+// Helper method to determine if a character is a Latin-script letter or
+// not. For our purposes, combining marks should also return true since
+// we assume they have been added to a preceding Latin character.
+func isLatinLetter(letter rune) bool {
+	// Combining marks are a subset of non-spacing-mark.
+	if !unicode.IsLetter(letter) && !unicode.Is(unicode.Mn, letter) {
+		return false
+	}
+	return (letter >= 0x0000 && letter <= 0x007F) || // BASIC_LATIN
+		(letter >= 0x0080 && letter <= 0x00FF) || // LATIN_1_SUPPLEMENT
+		(letter >= 0x0100 && letter <= 0x017F) || // LATIN_EXTENDED_A
+		(letter >= 0x0180 && letter <= 0x024F) || // LATIN_EXTENDED_B
+		(letter >= 0x1E00 && letter <= 0x1EFF) || // LATIN_EXTENDED_ADDITIONAL
+		(letter >= 0x0300 && letter <= 0x036F) // COMBINING_DIACRITICAL_MARKS
+}
+
+func isInvalidPunctuationSymbol(character rune) bool {
+	return character == '%' || unicode.Is(unicode.Sc, character)
+}
+
+// Attempts to extract a match from a candidate string. Returns the match
+// found, nil if none can be found.
+func (m *PhoneNumberMatcher) extractMatch(candidate string, offset int) *PhoneNumberMatch {
+	// Skip a match that is more likely to be a date.
+	if SLASH_SEPARATED_DATES.MatchString(candidate) {
+		return nil
+	}
+
+	// Skip potential time-stamps.
+	if TIME_STAMPS.MatchString(candidate) {
+		followingText := m.text[offset+len(candidate):]
+		if TIME_STAMPS_SUFFIX.MatchString(followingText) {
+			return nil
+		}
+	}
+
+	// Try to come up with a valid match given the entire candidate.
+	match := m.parseAndVerify(candidate, offset)
+	if match != nil {
+		return match
+	}
+
+	// If that failed, try to find an "inner match" - there might be a
+	// phone number within this candidate.
+	return m.extractInnerMatch(candidate, offset)
+}
+
+// Attempts to extract a match from candidate if the whole candidate does
+// not qualify as a match. Returns the match found, nil if none can be
+// found.
+func (m *PhoneNumberMatcher) extractInnerMatch(candidate string, offset int) *PhoneNumberMatch {
+	for _, possibleInnerMatch := range INNER_MATCHES {
+		isFirstMatch := true
+		for _, groups := range possibleInnerMatch.FindAllStringSubmatchIndex(candidate, -1) {
+			if m.maxTries <= 0 {
+				break
+			}
+			if isFirstMatch {
+				// We should handle any group before this one too.
+				group := trimAfterFirstMatch(UNWANTED_END_CHAR_PATTERN, candidate[:groups[0]])
+				match := m.parseAndVerify(group, offset)
+				if match != nil {
+					return match
+				}
+				m.maxTries--
+				isFirstMatch = false
+			}
+			group := trimAfterFirstMatch(UNWANTED_END_CHAR_PATTERN, candidate[groups[2]:groups[3]])
+			match := m.parseAndVerify(group, offset+groups[2])
+			if match != nil {
+				return match
+			}
+			m.maxTries--
+		}
+	}
+	return nil
+}
+
+// Parses a phone number from the candidate using ParseAndKeepRawInput
+// and verifies it matches the requested leniency. If parsing and
+// verification succeed, a corresponding PhoneNumberMatch is returned,
+// otherwise this method returns nil.
+func (m *PhoneNumberMatcher) parseAndVerify(candidate string, offset int) *PhoneNumberMatch {
+	// Check the candidate doesn't contain any formatting which would
+	// indicate that it really isn't a phone number.
+	if !MATCHING_BRACKETS.MatchString(candidate) || PUB_PAGES.MatchString(candidate) {
+		return nil
+	}
+
+	// If leniency is set to VALID or stricter, we also want to skip
+	// numbers that are surrounded by Latin alphabetic characters, to
+	// skip cases like abc8005001234 or 8005001234def.
+	if m.leniency >= VALID {
+		// If the candidate is not at the start of the text, and does not
+		// start with phone-number punctuation, check the previous character.
+		if offset > 0 && !LEAD_CLASS_PATTERN.MatchString(candidate) {
+			previousChar, _ := utf8.DecodeLastRuneInString(m.text[:offset])
+			// We return nil if it is a latin letter or an invalid
+			// punctuation symbol.
+			if isInvalidPunctuationSymbol(previousChar) || isLatinLetter(previousChar) {
+				return nil
+			}
+		}
+		lastCharIndex := offset + len(candidate)
+		if lastCharIndex < len(m.text) {
+			nextChar, _ := utf8.DecodeRuneInString(m.text[lastCharIndex:])
+			if isInvalidPunctuationSymbol(nextChar) || isLatinLetter(nextChar) {
+				return nil
+			}
+		}
+	}
+
+	number, err := m.metadata.ParseAndKeepRawInput(candidate, m.preferredRegion)
+	if err != nil {
+		return nil
+	}
+
+	if m.leniency.verify(m.metadata, number, candidate) {
+		// We used ParseAndKeepRawInput to create this number, but for
+		// now we don't return the extra values parsed.
+		number.CountryCodeSource = nil
+		number.RawInput = nil
+		number.PreferredDomesticCarrierCode = nil
+		return newPhoneNumberMatch(offset, candidate, number)
+	}
 	return nil
 }
 
 func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
+	return CurrentMetadata().ContainsOnlyValidXChars(number, candidate)
+}
+
+// ContainsOnlyValidXChars is the same as the package level
+// ContainsOnlyValidXChars, using this metadata.
+func (md *Metadata) ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 	// The characters 'x' and 'X' can be (1) a carrier code, in which
 	// case they always precede the national significant number or (2)
 	// an extension sign, in which case they always precede the extension
@@ -30,7 +465,7 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 				// This is the carrier code case, in which the 'X's
 				// always precede the national significant number.
 				index++
-				if isNumberMatchWithOneNumber(number, candidate[index:]) != NSN_MATCH {
+				if md.isNumberMatchWithOneNumber(number, candidate[index:]) != NSN_MATCH {
 					return false
 				}
 				// This is the extension sign case, in which the 'x'
@@ -44,13 +479,19 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 }
 
 func IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
+	return CurrentMetadata().IsNationalPrefixPresentIfRequired(number)
+}
+
+// IsNationalPrefixPresentIfRequired is the same as the package level
+// IsNationalPrefixPresentIfRequired, using this metadata.
+func (md *Metadata) IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
 	// First, check how we deduced the country code. If it was written
 	// in international format, then the national prefix is not required.
 	if number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
 		return true
 	}
-	var phoneNumberRegion = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-	var metadata = getMetadataForRegion(phoneNumberRegion)
+	var phoneNumberRegion = md.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+	var metadata = md.getMetadataForRegion(phoneNumberRegion)
 	if metadata == nil {
 		return true
 	}
@@ -110,18 +551,95 @@ func ContainsMoreThanOneSlashInNationalNumber(
 	return true
 }
 
+// CheckNumberGroupingIsValid checks the digit groups of candidate against
+// the groups number would be formatted into, using fn to do the
+// comparison. If the default formatting rules for the number don't
+// match, the alternate formats for its country calling code are tried
+// as well.
 func CheckNumberGroupingIsValid(
 	number *PhoneNumber,
 	candidate string,
 	fn func(*PhoneNumber, string, []string) bool) bool {
-	// TODO(ttacon): to be implemented
+	return CurrentMetadata().CheckNumberGroupingIsValid(number, candidate, fn)
+}
+
+// CheckNumberGroupingIsValid is the same as the package level
+// CheckNumberGroupingIsValid, using this metadata.
+func (md *Metadata) CheckNumberGroupingIsValid(
+	number *PhoneNumber,
+	candidate string,
+	fn func(*PhoneNumber, string, []string) bool) bool {
+
+	normalizedCandidate := normalizeDigits(candidate, true /* keep non-digits */)
+	formattedNumberGroups := md.getNationalNumberGroups(number)
+	if fn(number, normalizedCandidate, formattedNumberGroups) {
+		return true
+	}
+	// If this didn't pass, see if there are any alternate formats that
+	// match, and try them instead.
+	alternateFormats := md.getAlternateFormatsForCountry(int(number.GetCountryCode()))
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+	if alternateFormats != nil {
+		for _, alternateFormat := range alternateFormats.GetNumberFormat() {
+			if len(alternateFormat.GetLeadingDigitsPattern()) > 0 {
+				// There is only one leading digits pattern for alternate formats.
+				pattern := regexFor("^(?:" + alternateFormat.GetLeadingDigitsPattern()[0] + ")")
+				if !pattern.MatchString(nationalSignificantNumber) {
+					// Leading digits don't match; try another one.
+					continue
+				}
+			}
+			formattedNumberGroups = getNationalNumberGroupsForPattern(number, alternateFormat)
+			if fn(number, normalizedCandidate, formattedNumberGroups) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
+// Helper method to get the national-number part of a number, formatted
+// without any national prefix, and return it as a set of digit blocks
+// that would be formatted together following standard formatting rules.
+func (md *Metadata) getNationalNumberGroups(number *PhoneNumber) []string {
+	// This will be in the format +CC-DG1-DG2-DGX;ext=EXT where DG1..DGX
+	// represents groups of digits.
+	rfc3966Format := md.Format(number, RFC3966)
+	// We remove the extension part from the formatted string before
+	// splitting it into different groups.
+	endIndex := strings.Index(rfc3966Format, ";")
+	if endIndex < 0 {
+		endIndex = len(rfc3966Format)
+	}
+	// The country-code will have a '-' following it.
+	startIndex := strings.Index(rfc3966Format, "-") + 1
+	return strings.Split(rfc3966Format[startIndex:endIndex], "-")
+}
+
+// Helper method to get the national-number part of a number, formatted
+// without any national prefix, and return it as a set of digit blocks
+// that should be formatted together according to the formatting pattern
+// passed in.
+func getNationalNumberGroupsForPattern(number *PhoneNumber, formattingPattern *NumberFormat) []string {
+	// We format the NSN only, and split that according to the separator.
+	nationalSignificantNumber := GetNationalSignificantNumber(number)
+	return strings.Split(formatNsnUsingPattern(
+		nationalSignificantNumber, formattingPattern, RFC3966), "-")
+}
+
 func AllNumberGroupsRemainGrouped(
 	number *PhoneNumber,
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
+	return CurrentMetadata().AllNumberGroupsRemainGrouped(number, normalizedCandidate, formattedNumberGroups)
+}
+
+// AllNumberGroupsRemainGrouped is the same as the package level
+// AllNumberGroupsRemainGrouped, using this metadata.
+func (md *Metadata) AllNumberGroupsRemainGrouped(
+	number *PhoneNumber,
+	normalizedCandidate string,
+	formattedNumberGroups []string) bool {
 
 	var fromIndex = 0
 	if number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
@@ -135,13 +653,13 @@ func AllNumberGroupsRemainGrouped(
 		// Fails if the substring of normalizedCandidate starting
 		// from fromIndex doesn't contain the consecutive digits
 		// in formattedNumberGroups[i].
-		fromIndex = strings.Index(
-			normalizedCandidate[fromIndex+1:], formattedNumberGroups[i])
-		if fromIndex < 0 {
+		groupIndex := strings.Index(
+			normalizedCandidate[fromIndex:], formattedNumberGroups[i])
+		if groupIndex < 0 {
 			return false
 		}
 		// Moves fromIndex forward.
-		fromIndex += len(formattedNumberGroups[i])
+		fromIndex += groupIndex + len(formattedNumberGroups[i])
 		if i == 0 && fromIndex < len(normalizedCandidate) {
 			// We are at the position right after the NDC. We get
 			// the region used for formatting information based on
@@ -149,8 +667,8 @@ func AllNumberGroupsRemainGrouped(
 			// number itself, as we do not need to distinguish between
 			// different countries with the same country calling code
 			// and this is faster.
-			var region = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-			if GetNddPrefixForRegion(region, true) != "" &&
+			var region = md.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+			if md.GetNddPrefixForRegion(region, true) != "" &&
 				unicode.IsDigit(rune(normalizedCandidate[fromIndex])) {
 				// This means there is no formatting symbol after the
 				// NDC. In this case, we only accept the number if there
@@ -176,11 +694,16 @@ func AllNumberGroupsAreExactlyPresent(
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
 
-	var candidateGroups = NON_DIGITS_PATTERN.FindAllString(normalizedCandidate, -1)
+	var candidateGroups = NON_DIGITS_PATTERN.Split(normalizedCandidate, -1)
+	// Trailing empty groups are dropped, as a trailing separator doesn't
+	// start a new group of digits.
+	for len(candidateGroups) > 1 && candidateGroups[len(candidateGroups)-1] == "" {
+		candidateGroups = candidateGroups[:len(candidateGroups)-1]
+	}
 	// Set this to the last group, skipping it if the number has an extension.
-	var candidateNumberGroupIndex = len(candidateGroups) - 2
+	var candidateNumberGroupIndex = len(candidateGroups) - 1
 	if number.GetExtension() != "" {
-		candidateNumberGroupIndex = len(candidateGroups) - 1
+		candidateNumberGroupIndex = len(candidateGroups) - 2
 	}
 
 	// First we check if the national significant number is formatted
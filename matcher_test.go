@@ -0,0 +1,207 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestFindNumbers(t *testing.T) {
+	var tests = []struct {
+		text     string
+		region   string
+		leniency Leniency
+		raw      []string
+		starts   []int
+	}{
+		{
+			text:     "Call me at 650-253-0000 or +44 20 7031 3000 tomorrow.",
+			region:   "US",
+			leniency: VALID,
+			raw:      []string{"650-253-0000", "+44 20 7031 3000"},
+			starts:   []int{11, 27},
+		}, {
+			text:     "My office is (650) 253-0000 x123.",
+			region:   "US",
+			leniency: VALID,
+			raw:      []string{"(650) 253-0000 x123"},
+			starts:   []int{13},
+		}, {
+			// numbers glued to latin letters are only found when possible
+			text:     "abc8005001234",
+			region:   "US",
+			leniency: POSSIBLE,
+			raw:      []string{"8005001234"},
+			starts:   []int{3},
+		}, {
+			text:     "abc8005001234",
+			region:   "US",
+			leniency: VALID,
+		}, {
+			// dates aren't numbers
+			text:     "See you on 3/10/2011",
+			region:   "US",
+			leniency: POSSIBLE,
+		}, {
+			// timestamps aren't numbers either
+			text:     "2012-01-02 08:00",
+			region:   "US",
+			leniency: POSSIBLE,
+		}, {
+			text:     "No numbers here",
+			region:   "US",
+			leniency: POSSIBLE,
+		},
+	}
+
+	for i, test := range tests {
+		matches := FindNumbers(test.text, test.region, test.leniency, math.MaxInt64)
+		if len(matches) != len(test.raw) {
+			t.Errorf("[test %d] expected %d matches, got %d", i, len(test.raw), len(matches))
+			continue
+		}
+		for j, match := range matches {
+			if match.RawString != test.raw[j] {
+				t.Errorf("[test %d:%d] raw string %s != %s", i, j, match.RawString, test.raw[j])
+			}
+			if match.Start != test.starts[j] {
+				t.Errorf("[test %d:%d] start %d != %d", i, j, match.Start, test.starts[j])
+			}
+			if test.text[match.Start:match.End] != match.RawString {
+				t.Errorf("[test %d:%d] offsets don't point at raw string", i, j)
+			}
+			if match.Number.RawInput != nil || match.Number.CountryCodeSource != nil {
+				t.Errorf("[test %d:%d] expected raw input to be cleared", i, j)
+			}
+		}
+	}
+}
+
+func TestPhoneNumberMatcherMaxTries(t *testing.T) {
+	// the first candidate is invalid, so with a single try we never reach the valid one
+	text := "Call 1234 or 650-253-0000"
+
+	matcher := NewPhoneNumberMatcher(text, "US", VALID, 1)
+	if matcher.HasNext() {
+		t.Errorf("expected no match with a single try, got %s", matcher.Next().RawString)
+	}
+
+	matcher = NewPhoneNumberMatcher(text, "US", VALID, 10)
+	if !matcher.HasNext() {
+		t.Fatalf("expected a match")
+	}
+	match := matcher.Next()
+	if match.RawString != "650-253-0000" || match.Number.GetNationalNumber() != 6502530000 {
+		t.Errorf("unexpected match: %s", match.RawString)
+	}
+	if matcher.HasNext() || matcher.Next() != nil {
+		t.Errorf("expected matcher to be exhausted")
+	}
+}
+
+func TestCheckNumberGroupingIsValid(t *testing.T) {
+	var tests = []struct {
+		text     string
+		leniency Leniency
+		found    bool
+	}{
+		{text: "650-253-0000", leniency: STRICT_GROUPING, found: true},
+		{text: "650 2530000", leniency: STRICT_GROUPING, found: true},
+		{text: "6502 530 000", leniency: STRICT_GROUPING, found: false},
+		{text: "+44 20 7031 3000", leniency: STRICT_GROUPING, found: true},
+		{text: "+44 2070 313000", leniency: STRICT_GROUPING, found: false},
+		{text: "650-253-0000", leniency: EXACT_GROUPING, found: true},
+		{text: "(650) 253-0000 x123", leniency: EXACT_GROUPING, found: true},
+		{text: "6502530000", leniency: EXACT_GROUPING, found: true},
+		{text: "650 2530000", leniency: EXACT_GROUPING, found: false},
+		{text: "+41 44 668 18 00", leniency: EXACT_GROUPING, found: true},
+		{text: "+41 44 668 1800", leniency: EXACT_GROUPING, found: false},
+	}
+
+	for i, test := range tests {
+		found := len(FindNumbers(test.text, "US", test.leniency, math.MaxInt64)) == 1
+		if found != test.found {
+			t.Errorf("[test %d] %s: expected found %t, got %t", i, test.text, test.found, found)
+		}
+	}
+}
+
+func TestCheckNumberGroupingIsValidWithAlternateFormats(t *testing.T) {
+	number, err := Parse("+41 44 668 1800", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidate := "+41 44 668 1800"
+	exactlyPresent := func(number *PhoneNumber, normalizedCandidate string, groups []string) bool {
+		return AllNumberGroupsAreExactlyPresent(number, normalizedCandidate, groups)
+	}
+
+	metadata := *CurrentMetadata()
+	metadata.alternateFormatsMap = map[int]*PhoneMetadata{}
+	if metadata.CheckNumberGroupingIsValid(number, candidate, exactlyPresent) {
+		t.Errorf("expected grouping to be invalid without alternate formats")
+	}
+
+	metadata.alternateFormatsMap = map[int]*PhoneMetadata{
+		41: {
+			CountryCode: proto.Int32(41),
+			NumberFormat: []*NumberFormat{
+				{
+					Pattern:              proto.String(`(\d{2})(\d{3})(\d{4})`),
+					Format:               proto.String("$1 $2 $3"),
+					LeadingDigitsPattern: []string{"[2-9]"},
+				},
+			},
+		},
+	}
+	if !metadata.CheckNumberGroupingIsValid(number, candidate, exactlyPresent) {
+		t.Errorf("expected grouping to be valid using alternate formats")
+	}
+}
+
+func TestFindNumbersWithBuiltinAlternateFormats(t *testing.T) {
+	metadata := CurrentMetadata()
+	if len(metadata.alternateFormatsMap) == 0 {
+		t.Fatalf("no alternate formats built in, run buildmetadata to generate them")
+	}
+	withoutAlternates := *metadata
+	withoutAlternates.alternateFormatsMap = map[int]*PhoneMetadata{}
+
+	// group example numbers by the alternate formats of their country,
+	// counting those only found because of them
+	found := 0
+	for countryCode, alternates := range metadata.alternateFormatsMap {
+		regionCode := GetRegionCodeForCountryCode(countryCode)
+		for _, numberType := range EXAMPLE_NUMBER_TYPES {
+			number := GetExampleNumberForType(regionCode, numberType)
+			if number == nil {
+				continue
+			}
+			nsn := GetNationalSignificantNumber(number)
+			for _, format := range alternates.GetNumberFormat() {
+				leadingDigits := format.GetLeadingDigitsPattern()
+				if len(leadingDigits) > 0 && !regexFor("^(?:"+leadingDigits[0]+")").MatchString(nsn) {
+					continue
+				}
+				if !regexFor("^(?:" + format.GetPattern() + ")$").MatchString(nsn) {
+					continue
+				}
+				groups := getNationalNumberGroupsForPattern(number, format)
+				text := fmt.Sprintf("+%d %s", countryCode, strings.Join(groups, " "))
+				if len(withoutAlternates.FindNumbers(text, "", EXACT_GROUPING, math.MaxInt64)) > 0 {
+					continue
+				}
+				if len(FindNumbers(text, "", EXACT_GROUPING, math.MaxInt64)) != 1 {
+					t.Errorf("[test %s] failed: not found using alternate formats\n", text)
+				}
+				found++
+			}
+		}
+	}
+	if found == 0 {
+		t.Errorf("[test alternate formats] failed: no numbers only found using them\n")
+	}
+}
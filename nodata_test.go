@@ -0,0 +1,27 @@
+//go:build phonenumbers_nocarrier && phonenumbers_nogeocoding && phonenumbers_notimezone
+// +build phonenumbers_nocarrier,phonenumbers_nogeocoding,phonenumbers_notimezone
+
+package phonenumbers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupsWithoutData(t *testing.T) {
+	number, err := Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if carrier, err := GetCarrierForNumber(number, "en"); carrier != "" || err != nil {
+		t.Errorf("expected no carrier, got %s, %v", carrier, err)
+	}
+	if geocoding, err := GetGeocodingForNumber(number, "en"); geocoding != "" || err != nil {
+		t.Errorf("expected no geocoding, got %s, %v", geocoding, err)
+	}
+	timezones, err := GetTimezonesForNumber(number)
+	if err != nil || !reflect.DeepEqual(timezones, []string{UNKNOWN_TIMEZONE}) {
+		t.Errorf("expected unknown timezone, got %v, %v", timezones, err)
+	}
+}
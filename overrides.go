@@ -0,0 +1,297 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ErrInvalidOverrides is returned when metadata overrides can't be loaded
+// or applied
+var ErrInvalidOverrides = errors.New("invalid metadata overrides")
+
+// the overrides applied to all metadata put in use, nil if none
+var _metadataOverrides atomic.Value // *MetadataOverrides
+
+func getMetadataOverrides() *MetadataOverrides {
+	overrides, _ := _metadataOverrides.Load().(*MetadataOverrides)
+	return overrides
+}
+
+// MetadataOverrides are changes layered on top of metadata, such as ranges
+// operators launched before they were added upstream, or private numbering
+// plans. They are written in the schema of PhoneNumberMetadata.xml, with
+// one territory per region or non-geographical entity changed:
+//
+//   - a territory for a region or calling code not in the metadata adds it
+//     as a whole, and so needs its generalDesc like any other territory
+//   - the patterns and possible lengths of each number type in a territory
+//     for a region in the metadata are added to those of that type, or
+//     replace them if the type has replace="true"
+//   - the number formats of a territory for a region in the metadata are
+//     tried before those of the region
+type MetadataOverrides struct {
+	territories []TerritoryE
+}
+
+// LoadMetadataOverrides loads overrides from the passed in XML, or JSON
+// with the same structure, such as:
+//
+//	{"territories": [{"id": "GB", "countryCode": 44, "mobile": {
+//	    "nationalNumberPattern": "7000\\d{6}",
+//	    "possibleLengths": {"national": "10"}
+//	}}]}
+func LoadMetadataOverrides(data []byte) (*MetadataOverrides, error) {
+	document := &PhoneNumberMetadataE{}
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(data, document)
+	} else {
+		err = xml.Unmarshal(data, document)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrInvalidOverrides, err)
+	}
+
+	for _, territory := range document.Territories {
+		if territory.ID == "" {
+			return nil, fmt.Errorf("%s: territory without id", ErrInvalidOverrides)
+		}
+		if territory.ID == REGION_CODE_FOR_NON_GEO_ENTITY && territory.CountryCode == 0 {
+			return nil, fmt.Errorf("%s: non-geographical territory without countryCode", ErrInvalidOverrides)
+		}
+	}
+	return &MetadataOverrides{territories: document.Territories}, nil
+}
+
+// LoadMetadataOverridesFile loads overrides from the XML or JSON file at
+// the passed in path.
+func LoadMetadataOverridesFile(path string) (*MetadataOverrides, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadMetadataOverrides(data)
+}
+
+// SetMetadataOverrides applies the passed in overrides to the metadata in
+// use, and to all metadata put in use by later updates. Passing nil removes
+// any overrides. Returns an error, leaving the metadata in use unchanged,
+// if the overrides can't be applied or leave invalid example numbers.
+func SetMetadataOverrides(overrides *MetadataOverrides) error {
+	commitMetadataMutex.Lock()
+	defer commitMetadataMutex.Unlock()
+
+	metadata, err := loadMetadata(CurrentMetadata().raw, overrides)
+	if err != nil {
+		return err
+	}
+	if err := metadata.validateMetadata(); err != nil {
+		return err
+	}
+	_metadataOverrides.Store(overrides)
+	metadata.store()
+	return nil
+}
+
+// Apply returns a copy of the passed in metadata with the overrides
+// applied, for use with NewUtil. The passed in metadata isn't changed.
+func (o *MetadataOverrides) Apply(collection *PhoneMetadataCollection) (result *PhoneMetadataCollection, err error) {
+	// the builder panics on invalid metadata
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("%s: %v", ErrInvalidOverrides, r)
+		}
+	}()
+
+	result = &PhoneMetadataCollection{
+		Metadata: append([]*PhoneMetadata(nil), collection.GetMetadata()...),
+	}
+	changed := &PhoneMetadataCollection{}
+	for i := range o.territories {
+		territory := &o.territories[i]
+
+		var metadata *PhoneMetadata
+		index := findTerritoryMetadata(result, territory)
+		if index < 0 {
+			metadata = loadCountryMetadata(territory.ID, territory, false, false)
+			result.Metadata = append(result.Metadata, metadata)
+		} else {
+			metadata = proto.Clone(result.Metadata[index]).(*PhoneMetadata)
+			overrideMetadata(metadata, territory)
+			result.Metadata[index] = metadata
+		}
+		changed.Metadata = append(changed.Metadata, metadata)
+	}
+
+	if err := checkMetadataPatterns(changed); err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrInvalidOverrides, err)
+	}
+	return result, nil
+}
+
+// Returns the index of the metadata for the region, or non-geographical
+// calling code, of the passed in territory, -1 if there is none.
+func findTerritoryMetadata(collection *PhoneMetadataCollection, territory *TerritoryE) int {
+	for i, metadata := range collection.Metadata {
+		if metadata.GetId() != territory.ID {
+			continue
+		}
+		if territory.ID != REGION_CODE_FOR_NON_GEO_ENTITY || metadata.GetCountryCode() == territory.CountryCode {
+			return i
+		}
+	}
+	return -1
+}
+
+// Applies the number types and formats of the passed in territory to the
+// passed in metadata of the same region.
+func overrideMetadata(metadata *PhoneMetadata, territory *TerritoryE) {
+	descs := []struct {
+		desc    **PhoneNumberDesc
+		element *PhoneNumberDescE
+	}{
+		{&metadata.FixedLine, territory.FixedLine},
+		{&metadata.Mobile, territory.Mobile},
+		{&metadata.Pager, territory.Pager},
+		{&metadata.TollFree, territory.TollFree},
+		{&metadata.PremiumRate, territory.PremiumRate},
+		{&metadata.SharedCost, territory.SharedCost},
+		{&metadata.PersonalNumber, territory.PersonalNumber},
+		{&metadata.Voip, territory.VOIP},
+		{&metadata.Uan, territory.UAN},
+		{&metadata.Voicemail, territory.VoiceMail},
+		{&metadata.NoInternationalDialling, territory.NoInternationalDialing},
+	}
+
+	generalDesc := metadata.GetGeneralDesc()
+	for _, d := range descs {
+		if d.element == nil {
+			continue
+		}
+		// types without lengths of their own have those of the general
+		// description, which is about to change
+		for _, other := range descs {
+			if hasNationalNumberPattern(*other.desc) && len((*other.desc).PossibleLength) == 0 {
+				(*other.desc).PossibleLength = append([]int32(nil), generalDesc.GetPossibleLength()...)
+			}
+		}
+
+		*d.desc = overrideDesc(*d.desc, d.element, d.element.Replace)
+
+		// the general description covers all the types
+		generalDesc = overrideDesc(generalDesc, &PhoneNumberDescE{
+			NationalNumberPattern: d.element.NationalNumberPattern,
+			PossibleLengths:       d.element.PossibleLengths,
+		}, false)
+	}
+	metadata.GeneralDesc = generalDesc
+
+	if metadata.GetMobile().GetNationalNumberPattern() == metadata.GetFixedLine().GetNationalNumberPattern() {
+		metadata.SameMobileAndFixedLinePattern = bp(true)
+	} else {
+		metadata.SameMobileAndFixedLinePattern = nil
+	}
+
+	if len(territory.AvailableFormats) > 0 {
+		nationalPrefix := territory.NationalPrefix
+		if nationalPrefix == "" {
+			nationalPrefix = metadata.GetNationalPrefix()
+		}
+		formats := &PhoneMetadata{Id: metadata.Id}
+		loadAvailableFormats(formats, territory, nationalPrefix,
+			getNationalPrefixFormattingRule(territory.NationalPrefixFormattingRule, nationalPrefix),
+			territory.NationalPrefixOptionalWhenFormatting)
+
+		// no international formats means they are the same as the national
+		// ones, so either both have them or neither does
+		if len(formats.IntlNumberFormat) > 0 && len(metadata.IntlNumberFormat) == 0 {
+			metadata.IntlNumberFormat = cloneNumberFormats(metadata.NumberFormat)
+		} else if len(formats.IntlNumberFormat) == 0 && len(metadata.IntlNumberFormat) > 0 {
+			formats.IntlNumberFormat = cloneNumberFormats(formats.NumberFormat)
+		}
+		metadata.NumberFormat = append(formats.NumberFormat, metadata.NumberFormat...)
+		metadata.IntlNumberFormat = append(formats.IntlNumberFormat, metadata.IntlNumberFormat...)
+	}
+}
+
+// Returns the passed in description with the pattern, possible lengths and
+// example number of the passed in element added, or replacing them.
+func overrideDesc(desc *PhoneNumberDesc, element *PhoneNumberDescE, replace bool) *PhoneNumberDesc {
+	result := &PhoneNumberDesc{}
+	if desc != nil {
+		result = proto.Clone(desc).(*PhoneNumberDesc)
+	}
+	if !hasNationalNumberPattern(result) {
+		replace = true
+	}
+
+	if element.NationalNumberPattern != "" {
+		pattern := validateRE(element.NationalNumberPattern, true)
+		if replace {
+			result.NationalNumberPattern = sp(pattern)
+		} else {
+			result.NationalNumberPattern = sp(result.GetNationalNumberPattern() + "|" + pattern)
+		}
+	}
+
+	if element.PossibleLengths != nil {
+		lengths := parsePossibleLengthStringToSet(element.PossibleLengths.National)
+		localOnlyLengths := make(map[int32]bool)
+		if element.PossibleLengths.LocalOnly != "" {
+			localOnlyLengths = parsePossibleLengthStringToSet(element.PossibleLengths.LocalOnly)
+		}
+		if !replace {
+			for _, length := range result.PossibleLength {
+				lengths[length] = true
+			}
+			for _, length := range result.PossibleLengthLocalOnly {
+				localOnlyLengths[length] = true
+			}
+		}
+		result.PossibleLength = sortedLengths(lengths)
+		result.PossibleLengthLocalOnly = sortedLengths(localOnlyLengths)
+	} else if replace && !hasNationalNumberPattern(desc) {
+		// lengths of a type which had no numbers, those of the general
+		// description are used instead
+		result.PossibleLength = nil
+		result.PossibleLengthLocalOnly = nil
+	}
+
+	if element.ExampleNumber != "" {
+		result.ExampleNumber = sp(element.ExampleNumber)
+	}
+	return result
+}
+
+// Returns whether the passed in description has any numbers.
+func hasNationalNumberPattern(desc *PhoneNumberDesc) bool {
+	pattern := desc.GetNationalNumberPattern()
+	return pattern != "" && pattern != "NA"
+}
+
+func sortedLengths(lengths map[int32]bool) []int32 {
+	var sorted []int32
+	for length := range lengths {
+		if length != -1 {
+			sorted = append(sorted, length)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func cloneNumberFormats(formats []*NumberFormat) []*NumberFormat {
+	clones := make([]*NumberFormat, len(formats))
+	for i, format := range formats {
+		clones[i] = proto.Clone(format).(*NumberFormat)
+	}
+	return clones
+}
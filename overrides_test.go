@@ -0,0 +1,226 @@
+package phonenumbers
+
+import (
+	"testing"
+)
+
+var testOverridesXML = []byte(`<phoneNumberMetadata>
+  <territories>
+    <territory id="GB" countryCode="44" nationalPrefixFormattingRule="$NP$FG">
+      <availableFormats>
+        <numberFormat pattern="(\d{4})(\d{6})">
+          <leadingDigits>60</leadingDigits>
+          <format>$1 $2</format>
+        </numberFormat>
+      </availableFormats>
+      <mobile>
+        <nationalNumberPattern>60\d{8}</nationalNumberPattern>
+        <possibleLengths national="10"/>
+      </mobile>
+    </territory>
+    <territory id="XP" countryCode="999" internationalPrefix="00">
+      <availableFormats>
+        <numberFormat pattern="(\d{2})(\d{2})">
+          <format>$1 $2</format>
+        </numberFormat>
+      </availableFormats>
+      <generalDesc>
+        <nationalNumberPattern>\d{4}</nationalNumberPattern>
+      </generalDesc>
+      <fixedLine>
+        <nationalNumberPattern>[2-8]\d{3}</nationalNumberPattern>
+        <possibleLengths national="4"/>
+        <exampleNumber>2345</exampleNumber>
+      </fixedLine>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`)
+
+var testOverridesJSON = []byte(`{"territories": [
+  {"id": "GB", "countryCode": 44, "nationalPrefixFormattingRule": "$NP$FG",
+   "availableFormats": [{"pattern": "(\\d{4})(\\d{6})", "leadingDigits": ["60"], "format": "$1 $2"}],
+   "mobile": {"nationalNumberPattern": "60\\d{8}", "possibleLengths": {"national": "10"}}},
+  {"id": "XP", "countryCode": 999, "internationalPrefix": "00",
+   "availableFormats": [{"pattern": "(\\d{2})(\\d{2})", "format": "$1 $2"}],
+   "generalDesc": {"nationalNumberPattern": "\\d{4}"},
+   "fixedLine": {"nationalNumberPattern": "[2-8]\\d{3}", "possibleLengths": {"national": "4"}, "exampleNumber": "2345"}}
+]}`)
+
+var overriddenNumberTests = []struct {
+	num           string
+	valid         bool
+	numType       PhoneNumberType
+	region        string
+	national      string
+	international string
+}{
+	// added to GB
+	{"+446012345678", true, MOBILE, "GB", "06012 345678", "+44 6012 345678"},
+	// GB numbers which were valid before still are
+	{"+447400123456", true, MOBILE, "GB", "07400 123456", "+44 7400 123456"},
+	{"+442071838750", true, FIXED_LINE, "GB", "020 7183 8750", "+44 20 7183 8750"},
+	// a private region
+	{"+9992345", true, FIXED_LINE, "XP", "23 45", "+999 23 45"},
+	{"+9991234", false, UNKNOWN, "XP", "12 34", "+999 12 34"},
+}
+
+func TestMetadataOverrides(t *testing.T) {
+	for _, data := range [][]byte{testOverridesXML, testOverridesJSON} {
+		overrides, err := LoadMetadataOverrides(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		collection, err := overrides.Apply(getCurrMetadataColl())
+		if err != nil {
+			t.Fatal(err)
+		}
+		util, err := NewUtil(collection, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, test := range overriddenNumberTests {
+			num, err := util.Parse(test.num, "")
+			if err != nil {
+				t.Errorf("[test %d:parse] failed: %v\n", i, err)
+				continue
+			}
+			if valid := util.IsValidNumber(num); valid != test.valid {
+				t.Errorf("[test %d:valid] failed: %v != %v\n", i, valid, test.valid)
+			}
+			if numType := util.GetNumberType(num); numType != test.numType {
+				t.Errorf("[test %d:type] failed: %v != %v\n", i, numType, test.numType)
+			}
+			if region := util.GetRegionCodeForNumber(num); test.valid && region != test.region {
+				t.Errorf("[test %d:region] failed: %s != %s\n", i, region, test.region)
+			}
+			if national := util.Format(num, NATIONAL); national != test.national {
+				t.Errorf("[test %d:national] failed: %s != %s\n", i, national, test.national)
+			}
+			if international := util.Format(num, INTERNATIONAL); international != test.international {
+				t.Errorf("[test %d:international] failed: %s != %s\n", i, international, test.international)
+			}
+		}
+	}
+
+	// the metadata the overrides were applied to isn't changed
+	num, err := Parse("+446012345678", "")
+	if err != nil || IsValidNumber(num) {
+		t.Errorf("[test unchanged] failed: %v\n", err)
+	}
+}
+
+func TestMetadataOverridesReplace(t *testing.T) {
+	overrides, err := LoadMetadataOverrides([]byte(`<phoneNumberMetadata><territories>
+      <territory id="GB" countryCode="44">
+        <mobile replace="true">
+          <nationalNumberPattern>7400\d{6}</nationalNumberPattern>
+          <exampleNumber>7400123456</exampleNumber>
+        </mobile>
+      </territory>
+    </territories></phoneNumberMetadata>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection, err := overrides.Apply(getCurrMetadataColl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	util, err := NewUtil(collection, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		num     string
+		numType PhoneNumberType
+	}{
+		{"+447400123456", MOBILE},
+		{"+447500123456", UNKNOWN},
+		{"+442071838750", FIXED_LINE},
+	}
+	for i, test := range tests {
+		num, err := util.Parse(test.num, "")
+		if err != nil {
+			t.Errorf("[test %d:parse] failed: %v\n", i, err)
+			continue
+		}
+		if numType := util.GetNumberType(num); numType != test.numType {
+			t.Errorf("[test %d:type] failed: %v != %v\n", i, numType, test.numType)
+		}
+	}
+}
+
+func TestSetMetadataOverrides(t *testing.T) {
+	original := CurrentMetadata()
+	defer original.store()
+	defer _metadataOverrides.Store((*MetadataOverrides)(nil))
+
+	overrides, err := LoadMetadataOverrides(testOverridesXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetMetadataOverrides(overrides); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(name string, expected bool) {
+		for i, test := range overriddenNumberTests[:1] {
+			num, err := Parse(test.num, "")
+			if err != nil || IsValidNumber(num) != expected {
+				t.Errorf("[test %s:%d] failed: %v, %v != %v\n", name, i, IsValidNumber(num), err, expected)
+			}
+		}
+		if _, err := Parse("+9992345", ""); (err == nil) != expected {
+			t.Errorf("[test %s:private] failed: %v\n", name, err)
+		}
+	}
+	check("set", true)
+
+	// updated metadata has them applied too
+	raw := *original.raw
+	raw.Version = "2030-01-01T00:00:00Z"
+	if err := commitMetadata(&raw, ""); err != nil {
+		t.Fatal(err)
+	}
+	check("updated", true)
+
+	if err := SetMetadataOverrides(nil); err != nil {
+		t.Fatal(err)
+	}
+	check("removed", false)
+	if getVersion() != raw.Version {
+		t.Errorf("[test version] failed: %s != %s\n", getVersion(), raw.Version)
+	}
+}
+
+func TestMetadataOverridesErrors(t *testing.T) {
+	tests := []string{
+		`<phoneNumberMetadata><territories><territory id="GB"`,
+		`{"territories": [{"countryCode": 44}]}`,
+		`{"territories": [{"id": "001"}]}`,
+	}
+	for i, test := range tests {
+		if _, err := LoadMetadataOverrides([]byte(test)); err == nil {
+			t.Errorf("[test %d:load] failed: expected error\n", i)
+		}
+	}
+
+	applyTests := []string{
+		`{"territories": [{"id": "GB", "countryCode": 44, "mobile": {"nationalNumberPattern": "7("}}]}`,
+		`{"territories": [{"id": "GB", "countryCode": 44, "mobile": {"nationalNumberPattern": "70\\d{8}", "possibleLengths": {"national": ""}}}]}`,
+	}
+	for i, test := range applyTests {
+		overrides, err := LoadMetadataOverrides([]byte(test))
+		if err != nil {
+			t.Errorf("[test %d:load] failed: %v\n", i, err)
+			continue
+		}
+		if _, err := overrides.Apply(getCurrMetadataColl()); err == nil {
+			t.Errorf("[test %d:apply] failed: expected error\n", i)
+		}
+		if err := SetMetadataOverrides(overrides); err == nil {
+			t.Errorf("[test %d:set] failed: expected error\n", i)
+		}
+	}
+}
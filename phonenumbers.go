@@ -78,6 +78,21 @@ var (
 		54: "9",
 	}
 
+	// Set of country calling codes that have geographically assigned
+	// mobile numbers. This may not be complete; we add calling codes case
+	// by case, as we find geographical mobile numbers or hear from user
+	// reports. Note that countries like the US, where we can't distinguish
+	// between fixed-line or mobile numbers, are not listed here, since we
+	// consider FIXED_LINE_OR_MOBILE to be a possibly geographically-related
+	// type anyway (like FIXED_LINE).
+	GEO_MOBILE_COUNTRIES = map[int]bool{
+		52: true, // Mexico
+		54: true, // Argentina
+		55: true, // Brazil
+		62: true, // Indonesia: some prefixes only (fixed CMDA wireless)
+		86: true, // China
+	}
+
 	// A map that contains characters that are essential when dialling.
 	// That means any of the characters in this map must not be removed
 	// from a number when dialling, otherwise the call will not reach
@@ -393,7 +408,7 @@ var (
 	// formatting rule has the first group only, i.e., does not start
 	// with the national prefix. Note that the pattern explicitly allows
 	// for unbalanced parentheses.
-	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`\(?\$1\)?`)
+	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`^\(?\$1\)?$`)
 
 	REGION_CODE_FOR_NON_GEO_ENTITY = "001"
 )
@@ -495,39 +510,45 @@ const (
 	EXACT_GROUPING
 )
 
+// Verify returns whether the passed in number, found as candidate in text,
+// is acceptable at this leniency.
 func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
+	return l.verify(CurrentMetadata(), number, candidate)
+}
+
+func (l Leniency) verify(md *Metadata, number *PhoneNumber, candidate string) bool {
 
 	switch l {
 	case POSSIBLE:
-		return IsPossibleNumber(number)
+		return md.IsPossibleNumber(number)
 	case VALID:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) {
+		if !md.IsValidNumber(number) ||
+			!md.ContainsOnlyValidXChars(number, candidate) {
 			return false
 		}
-		return IsNationalPrefixPresentIfRequired(number)
+		return md.IsNationalPrefixPresentIfRequired(number)
 	case STRICT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !md.IsValidNumber(number) ||
+			!md.ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!md.IsNationalPrefixPresentIfRequired(number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return md.CheckNumberGroupingIsValid(number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
-				return AllNumberGroupsRemainGrouped(
+				return md.AllNumberGroupsRemainGrouped(
 					number, normalizedCandidate, expectedNumberGroups)
 			})
 	case EXACT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !md.IsValidNumber(number) ||
+			!md.ContainsOnlyValidXChars(number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!md.IsNationalPrefixPresentIfRequired(number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return md.CheckNumberGroupingIsValid(number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
@@ -539,95 +560,46 @@ func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
 }
 
 var (
-	// golang map is not go routine safe. Sometimes process exiting
-	// because of panic. So adding mutex to synchronize the operation.
-
-	// The set of regions that share country calling code 1.
-	// There are roughly 26 regions.
-	_nanpaRegions atomic.Value // = make(map[string]struct{})
-
-	// A mapping from a region code to the PhoneMetadata for that region.
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	_regionToMetadataMap atomic.Value // = make(map[string]*PhoneMetadata)
-
-	// A mapping from a country calling code for a non-geographical
-	// entity to the PhoneMetadata for that country calling code.
-	// Examples of the country calling codes include 800 (International
-	// Toll Free Service) and 808 (International Shared Cost Service).
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	_countryCodeToNonGeographicalMetadataMap atomic.Value // = make(map[int]*PhoneMetadata)
-
 	// A cache for frequently used region-specific regular expressions.
 	// The initial capacity is set to 100 as this seems to be an optimal
 	// value for Android, based on performance measurements.
 	regexCache    = make(map[string]*regexp.Regexp)
 	regCacheMutex sync.RWMutex
 
-	// The set of regions the library supports.
-	// There are roughly 240 of them and we set the initial capacity of
-	// the HashSet to 320 to offer a load factor of roughly 0.75.
-	_supportedRegions atomic.Value // = make(map[string]bool, 320)
-
-	// The set of calling codes that map to the non-geo entity
-	// region ("001"). This set currently contains < 12 elements so the
-	// default capacity of 16 (load factor=0.75) is fine.
-	_countryCodesForNonGeographicalRegion atomic.Value // = make(map[int]bool, 16)
-
-	// carrierPrefixMap is maps for our prefix to carrier maps
-	_carrierPrefixMap atomic.Value // = make(map[string]*intStringMap)
-
-	// _geocodingPrefixMap is maps for our prefix to geocoding maps
-	_geocodingPrefixMap atomic.Value //  = make(map[string]*intStringMap)
-
-	// All the calling codes we support
-	_supportedCallingCodes atomic.Value // = make(map[int]bool, 320)
-
-	_timezoneMap atomic.Value // *intStringArrayMap
-
-	// Our map from country code (as integer) to two letter region codes
-	_countryCodeToRegion atomic.Value // map[int][]string
+	// the metadata in use, replaced as a whole by updates
+	_metadata atomic.Value // *Metadata
 )
 
-func getNnanpaRegions() map[string]struct{} {
-	return _nanpaRegions.Load().(map[string]struct{})
-}
-
-func getRegionToMetadataMap() map[string]*PhoneMetadata {
-	return _regionToMetadataMap.Load().(map[string]*PhoneMetadata)
+// CurrentMetadata returns the metadata in use. The package level functions
+// use whatever metadata is in use when they are called, while the methods
+// of the returned metadata keep using it even after an update, so that
+// everything done with them sees the same version.
+func CurrentMetadata() *Metadata {
+	return _metadata.Load().(*Metadata)
 }
 
-func getCountryCodeToNonGeographicalMetadataMap() map[int]*PhoneMetadata {
-	return _countryCodeToNonGeographicalMetadataMap.Load().(map[int]*PhoneMetadata)
+func getCarrierPrefixMap() *prefixMapCache {
+	return CurrentMetadata().carrierPrefixMap
 }
 
-func getSupportedRegions() map[string]bool {
-	return _supportedRegions.Load().(map[string]bool)
+func getGeocodingPrefixMap() *prefixMapCache {
+	return CurrentMetadata().geocodingPrefixMap
 }
 
-func getCountryCodesForNonGeographicalRegion() map[int]bool {
-	return _countryCodesForNonGeographicalRegion.Load().(map[int]bool)
-}
-
-func getCarrierPrefixMap() map[string]*intStringMap {
-	return _carrierPrefixMap.Load().(map[string]*intStringMap)
-}
-
-func getGeocodingPrefixMap() map[string]*intStringMap {
-	return _geocodingPrefixMap.Load().(map[string]*intStringMap)
-}
-
-func getSupportedCallingCodes() map[int]bool {
-	return _supportedCallingCodes.Load().(map[int]bool)
+func getTimezoneMap() *intStringArrayMap {
+	return CurrentMetadata().timezoneMap
 }
 
-func getTimezoneMap() *intStringArrayMap {
-	return _timezoneMap.Load().(*intStringArrayMap)
+// Returns the alternate formats for the given country calling code, or
+// nil if there are none.
+func (md *Metadata) getAlternateFormatsForCountry(countryCallingCode int) *PhoneMetadata {
+	return md.alternateFormatsMap[countryCallingCode]
 }
 
-func getCountryCodeToRegion() map[int][]string {
-	return _countryCodeToRegion.Load().(map[int][]string)
+// Returns the short number metadata for the given region code, or nil
+// if there is none.
+func (md *Metadata) getShortNumberMetadataForRegion(regionCode string) *PhoneMetadata {
+	return md.shortNumberMetadataMap[regionCode]
 }
 
 var ErrEmptyMetadata = errors.New("empty metadata")
@@ -654,35 +626,31 @@ func regexFor(pattern string) *regexp.Regexp {
 	return regex
 }
 
-func readFromNanpaRegions(key string) (struct{}, bool) {
-	v, ok := getNnanpaRegions()[key]
+func (md *Metadata) readFromNanpaRegions(key string) (struct{}, bool) {
+	v, ok := md.nanpaRegions[key]
 	return v, ok
 }
 
-func readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
-	v, ok := getRegionToMetadataMap()[key]
+func (md *Metadata) readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
+	v, ok := md.regionToMetadataMap[key]
 	return v, ok
 }
 
-func readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata, bool) {
-	v, ok := getCountryCodeToNonGeographicalMetadataMap()[key]
+func (md *Metadata) readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata, bool) {
+	v, ok := md.countryCodeToNonGeographicalMetadataMap[key]
 	return v, ok
 }
 
-var (
-	_currMetadataColl atomic.Value // *PhoneMetadataCollection
-)
-
 func getCurrMetadataColl() *PhoneMetadataCollection {
-	return _currMetadataColl.Load().(*PhoneMetadataCollection)
+	return CurrentMetadata().collection
 }
 
 func MetadataCollection() (*PhoneMetadataCollection, error) {
-	if _currMetadataColl.Load() != nil {
-		return getCurrMetadataColl(), nil
-	}
+	return getCurrMetadataColl(), nil
+}
 
-	rawBytes, err := decodeUnzipString(getMetadataData())
+func loadMetadataCollection(data string) (*PhoneMetadataCollection, error) {
+	rawBytes, err := decodeUnzipString(data)
 	if err != nil {
 		return nil, err
 	}
@@ -873,7 +841,13 @@ func ConvertAlphaCharactersInNumber(number string) string {
 //    non-geographical entities
 //  - some geographical numbers have no area codes.
 func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+	return CurrentMetadata().GetLengthOfGeographicalAreaCode(number)
+}
+
+// GetLengthOfGeographicalAreaCode is the same as the package level
+// GetLengthOfGeographicalAreaCode, using this metadata.
+func (md *Metadata) GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
+	metadata := md.getMetadataForRegion(md.GetRegionCodeForNumber(number))
 	if metadata == nil {
 		return 0
 	}
@@ -885,11 +859,11 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 		return 0
 	}
 
-	if !isNumberGeographical(number) {
+	if !md.isNumberGeographical(number) {
 		return 0
 	}
 
-	return GetLengthOfNationalDestinationCode(number)
+	return md.GetLengthOfNationalDestinationCode(number)
 }
 
 // Gets the length of the national destination code (NDC) from the
@@ -921,6 +895,12 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 // Refer to the unittests to see the difference between this function and
 // GetLengthOfGeographicalAreaCode().
 func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
+	return CurrentMetadata().GetLengthOfNationalDestinationCode(number)
+}
+
+// GetLengthOfNationalDestinationCode is the same as the package level
+// GetLengthOfNationalDestinationCode, using this metadata.
+func (md *Metadata) GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	var copiedProto *PhoneNumber
 	if len(number.GetExtension()) > 0 {
 		// We don't want to alter the proto given to us, but we don't
@@ -933,7 +913,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 		copiedProto = number
 	}
 
-	nationalSignificantNumber := Format(copiedProto, INTERNATIONAL)
+	nationalSignificantNumber := md.Format(copiedProto, INTERNATIONAL)
 	numberGroups := DIGITS_PATTERN.FindAllString(nationalSignificantNumber, -1)
 
 	// The pattern will start with "+COUNTRY_CODE " so the first group
@@ -943,7 +923,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	if len(numberGroups) <= 3 {
 		return 0
 	}
-	if GetNumberType(number) == MOBILE {
+	if md.GetNumberType(number) == MOBILE {
 		// For example Argentinian mobile numbers, when formatted in
 		// the international format, are in the form of +54 9 NDC XXXX....
 		// As a result, we take the length of the third group (NDC) and
@@ -992,7 +972,13 @@ func normalizeHelper(number string,
 
 // GetSupportedRegions returns all regions the library has metadata for.
 func GetSupportedRegions() map[string]bool {
-	return getSupportedRegions()
+	return CurrentMetadata().GetSupportedRegions()
+}
+
+// GetSupportedRegions is the same as the package level
+// GetSupportedRegions, using this metadata.
+func (md *Metadata) GetSupportedRegions() map[string]bool {
+	return md.supportedRegions
 }
 
 // GetSupportedCallingCodes returns all country calling codes the library has metadata for, covering both non-geographical
@@ -1000,12 +986,24 @@ func GetSupportedRegions() map[string]bool {
 // used to populate a drop-down box of country calling codes for a phone-number widget, for
 // instance.
 func GetSupportedCallingCodes() map[int]bool {
-	return getSupportedCallingCodes()
+	return CurrentMetadata().GetSupportedCallingCodes()
+}
+
+// GetSupportedCallingCodes is the same as the package level
+// GetSupportedCallingCodes, using this metadata.
+func (md *Metadata) GetSupportedCallingCodes() map[int]bool {
+	return md.supportedCallingCodes
 }
 
 // GetSupportedGlobalNetworkCallingCodes returns all global network calling codes the library has metadata for.
 func GetSupportedGlobalNetworkCallingCodes() map[int]bool {
-	return getCountryCodesForNonGeographicalRegion()
+	return CurrentMetadata().GetSupportedGlobalNetworkCallingCodes()
+}
+
+// GetSupportedGlobalNetworkCallingCodes is the same as the package level
+// GetSupportedGlobalNetworkCallingCodes, using this metadata.
+func (md *Metadata) GetSupportedGlobalNetworkCallingCodes() map[int]bool {
+	return md.countryCodesForNonGeographicalRegion
 }
 
 // Helper function to check if the national prefix formatting rule has the
@@ -1024,23 +1022,32 @@ func formattingRuleHasFirstGroupOnly(nationalPrefixFormattingRule string) bool {
 // overlap for geocodable and non-geocodable numbers. Also, if new phone
 // number types were added, we should check if this other method should be
 // updated too.
-func isNumberGeographical(phoneNumber *PhoneNumber) bool {
-	numberType := GetNumberType(phoneNumber)
+func (md *Metadata) isNumberGeographical(phoneNumber *PhoneNumber) bool {
+	numberType := md.GetNumberType(phoneNumber)
 	// TODO: Include mobile phone numbers from countries like Indonesia,
 	// which has some mobile numbers that are geographical.
 	return numberType == FIXED_LINE ||
 		numberType == FIXED_LINE_OR_MOBILE
 }
 
+// Tests whether a phone number type with the passed in country calling
+// code can be geocoded. Unlike isNumberGeographical, this includes the
+// mobile numbers of GEO_MOBILE_COUNTRIES, as upstream's geocoder does.
+func isNumberTypeGeographical(numberType PhoneNumberType, countryCallingCode int) bool {
+	return numberType == FIXED_LINE ||
+		numberType == FIXED_LINE_OR_MOBILE ||
+		(GEO_MOBILE_COUNTRIES[countryCallingCode] && numberType == MOBILE)
+}
+
 // Helper function to check region code is not unknown or null.
-func isValidRegionCode(regionCode string) bool {
-	valid := getSupportedRegions()[regionCode]
+func (md *Metadata) isValidRegionCode(regionCode string) bool {
+	valid := md.supportedRegions[regionCode]
 	return len(regionCode) != 0 && valid
 }
 
 // Helper function to check the country calling code is valid.
-func hasValidCountryCallingCode(countryCallingCode int) bool {
-	_, containsKey := getCountryCodeToRegion()[countryCallingCode]
+func (md *Metadata) hasValidCountryCallingCode(countryCallingCode int) bool {
+	_, containsKey := md.countryCodeToRegion[countryCallingCode]
 	return containsKey
 }
 
@@ -1055,6 +1062,11 @@ func hasValidCountryCallingCode(countryCallingCode int) bool {
 // formatting rules to apply so we return the national significant number
 // with no formatting applied.
 func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
+	return CurrentMetadata().Format(number, numberFormat)
+}
+
+// Format is the same as the package level Format, using this metadata.
+func (md *Metadata) Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 	if number.GetNationalNumber() == 0 && len(number.GetRawInput()) > 0 {
 		// Unparseable numbers that kept their raw input just use that.
 		// This is the only case where a number can be formatted as E164
@@ -1068,7 +1080,7 @@ func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 		}
 	}
 	var formattedNumber = NewBuilder(nil)
-	FormatWithBuf(number, numberFormat, formattedNumber)
+	md.FormatWithBuf(number, numberFormat, formattedNumber)
 	return formattedNumber.String()
 }
 
@@ -1076,6 +1088,12 @@ func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 // StringBuilder as a parameter to decrease object creation when invoked
 // many times.
 func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formattedNumber *Builder) {
+	CurrentMetadata().FormatWithBuf(number, numberFormat, formattedNumber)
+}
+
+// FormatWithBuf is the same as the package level FormatWithBuf, using this
+// metadata.
+func (md *Metadata) FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formattedNumber *Builder) {
 	// Clear the StringBuilder first.
 	formattedNumber.Reset()
 	countryCallingCode := int(number.GetCountryCode())
@@ -1088,7 +1106,7 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 		formattedNumber.WriteString(nationalSignificantNumber)
 		prefixNumberWithCountryCallingCode(countryCallingCode, E164, formattedNumber)
 		return
-	} else if !hasValidCountryCallingCode(countryCallingCode) {
+	} else if !md.hasValidCountryCallingCode(countryCallingCode) {
 		formattedNumber.WriteString(nationalSignificantNumber)
 		return
 	}
@@ -1096,12 +1114,12 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCallingCode)
 
 	// Metadata cannot be null because the country calling code is
 	// valid (which means that the region code cannot be ZZ and must
 	// be one of our supported region codes).
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := md.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 
 	formattedNumber.WriteString(formatNsn(nationalSignificantNumber, metadata, numberFormat))
 	maybeAppendFormattedExtension(number, metadata, numberFormat, formattedNumber)
@@ -1117,19 +1135,27 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 func FormatByPattern(number *PhoneNumber,
 	numberFormat PhoneNumberFormat,
 	userDefinedFormats []*NumberFormat) string {
+	return CurrentMetadata().FormatByPattern(number, numberFormat, userDefinedFormats)
+}
+
+// FormatByPattern is the same as the package level FormatByPattern, using
+// this metadata.
+func (md *Metadata) FormatByPattern(number *PhoneNumber,
+	numberFormat PhoneNumberFormat,
+	userDefinedFormats []*NumberFormat) string {
 
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !md.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For example,
 	// for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := md.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 
 	formattedNumber := NewBuilder(nil)
 
@@ -1180,18 +1206,24 @@ func FormatByPattern(number *PhoneNumber,
 // carrier code stored. If carrierCode contains an empty string, returns
 // the number in national format without any carrier code.
 func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string) string {
+	return CurrentMetadata().FormatNationalNumberWithCarrierCode(number, carrierCode)
+}
+
+// FormatNationalNumberWithCarrierCode is the same as the package level
+// FormatNationalNumberWithCarrierCode, using this metadata.
+func (md *Metadata) FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string) string {
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !md.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := md.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 
 	formattedNumber := NewBuilder(nil)
 	formattedNumber.WriteString(
@@ -1208,11 +1240,11 @@ func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string
 	return formattedNumber.String()
 }
 
-func getMetadataForRegionOrCallingCode(countryCallingCode int, regionCode string) *PhoneMetadata {
+func (md *Metadata) getMetadataForRegionOrCallingCode(countryCallingCode int, regionCode string) *PhoneMetadata {
 	if REGION_CODE_FOR_NON_GEO_ENTITY == regionCode {
-		return getMetadataForNonGeographicalRegion(countryCallingCode)
+		return md.getMetadataForNonGeographicalRegion(countryCallingCode)
 	}
-	return getMetadataForRegion(regionCode)
+	return md.getMetadataForRegion(regionCode)
 }
 
 // Formats a phone number in national format for dialing using the carrier
@@ -1228,12 +1260,20 @@ func getMetadataForRegionOrCallingCode(countryCallingCode int, regionCode string
 func FormatNationalNumberWithPreferredCarrierCode(
 	number *PhoneNumber,
 	fallbackCarrierCode string) string {
+	return CurrentMetadata().FormatNationalNumberWithPreferredCarrierCode(number, fallbackCarrierCode)
+}
+
+// FormatNationalNumberWithPreferredCarrierCode is the same as the package
+// level FormatNationalNumberWithPreferredCarrierCode, using this metadata.
+func (md *Metadata) FormatNationalNumberWithPreferredCarrierCode(
+	number *PhoneNumber,
+	fallbackCarrierCode string) string {
 
 	pref := number.GetPreferredDomesticCarrierCode()
 	if number.GetPreferredDomesticCarrierCode() == "" {
 		pref = fallbackCarrierCode
 	}
-	return FormatNationalNumberWithCarrierCode(number, pref)
+	return md.FormatNationalNumberWithCarrierCode(number, pref)
 }
 
 // Returns a number formatted in such a way that it can be dialed from a
@@ -1244,9 +1284,18 @@ func FormatNumberForMobileDialing(
 	number *PhoneNumber,
 	regionCallingFrom string,
 	withFormatting bool) string {
+	return CurrentMetadata().FormatNumberForMobileDialing(number, regionCallingFrom, withFormatting)
+}
+
+// FormatNumberForMobileDialing is the same as the package level
+// FormatNumberForMobileDialing, using this metadata.
+func (md *Metadata) FormatNumberForMobileDialing(
+	number *PhoneNumber,
+	regionCallingFrom string,
+	withFormatting bool) string {
 
 	countryCallingCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !md.hasValidCountryCallingCode(countryCallingCode) {
 		return number.GetRawInput() // go impl defaults to ""
 	}
 
@@ -1256,8 +1305,8 @@ func FormatNumberForMobileDialing(
 	var numberNoExt = &PhoneNumber{}
 	proto.Merge(numberNoExt, number)
 	numberNoExt.Extension = nil // can we assume this is safe? (no nil-pointer?)
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
-	numberType := GetNumberType(numberNoExt)
+	regionCode := md.GetRegionCodeForCountryCode(countryCallingCode)
+	numberType := md.GetNumberType(numberNoExt)
 	isValidNumber := numberType != UNKNOWN
 	if regionCallingFrom == regionCode {
 		isFixedLineOrMobile :=
@@ -1267,12 +1316,12 @@ func FormatNumberForMobileDialing(
 		// Carrier codes may be needed in some countries. We handle this here.
 		if regionCode == "CO" && numberType == FIXED_LINE {
 			formattedNumber =
-				FormatNationalNumberWithCarrierCode(
+				md.FormatNationalNumberWithCarrierCode(
 					numberNoExt, COLOMBIA_MOBILE_TO_FIXED_LINE_PREFIX)
 		} else if regionCode == "BR" && isFixedLineOrMobile {
 			if numberNoExt.GetPreferredDomesticCarrierCode() != "" {
 				formattedNumber =
-					FormatNationalNumberWithPreferredCarrierCode(numberNoExt, "")
+					md.FormatNationalNumberWithPreferredCarrierCode(numberNoExt, "")
 			} else {
 				// Brazilian fixed line and mobile numbers need to be dialed
 				// with a carrier code when called within Brazil. Without
@@ -1288,18 +1337,18 @@ func FormatNumberForMobileDialing(
 			// result, we add it back here
 			// if it is a valid regular length phone number.
 			formattedNumber =
-				GetNddPrefixForRegion(regionCode, true /* strip non-digits */) +
-					" " + Format(numberNoExt, NATIONAL)
+				md.GetNddPrefixForRegion(regionCode, true /* strip non-digits */) +
+					" " + md.Format(numberNoExt, NATIONAL)
 		} else if countryCallingCode == NANPA_COUNTRY_CODE {
 			// For NANPA countries, we output international format for
 			// numbers that can be dialed internationally, since that
 			// always works, except for numbers which might potentially be
 			// short numbers, which are always dialled in national format.
-			regionMetadata := getMetadataForRegion(regionCallingFrom)
-			if canBeInternationallyDialled(numberNoExt) && testNumberLength(GetNationalSignificantNumber(numberNoExt), regionMetadata, UNKNOWN) != TOO_SHORT {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+			regionMetadata := md.getMetadataForRegion(regionCallingFrom)
+			if md.canBeInternationallyDialled(numberNoExt) && testNumberLength(GetNationalSignificantNumber(numberNoExt), regionMetadata, UNKNOWN) != TOO_SHORT {
+				formattedNumber = md.Format(numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = md.Format(numberNoExt, NATIONAL)
 			}
 		} else {
 			// For non-geographical countries, and Mexican and Chilean fixed
@@ -1321,21 +1370,21 @@ func FormatNumberForMobileDialing(
 			if regionCode == REGION_CODE_FOR_NON_GEO_ENTITY ||
 				((regionCode == "MX" || regionCode == "CL" || regionCode == "UZ") &&
 					isFixedLineOrMobile) &&
-					canBeInternationallyDialled(numberNoExt) {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+					md.canBeInternationallyDialled(numberNoExt) {
+				formattedNumber = md.Format(numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = md.Format(numberNoExt, NATIONAL)
 			}
 		}
-	} else if isValidNumber && canBeInternationallyDialled(numberNoExt) {
+	} else if isValidNumber && md.canBeInternationallyDialled(numberNoExt) {
 		// We assume that short numbers are not diallable from outside
 		// their region, so if a number is not a valid regular length
 		// phone number, we treat it as if it cannot be internationally
 		// dialled.
 		if withFormatting {
-			return Format(numberNoExt, INTERNATIONAL)
+			return md.Format(numberNoExt, INTERNATIONAL)
 		}
-		return Format(numberNoExt, E164)
+		return md.Format(numberNoExt, E164)
 	}
 	if withFormatting {
 		return formattedNumber
@@ -1361,22 +1410,30 @@ func FormatNumberForMobileDialing(
 func FormatOutOfCountryCallingNumber(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
+	return CurrentMetadata().FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+}
+
+// FormatOutOfCountryCallingNumber is the same as the package level
+// FormatOutOfCountryCallingNumber, using this metadata.
+func (md *Metadata) FormatOutOfCountryCallingNumber(
+	number *PhoneNumber,
+	regionCallingFrom string) string {
 
-	if !isValidRegionCode(regionCallingFrom) {
-		return Format(number, INTERNATIONAL)
+	if !md.isValidRegionCode(regionCallingFrom) {
+		return md.Format(number, INTERNATIONAL)
 	}
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !md.hasValidCountryCallingCode(countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	if countryCallingCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if md.IsNANPACountry(regionCallingFrom) {
 			// For NANPA regions, return the national format for these
 			// regions but prefix it with the country calling code.
-			return strconv.Itoa(countryCallingCode) + " " + Format(number, NATIONAL)
+			return strconv.Itoa(countryCallingCode) + " " + md.Format(number, NATIONAL)
 		}
-	} else if countryCallingCode == getCountryCodeForValidRegion(regionCallingFrom) {
+	} else if countryCallingCode == md.getCountryCodeForValidRegion(regionCallingFrom) {
 		// If regions share a country calling code, the country calling
 		// code need not be dialled. This also applies when dialling
 		// within a region, so this if clause covers both these cases.
@@ -1386,10 +1443,10 @@ func FormatOutOfCountryCallingNumber(
 		// case for now and for those cases return the version including
 		// country calling code.
 		// Details here: http://www.petitfute.com/voyage/225-info-pratiques-reunion
-		return Format(number, NATIONAL)
+		return md.Format(number, NATIONAL)
 	}
 	// Metadata cannot be null because we checked 'isValidRegionCode()' above.
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := md.getMetadataForRegion(regionCallingFrom)
 	internationalPrefix := metadataForRegionCallingFrom.GetInternationalPrefix()
 
 	// For regions that have multiple international prefixes, the
@@ -1403,10 +1460,10 @@ func FormatOutOfCountryCallingNumber(
 		internationalPrefixForFormatting = metPref
 	}
 
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
 	metadataForRegion :=
-		getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+		md.getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
 	formattedNationalNumber :=
 		formatNsn(
 			nationalSignificantNumber, metadataForRegion, INTERNATIONAL)
@@ -1434,33 +1491,39 @@ func FormatOutOfCountryCallingNumber(
 // Note this method guarantees no digit will be inserted, removed or
 // modified as a result of formatting.
 func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) string {
+	return CurrentMetadata().FormatInOriginalFormat(number, regionCallingFrom)
+}
+
+// FormatInOriginalFormat is the same as the package level
+// FormatInOriginalFormat, using this metadata.
+func (md *Metadata) FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) string {
 	rawInput := number.GetRawInput()
-	if len(rawInput) == 0 && !hasFormattingPatternForNumber(number) {
+	if len(rawInput) == 0 && !md.hasFormattingPatternForNumber(number) {
 		// We check if we have the formatting pattern because without that, we might format the number
 		// as a group without national prefix.
 		return rawInput
 	}
 	if number.GetCountryCodeSource() == 0 {
-		return Format(number, NATIONAL)
+		return md.Format(number, NATIONAL)
 	}
 	var formattedNumber string
 	switch number.GetCountryCodeSource() {
 	case PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)
+		formattedNumber = md.Format(number, INTERNATIONAL)
 	case PhoneNumber_FROM_NUMBER_WITH_IDD:
-		formattedNumber = FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		formattedNumber = md.FormatOutOfCountryCallingNumber(number, regionCallingFrom)
 	case PhoneNumber_FROM_NUMBER_WITHOUT_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)[1:]
+		formattedNumber = md.Format(number, INTERNATIONAL)[1:]
 	case PhoneNumber_FROM_DEFAULT_COUNTRY:
 		// Fall-through to default case.
 		fallthrough
 	default:
-		regionCode := GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+		regionCode := md.GetRegionCodeForCountryCode(int(number.GetCountryCode()))
 		// We strip non-digits from the NDD here, and from the raw
 		// input later, so that we can compare them easily.
-		nationalPrefix := GetNddPrefixForRegion(
+		nationalPrefix := md.GetNddPrefixForRegion(
 			regionCode, true /* strip non-digits */)
-		nationalFormat := Format(number, NATIONAL)
+		nationalFormat := md.Format(number, NATIONAL)
 		if len(nationalPrefix) == 0 {
 			// If the region doesn't have a national prefix at all,
 			// we can safely return the national format without worrying
@@ -1470,14 +1533,14 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		}
 		// Otherwise, we check if the original number was entered with
 		// a national prefix.
-		if rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode) {
+		if md.rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode) {
 			// If so, we can safely return the national format.
 			formattedNumber = nationalFormat
 			break
 		}
 		// Metadata cannot be null here because GetNddPrefixForRegion()
 		// (above) returns null if there is no metadata for the region.
-		metadata := getMetadataForRegion(regionCode)
+		metadata := md.getMetadataForRegion(regionCode)
 		nationalNumber := GetNationalSignificantNumber(number)
 		formatRule :=
 			chooseFormattingPatternForNumber(metadata.GetNumberFormat(), nationalNumber)
@@ -1515,7 +1578,7 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		proto.Merge(numFormatCopy, formatRule)
 		numFormatCopy.NationalPrefixFormattingRule = nil
 		var numberFormats = []*NumberFormat{numFormatCopy}
-		formattedNumber = FormatByPattern(number, NATIONAL, numberFormats)
+		formattedNumber = md.FormatByPattern(number, NATIONAL, numberFormats)
 	}
 	rawInput = number.GetRawInput()
 	// If no digit is inserted/removed/modified as a result of our
@@ -1534,7 +1597,7 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 // Check if rawInput, which is assumed to be in the national format, has
 // a national prefix. The national prefix is assumed to be in digits-only
 // form.
-func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string) bool {
+func (md *Metadata) rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string) bool {
 	normalizedNationalNumber := NormalizeDigitsOnly(rawInput)
 	if strings.HasPrefix(normalizedNationalNumber, nationalPrefix) {
 		// Some Japanese numbers (e.g. 00777123) might be mistaken to
@@ -1542,20 +1605,20 @@ func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string)
 		// (e.g. 0777123) if we just do prefix matching. To tackle that,
 		// we check the validity of the number if the assumed national
 		// prefix is removed (777123 won't be valid in Japan).
-		num, err := Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
+		num, err := md.Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
 		if err != nil {
 			return false
 		}
-		return IsValidNumber(num)
+		return md.IsValidNumber(num)
 
 	}
 	return false
 }
 
-func hasFormattingPatternForNumber(number *PhoneNumber) bool {
+func (md *Metadata) hasFormattingPatternForNumber(number *PhoneNumber) bool {
 	countryCallingCode := int(number.GetCountryCode())
-	phoneNumberRegion := GetRegionCodeForCountryCode(countryCallingCode)
-	metadata := getMetadataForRegionOrCallingCode(
+	phoneNumberRegion := md.GetRegionCodeForCountryCode(countryCallingCode)
+	metadata := md.getMetadataForRegionOrCallingCode(
 		countryCallingCode, phoneNumberRegion)
 	if metadata == nil {
 		return false
@@ -1589,16 +1652,24 @@ func hasFormattingPatternForNumber(number *PhoneNumber) bool {
 func FormatOutOfCountryKeepingAlphaChars(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
+	return CurrentMetadata().FormatOutOfCountryKeepingAlphaChars(number, regionCallingFrom)
+}
+
+// FormatOutOfCountryKeepingAlphaChars is the same as the package level
+// FormatOutOfCountryKeepingAlphaChars, using this metadata.
+func (md *Metadata) FormatOutOfCountryKeepingAlphaChars(
+	number *PhoneNumber,
+	regionCallingFrom string) string {
 
 	rawInput := number.GetRawInput()
 	// If there is no raw input, then we can't keep alpha characters
 	// because there aren't any. In this case, we return
 	// formatOutOfCountryCallingNumber.
 	if len(rawInput) == 0 {
-		return FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		return md.FormatOutOfCountryCallingNumber(number, regionCallingFrom)
 	}
 	countryCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCode) {
+	if !md.hasValidCountryCallingCode(countryCode) {
 		return rawInput
 	}
 	// Strip any prefix such as country calling code, IDD, that was
@@ -1618,13 +1689,13 @@ func FormatOutOfCountryKeepingAlphaChars(
 			rawInput = rawInput[firstNationalNumberDigit:]
 		}
 	}
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := md.getMetadataForRegion(regionCallingFrom)
 	if countryCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if md.IsNANPACountry(regionCallingFrom) {
 			return strconv.Itoa(countryCode) + " " + rawInput
 		}
 	} else if metadataForRegionCallingFrom != nil &&
-		countryCode == getCountryCodeForValidRegion(regionCallingFrom) {
+		countryCode == md.getCountryCodeForValidRegion(regionCallingFrom) {
 		formattingPattern :=
 			chooseFormattingPatternForNumber(
 				metadataForRegionCallingFrom.GetNumberFormat(),
@@ -1663,9 +1734,9 @@ func FormatOutOfCountryKeepingAlphaChars(
 		}
 	}
 	var formattedNumber = NewBuilder([]byte(rawInput))
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadataForRegion *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	var metadataForRegion *PhoneMetadata = md.getMetadataForRegionOrCallingCode(countryCode, regionCode)
 	maybeAppendFormattedExtension(number, metadataForRegion,
 		INTERNATIONAL, formattedNumber)
 	if len(internationalPrefixForFormatting) > 0 {
@@ -1882,20 +1953,32 @@ func formatNsnUsingPatternWithCarrier(
 
 // Gets a valid number for the specified region.
 func GetExampleNumber(regionCode string) *PhoneNumber {
-	return GetExampleNumberForType(regionCode, FIXED_LINE)
+	return CurrentMetadata().GetExampleNumber(regionCode)
+}
+
+// GetExampleNumber is the same as the package level GetExampleNumber,
+// using this metadata.
+func (md *Metadata) GetExampleNumber(regionCode string) *PhoneNumber {
+	return md.GetExampleNumberForType(regionCode, FIXED_LINE)
 }
 
 // Gets a valid number for the specified region and number type.
 func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumber {
+	return CurrentMetadata().GetExampleNumberForType(regionCode, typ)
+}
+
+// GetExampleNumberForType is the same as the package level
+// GetExampleNumberForType, using this metadata.
+func (md *Metadata) GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumber {
 	// Check the region code is valid.
-	if !isValidRegionCode(regionCode) {
+	if !md.isValidRegionCode(regionCode) {
 		return nil
 	}
 	//PhoneNumberDesc (pointer?)
-	var desc = getNumberDescByType(getMetadataForRegion(regionCode), typ)
+	var desc = getNumberDescByType(md.getMetadataForRegion(regionCode), typ)
 	exNum := desc.GetExampleNumber()
 	if len(exNum) > 0 {
-		num, err := Parse(exNum, regionCode)
+		num, err := md.Parse(exNum, regionCode)
 		if err != nil {
 			return nil
 		}
@@ -1906,7 +1989,13 @@ func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumbe
 
 // Gets a valid number for the specified country calling code for a non-geographical entity.
 func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
-	var metadata *PhoneMetadata = getMetadataForNonGeographicalRegion(countryCallingCode)
+	return CurrentMetadata().GetExampleNumberForNonGeoEntity(countryCallingCode)
+}
+
+// GetExampleNumberForNonGeoEntity is the same as the package level
+// GetExampleNumberForNonGeoEntity, using this metadata.
+func (md *Metadata) GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
+	var metadata *PhoneMetadata = md.getMetadataForNonGeographicalRegion(countryCallingCode)
 	if metadata == nil {
 		return nil
 	}
@@ -1919,7 +2008,7 @@ func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
 
 	for _, desc := range descPriority {
 		if desc != nil && desc.GetExampleNumber() != "" {
-			num, err := Parse("+"+strconv.Itoa(countryCallingCode)+desc.GetExampleNumber(), "ZZ")
+			num, err := md.Parse("+"+strconv.Itoa(countryCallingCode)+desc.GetExampleNumber(), "ZZ")
 			if err != nil {
 				return nil
 			}
@@ -1987,8 +2076,14 @@ func getNumberDescByType(
 
 // Gets the type of a phone number.
 func GetNumberType(number *PhoneNumber) PhoneNumberType {
-	var regionCode string = GetRegionCodeForNumber(number)
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(
+	return CurrentMetadata().GetNumberType(number)
+}
+
+// GetNumberType is the same as the package level GetNumberType, using this
+// metadata.
+func (md *Metadata) GetNumberType(number *PhoneNumber) PhoneNumberType {
+	var regionCode string = md.GetRegionCodeForNumber(number)
+	var metadata *PhoneMetadata = md.getMetadataForRegionOrCallingCode(
 		int(number.GetCountryCode()), regionCode)
 	if metadata == nil {
 		return UNKNOWN
@@ -2049,20 +2144,20 @@ func getNumberTypeHelper(nationalNumber string, metadata *PhoneMetadata) PhoneNu
 
 // Returns the metadata for the given region code or nil if the region
 // code is invalid or unknown.
-func getMetadataForRegion(regionCode string) *PhoneMetadata {
-	if !isValidRegionCode(regionCode) {
+func (md *Metadata) getMetadataForRegion(regionCode string) *PhoneMetadata {
+	if !md.isValidRegionCode(regionCode) {
 		return nil
 	}
-	val, _ := readFromRegionToMetadataMap(regionCode)
+	val, _ := md.readFromRegionToMetadataMap(regionCode)
 	return val
 }
 
-func getMetadataForNonGeographicalRegion(countryCallingCode int) *PhoneMetadata {
-	_, ok := getCountryCodeToRegion()[countryCallingCode]
+func (md *Metadata) getMetadataForNonGeographicalRegion(countryCallingCode int) *PhoneMetadata {
+	_, ok := md.countryCodeToRegion[countryCallingCode]
 	if !ok {
 		return nil
 	}
-	val, _ := readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
+	val, _ := md.readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
 	return val
 }
 
@@ -2100,8 +2195,14 @@ func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bo
 // verify the number is actually in use, which is impossible to tell by
 // just looking at a number itself.
 func IsValidNumber(number *PhoneNumber) bool {
-	var regionCode string = GetRegionCodeForNumber(number)
-	return IsValidNumberForRegion(number, regionCode)
+	return CurrentMetadata().IsValidNumber(number)
+}
+
+// IsValidNumber is the same as the package level IsValidNumber, using this
+// metadata.
+func (md *Metadata) IsValidNumber(number *PhoneNumber) bool {
+	var regionCode string = md.GetRegionCodeForNumber(number)
+	return md.IsValidNumberForRegion(number, regionCode)
 }
 
 // Tests whether a phone number is valid for a certain region. Note this
@@ -2117,9 +2218,15 @@ func IsValidNumber(number *PhoneNumber) bool {
 // such as the Isle of Man as invalid for the region "GB" (United Kingdom),
 // since it has its own region code, "IM", which may be undesirable.
 func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
+	return CurrentMetadata().IsValidNumberForRegion(number, regionCode)
+}
+
+// IsValidNumberForRegion is the same as the package level
+// IsValidNumberForRegion, using this metadata.
+func (md *Metadata) IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
 	var countryCode int = int(number.GetCountryCode())
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
-	if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && countryCode != getCountryCodeForValidRegion(regionCode)) {
+	var metadata *PhoneMetadata = md.getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && countryCode != md.getCountryCodeForValidRegion(regionCode)) {
 		// Either the region code was invalid, or the country calling
 		// code for this number does not match that of the region code.
 		return false
@@ -2131,18 +2238,24 @@ func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
 // Returns the region where a phone number is from. This could be used for
 // geocoding at the region level.
 func GetRegionCodeForNumber(number *PhoneNumber) string {
+	return CurrentMetadata().GetRegionCodeForNumber(number)
+}
+
+// GetRegionCodeForNumber is the same as the package level
+// GetRegionCodeForNumber, using this metadata.
+func (md *Metadata) GetRegionCodeForNumber(number *PhoneNumber) string {
 	var countryCode int = int(number.GetCountryCode())
-	var regions []string = getCountryCodeToRegion()[countryCode]
+	var regions []string = md.countryCodeToRegion[countryCode]
 	if len(regions) == 0 {
 		return ""
 	}
 	if len(regions) == 1 {
 		return regions[0]
 	}
-	return getRegionCodeForNumberFromRegionList(number, regions)
+	return md.getRegionCodeForNumberFromRegionList(number, regions)
 }
 
-func getRegionCodeForNumberFromRegionList(
+func (md *Metadata) getRegionCodeForNumberFromRegionList(
 	number *PhoneNumber,
 	regionCodes []string) string {
 
@@ -2151,7 +2264,7 @@ func getRegionCodeForNumberFromRegionList(
 		// If leadingDigits is present, use this. Otherwise, do
 		// full validation. Metadata cannot be null because the
 		// region codes come from the country calling code map.
-		var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+		var metadata *PhoneMetadata = md.getMetadataForRegion(regionCode)
 		if len(metadata.GetLeadingDigits()) > 0 {
 			patP := "^(?:" + metadata.GetLeadingDigits() + ")" // Non capturing grouping to support OR'ed alternatives (e.g. 555|1[78]|2)
 			pat := regexFor(patP)
@@ -2174,7 +2287,13 @@ func getRegionCodeForNumberFromRegionList(
 // value "001" will be returned (corresponding to the value for World in
 // the UN M.49 schema).
 func GetRegionCodeForCountryCode(countryCallingCode int) string {
-	var regionCodes []string = getCountryCodeToRegion()[countryCallingCode]
+	return CurrentMetadata().GetRegionCodeForCountryCode(countryCallingCode)
+}
+
+// GetRegionCodeForCountryCode is the same as the package level
+// GetRegionCodeForCountryCode, using this metadata.
+func (md *Metadata) GetRegionCodeForCountryCode(countryCallingCode int) string {
+	var regionCodes []string = md.countryCodeToRegion[countryCallingCode]
 	if len(regionCodes) == 0 {
 		return UNKNOWN_REGION
 	}
@@ -2186,24 +2305,36 @@ func GetRegionCodeForCountryCode(countryCallingCode int) string {
 // code 001 is returned. Also, in the case of no region code being found,
 // an empty list is returned.
 func GetRegionCodesForCountryCode(countryCallingCode int) []string {
-	var regionCodes []string = getCountryCodeToRegion()[countryCallingCode]
+	return CurrentMetadata().GetRegionCodesForCountryCode(countryCallingCode)
+}
+
+// GetRegionCodesForCountryCode is the same as the package level
+// GetRegionCodesForCountryCode, using this metadata.
+func (md *Metadata) GetRegionCodesForCountryCode(countryCallingCode int) []string {
+	var regionCodes []string = md.countryCodeToRegion[countryCallingCode]
 	return regionCodes
 }
 
 // Returns the country calling code for a specific region. For example, this
 // would be 1 for the United States, and 64 for New Zealand.
 func GetCountryCodeForRegion(regionCode string) int {
-	if !isValidRegionCode(regionCode) {
+	return CurrentMetadata().GetCountryCodeForRegion(regionCode)
+}
+
+// GetCountryCodeForRegion is the same as the package level
+// GetCountryCodeForRegion, using this metadata.
+func (md *Metadata) GetCountryCodeForRegion(regionCode string) int {
+	if !md.isValidRegionCode(regionCode) {
 		return 0
 	}
-	return getCountryCodeForValidRegion(regionCode)
+	return md.getCountryCodeForValidRegion(regionCode)
 }
 
 // Returns the country calling code for a specific region. For example,
 // this would be 1 for the United States, and 64 for New Zealand. Assumes
 // the region is already valid.
-func getCountryCodeForValidRegion(regionCode string) int {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+func (md *Metadata) getCountryCodeForValidRegion(regionCode string) int {
+	var metadata *PhoneMetadata = md.getMetadataForRegion(regionCode)
 	return int(metadata.GetCountryCode())
 }
 
@@ -2218,7 +2349,13 @@ func getCountryCodeForValidRegion(regionCode string) int {
 // of numbers. Use the library's formatting functions to prefix the
 // national prefix when required.
 func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+	return CurrentMetadata().GetNddPrefixForRegion(regionCode, stripNonDigits)
+}
+
+// GetNddPrefixForRegion is the same as the package level
+// GetNddPrefixForRegion, using this metadata.
+func (md *Metadata) GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
+	var metadata *PhoneMetadata = md.getMetadataForRegion(regionCode)
 	if metadata == nil {
 		return ""
 	}
@@ -2238,7 +2375,13 @@ func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
 // Checks if this is a region under the North American Numbering Plan
 // Administration (NANPA).
 func IsNANPACountry(regionCode string) bool {
-	_, ok := readFromNanpaRegions(regionCode)
+	return CurrentMetadata().IsNANPACountry(regionCode)
+}
+
+// IsNANPACountry is the same as the package level IsNANPACountry, using
+// this metadata.
+func (md *Metadata) IsNANPACountry(regionCode string) bool {
+	_, ok := md.readFromNanpaRegions(regionCode)
 	return ok
 }
 
@@ -2262,7 +2405,13 @@ func IsAlphaNumber(number string) bool {
 // Convenience wrapper around IsPossibleNumberWithReason(). Instead of
 // returning the reason for failure, this method returns a boolean value.
 func IsPossibleNumber(number *PhoneNumber) bool {
-	possible := IsPossibleNumberWithReason(number)
+	return CurrentMetadata().IsPossibleNumber(number)
+}
+
+// IsPossibleNumber is the same as the package level IsPossibleNumber,
+// using this metadata.
+func (md *Metadata) IsPossibleNumber(number *PhoneNumber) bool {
+	possible := md.IsPossibleNumberWithReason(number)
 	return possible == IS_POSSIBLE || possible == IS_POSSIBLE_LOCAL_ONLY
 }
 
@@ -2393,6 +2542,12 @@ func testNumberLength(number string, metadata *PhoneMetadata, numberType PhoneNu
 //    line numbers), it will return false for the subscriber-number-only
 //    version.
 func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
+	return CurrentMetadata().IsPossibleNumberWithReason(number)
+}
+
+// IsPossibleNumberWithReason is the same as the package level
+// IsPossibleNumberWithReason, using this metadata.
+func (md *Metadata) IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 	nationalNumber := GetNationalSignificantNumber(number)
 	countryCode := int(number.GetCountryCode())
 	// Note: For Russian Fed and NANPA numbers, we just use the rules
@@ -2401,12 +2556,12 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 	// but not valid. This would need to be revisited if the possible
 	// number pattern ever differed between various regions within
 	// those plans.
-	if !hasValidCountryCallingCode(countryCode) {
+	if !md.hasValidCountryCallingCode(countryCode) {
 		return INVALID_COUNTRY_CODE
 	}
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := md.GetRegionCodeForCountryCode(countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	var metadata *PhoneMetadata = md.getMetadataForRegionOrCallingCode(countryCode, regionCode)
 	var generalNumDesc *PhoneNumberDesc = metadata.GetGeneralDesc()
 	// Handling case of numbers with no metadata.
 	if len(generalNumDesc.GetNationalNumberPattern()) == 0 {
@@ -2429,12 +2584,12 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 //
 // This method first parses the number, then invokes
 // IsPossibleNumber(PhoneNumber) with the resultant PhoneNumber object.
-func isPossibleNumberWithRegion(number, regionDialingFrom string) bool {
-	num, err := Parse(number, regionDialingFrom)
+func (md *Metadata) isPossibleNumberWithRegion(number, regionDialingFrom string) bool {
+	num, err := md.Parse(number, regionDialingFrom)
 	if err != nil {
 		return false
 	}
-	return IsPossibleNumber(num)
+	return md.IsPossibleNumber(num)
 }
 
 // Attempts to extract a valid number from a phone number that is too long
@@ -2442,7 +2597,13 @@ func isPossibleNumberWithRegion(number, regionDialingFrom string) bool {
 // version. If no valid number could be extracted, the PhoneNumber object
 // passed in will not be modified.
 func TruncateTooLongNumber(number *PhoneNumber) bool {
-	if IsValidNumber(number) {
+	return CurrentMetadata().TruncateTooLongNumber(number)
+}
+
+// TruncateTooLongNumber is the same as the package level
+// TruncateTooLongNumber, using this metadata.
+func (md *Metadata) TruncateTooLongNumber(number *PhoneNumber) bool {
+	if md.IsValidNumber(number) {
 		return true
 	}
 	numberCopy := &PhoneNumber{}
@@ -2450,13 +2611,13 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	nationalNumber := number.GetNationalNumber()
 	nationalNumber /= 10
 	numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-	if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
+	if md.IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
 		return false
 	}
-	for !IsValidNumber(numberCopy) {
+	for !md.IsValidNumber(numberCopy) {
 		nationalNumber /= 10
 		numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-		if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
+		if md.IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
 			nationalNumber == 0 {
 			return false
 		}
@@ -2466,18 +2627,12 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	return true
 }
 
-// Gets an AsYouTypeFormatter for the specific region.
-// TODO(ttacon): uncomment once we do asyoutypeformatter.go
-//public AsYouTypeFormatter getAsYouTypeFormatter(String regionCode) {
-//    return new AsYouTypeFormatter(regionCode);
-//}
-
 // Extracts country calling code from fullNumber, returns it and places
 // the remaining number in nationalNumber. It assumes that the leading plus
 // sign or IDD has already been removed. Returns 0 if fullNumber doesn't
 // start with a valid country calling code, and leaves nationalNumber
 // unmodified.
-func extractCountryCode(fullNumber, nationalNumber *Builder) int {
+func (md *Metadata) extractCountryCode(fullNumber, nationalNumber *Builder) int {
 	fullNumBytes := fullNumber.Bytes()
 	if len(fullNumBytes) == 0 || fullNumBytes[0] == '0' {
 		// Country codes do not begin with a '0'.
@@ -2489,7 +2644,7 @@ func extractCountryCode(fullNumber, nationalNumber *Builder) int {
 	)
 	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i <= numberLength; i++ {
 		potentialCountryCode, _ = strconv.Atoi(string(fullNumBytes[0:i]))
-		if _, ok := getCountryCodeToRegion()[potentialCountryCode]; ok {
+		if _, ok := md.countryCodeToRegion[potentialCountryCode]; ok {
 			nationalNumber.Write(fullNumBytes[i:])
 			return potentialCountryCode
 		}
@@ -2519,7 +2674,7 @@ var ErrTooShortAfterIDD = errors.New("phone number had an IDD, but " +
 // It will throw a NumberParseException if the number starts with a '+' but
 // the country calling code supplied after this does not match that of any
 // known region.
-func maybeExtractCountryCode(
+func (md *Metadata) maybeExtractCountryCode(
 	number string,
 	defaultRegionMetadata *PhoneMetadata,
 	nationalNumber *Builder,
@@ -2545,7 +2700,7 @@ func maybeExtractCountryCode(
 		if len(fullNumber.String()) <= MIN_LENGTH_FOR_NSN {
 			return 0, ErrTooShortAfterIDD
 		}
-		potentialCountryCode := extractCountryCode(fullNumber, nationalNumber)
+		potentialCountryCode := md.extractCountryCode(fullNumber, nationalNumber)
 		if potentialCountryCode != 0 {
 			phoneNumber.CountryCode = proto.Int(potentialCountryCode)
 			return potentialCountryCode, nil
@@ -2745,8 +2900,8 @@ func maybeStripExtension(number *Builder) string {
 // that the number to parse starts with a + symbol so that we can attempt
 // to infer the region from the number. Returns false if it cannot use the
 // region provided and the region cannot be inferred.
-func checkRegionForParsing(numberToParse, defaultRegion string) bool {
-	if !isValidRegionCode(defaultRegion) {
+func (md *Metadata) checkRegionForParsing(numberToParse, defaultRegion string) bool {
+	if !md.isValidRegionCode(defaultRegion) {
 		// If the number is null or empty, we can't infer the region.
 		if len(numberToParse) == 0 ||
 			!PLUS_CHARS_PATTERN.MatchString(numberToParse) {
@@ -2762,15 +2917,75 @@ func checkRegionForParsing(numberToParse, defaultRegion string) bool {
 // a valid number for a particular region is not performed. This can be
 // done separately with IsValidNumber().
 func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return CurrentMetadata().Parse(numberToParse, defaultRegion)
+}
+
+// Parse is the same as the package level Parse, using this metadata.
+func (md *Metadata) Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	err := ParseToNumber(numberToParse, defaultRegion, phoneNumber)
+	err := md.ParseToNumber(numberToParse, defaultRegion, phoneNumber)
+	return phoneNumber, err
+}
+
+// ParseOptions changes how ParseWithOptions parses a number. The zero
+// value parses the same way as Parse.
+type ParseOptions struct {
+	// KeepRawInput records the input in RawInput, and any carrier code
+	// found in PreferredDomesticCarrierCode, as ParseAndKeepRawInput does
+	KeepRawInput bool
+
+	// KeepCountryCodeSource records how the country calling code was
+	// found in CountryCodeSource, as ParseAndKeepRawInput does
+	KeepCountryCodeSource bool
+
+	// DisableAlphaConversion stops vanity numbers such as 1-800-FLOWERS
+	// being converted to digits, they fail with ErrNotANumber instead
+	DisableAlphaConversion bool
+
+	// RejectExtensions fails numbers which have an extension with
+	// ErrExtensionNotAllowed, instead of recording it in Extension
+	RejectExtensions bool
+
+	// RequirePlusSign fails numbers which don't start with a plus sign
+	// with ErrMissingPlusSign, so the default region is never used to
+	// determine the country calling code
+	RequirePlusSign bool
+
+	// MaxInputLength is the longest input that will be parsed, longer
+	// inputs fail with ErrNumTooLong. Zero means MAX_INPUT_STRING_LENGTH.
+	MaxInputLength int
+}
+
+// ParseWithOptions parses a string the same way as Parse, but with the
+// behaviour changed by options. For example, to only accept numbers
+// written in international format without an extension:
+//
+//	number, err := phonenumbers.ParseWithOptions("+1 650 253 0000", "", phonenumbers.ParseOptions{
+//		RequirePlusSign:  true,
+//		RejectExtensions: true,
+//	})
+func ParseWithOptions(numberToParse, defaultRegion string, options ParseOptions) (*PhoneNumber, error) {
+	return CurrentMetadata().ParseWithOptions(numberToParse, defaultRegion, options)
+}
+
+// ParseWithOptions is the same as the package level ParseWithOptions,
+// using this metadata.
+func (md *Metadata) ParseWithOptions(numberToParse, defaultRegion string, options ParseOptions) (*PhoneNumber, error) {
+	var phoneNumber *PhoneNumber = &PhoneNumber{}
+	err := md.parseHelperWithOptions(numberToParse, defaultRegion, true, options, phoneNumber)
 	return phoneNumber, err
 }
 
 // Same as Parse(string, string), but accepts mutable PhoneNumber as a
 // parameter to decrease object creation when invoked many times.
 func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
+	return CurrentMetadata().ParseToNumber(numberToParse, defaultRegion, phoneNumber)
+}
+
+// ParseToNumber is the same as the package level ParseToNumber, using this
+// metadata.
+func (md *Metadata) ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
+	return md.parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
 }
 
 // Parses a string and returns it in proto buffer format. This method
@@ -2778,9 +2993,16 @@ func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber
 // the protocol buffer with numberToParse as well as the country_code_source
 // field.
 func ParseAndKeepRawInput(
+	numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return CurrentMetadata().ParseAndKeepRawInput(numberToParse, defaultRegion)
+}
+
+// ParseAndKeepRawInput is the same as the package level
+// ParseAndKeepRawInput, using this metadata.
+func (md *Metadata) ParseAndKeepRawInput(
 	numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	return phoneNumber, ParseAndKeepRawInputToNumber(
+	return phoneNumber, md.ParseAndKeepRawInputToNumber(
 		numberToParse, defaultRegion, phoneNumber)
 }
 
@@ -2790,28 +3012,16 @@ func ParseAndKeepRawInput(
 func ParseAndKeepRawInputToNumber(
 	numberToParse, defaultRegion string,
 	phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
+	return CurrentMetadata().ParseAndKeepRawInputToNumber(numberToParse, defaultRegion, phoneNumber)
 }
 
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-// This is a shortcut for findNumbers(CharSequence, String, Leniency, long)
-// getMatcher(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE)}.
-//public Iterable<PhoneNumberMatch> findNumbers(CharSequence text, String defaultRegion) {
-//    return findNumbers(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE);
-//}
-
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-//public Iterable<PhoneNumberMatch> findNumbers(
-//	final CharSequence text, final String defaultRegion, final Leniency leniency,
-//	final long maxTries) {
-//
-//		return new Iterable<PhoneNumberMatch>() {
-//			public Iterator<PhoneNumberMatch> iterator() {
-//				return new PhoneNumberMatcher(
-//					PhoneNumberUtil.this, text, defaultRegion, leniency, maxTries);
-//			}
-//		};
-//	}
+// ParseAndKeepRawInputToNumber is the same as the package level
+// ParseAndKeepRawInputToNumber, using this metadata.
+func (md *Metadata) ParseAndKeepRawInputToNumber(
+	numberToParse, defaultRegion string,
+	phoneNumber *PhoneNumber) error {
+	return md.parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
+}
 
 // A helper function to set the values related to leading zeros in a
 // PhoneNumber.
@@ -2838,38 +3048,201 @@ var (
 	ErrInvalidCountryCode = errors.New("invalid country code")
 	ErrNotANumber         = errors.New("the phone number supplied is not a number")
 	ErrTooShortNSN        = errors.New("the string supplied is too short to be a phone number")
+
+	ErrMissingPlusSign     = errors.New("the phone number supplied does not start with a plus sign")
+	ErrExtensionNotAllowed = errors.New("the phone number supplied has an extension")
+)
+
+// ParseErrorKind is the reason a number could not be parsed. Each kind
+// corresponds to one of the sentinel errors returned by Parse.
+type ParseErrorKind int
+
+const (
+	// The country code supplied did not belong to a supported country or
+	// non-geographical entity, or no valid default region was supplied
+	// for a number without a leading plus sign. See ErrInvalidCountryCode.
+	INVALID_COUNTRY_CODE_ERROR ParseErrorKind = iota
+	// The string passed in had fewer than 3 digits in it, or contained
+	// characters that can't be part of a phone number. See ErrNotANumber.
+	NOT_A_NUMBER_ERROR
+	// The string started with an international dialing prefix, but after
+	// this was stripped from the number, had fewer digits than any valid
+	// phone number (including extension) could have. See ErrTooShortAfterIDD.
+	TOO_SHORT_AFTER_IDD_ERROR
+	// The string, after any country calling code has been stripped, had
+	// fewer digits than any valid phone number could have. See ErrTooShortNSN.
+	TOO_SHORT_NSN_ERROR
+	// The string had more digits than any valid phone number could have.
+	// See ErrNumTooLong.
+	TOO_LONG_ERROR
+	// The string did not start with a plus sign when ParseOptions required
+	// one. See ErrMissingPlusSign.
+	MISSING_PLUS_SIGN_ERROR
+	// The string had an extension when ParseOptions rejected extensions.
+	// See ErrExtensionNotAllowed.
+	EXTENSION_NOT_ALLOWED_ERROR
 )
 
+func (k ParseErrorKind) String() string {
+	switch k {
+	case INVALID_COUNTRY_CODE_ERROR:
+		return "INVALID_COUNTRY_CODE"
+	case NOT_A_NUMBER_ERROR:
+		return "NOT_A_NUMBER"
+	case TOO_SHORT_AFTER_IDD_ERROR:
+		return "TOO_SHORT_AFTER_IDD"
+	case TOO_SHORT_NSN_ERROR:
+		return "TOO_SHORT_NSN"
+	case TOO_LONG_ERROR:
+		return "TOO_LONG"
+	case MISSING_PLUS_SIGN_ERROR:
+		return "MISSING_PLUS_SIGN"
+	case EXTENSION_NOT_ALLOWED_ERROR:
+		return "EXTENSION_NOT_ALLOWED"
+	}
+	return "UNKNOWN"
+}
+
+// ParseError is returned by Parse and its variants when a number can't be
+// parsed. It matches the corresponding sentinel error with errors.Is, so
+// existing checks such as errors.Is(err, ErrNotANumber) keep working,
+// while errors.As gives access to the details:
+//
+//	var parseErr *phonenumbers.ParseError
+//	if errors.As(err, &parseErr) && parseErr.Offset >= 0 {
+//		// point the user at parseErr.Input[parseErr.Offset:]
+//	}
+type ParseError struct {
+	// Kind is the reason the number could not be parsed
+	Kind ParseErrorKind
+
+	// Input is the string that was passed in to be parsed
+	Input string
+
+	// DefaultRegion is the region that was passed in to parse against
+	DefaultRegion string
+
+	// Offset is the byte offset in Input of the character that caused
+	// the error, or -1 when the error isn't caused by a single character
+	Offset int
+}
+
+func newParseError(kind ParseErrorKind, input, defaultRegion string, offset int) *ParseError {
+	return &ParseError{Kind: kind, Input: input, DefaultRegion: defaultRegion, Offset: offset}
+}
+
+// Error returns the message of the sentinel error for this kind.
+func (e *ParseError) Error() string {
+	return e.Unwrap().Error()
+}
+
+// Unwrap returns the sentinel error for this kind, such as ErrNotANumber.
+func (e *ParseError) Unwrap() error {
+	switch e.Kind {
+	case INVALID_COUNTRY_CODE_ERROR:
+		return ErrInvalidCountryCode
+	case NOT_A_NUMBER_ERROR:
+		return ErrNotANumber
+	case TOO_SHORT_AFTER_IDD_ERROR:
+		return ErrTooShortAfterIDD
+	case TOO_SHORT_NSN_ERROR:
+		return ErrTooShortNSN
+	case TOO_LONG_ERROR:
+		return ErrNumTooLong
+	case MISSING_PLUS_SIGN_ERROR:
+		return ErrMissingPlusSign
+	case EXTENSION_NOT_ALLOWED_ERROR:
+		return ErrExtensionNotAllowed
+	}
+	return nil
+}
+
+// Matches the letters of vanity numbers.
+var alphaCharPattern = regexp.MustCompile("[" + VALID_ALPHA + "]")
+
+// Matches the longest prefix of a number that could be viable, used to
+// find the first character which makes a number not viable.
+var viablePhoneNumberPrefixPattern = func() *regexp.Regexp {
+	pattern := regexp.MustCompile(
+		"^(?:" + VALID_PHONE_NUMBER + "(?:" + EXTN_PATTERNS_FOR_PARSING + ")?)")
+	pattern.Longest()
+	return pattern
+}()
+
+// Returns the offset in numberToParse of the first character that stops
+// the extracted number from being viable, or -1 if the number isn't
+// viable as a whole (e.g. it has too few digits) or can't be located.
+func notANumberOffset(numberToParse, number string) int {
+	start := strings.Index(numberToParse, number)
+	if len(number) == 0 || start < 0 {
+		return -1
+	}
+	loc := viablePhoneNumberPrefixPattern.FindStringIndex(number)
+	if loc == nil || loc[1] == 0 || loc[1] >= len(number) {
+		return -1
+	}
+	return start + loc[1]
+}
+
 // Parses a string and fills up the phoneNumber. This method is the same
 // as the public Parse() method, with the exception that it allows the
 // default region to be null, for use by IsNumberMatch(). checkRegion should
 // be set to false if it is permitted for the default region to be null or
 // unknown ("ZZ").
-func parseHelper(
+func (md *Metadata) parseHelper(
 	numberToParse, defaultRegion string,
 	keepRawInput, checkRegion bool,
 	phoneNumber *PhoneNumber) error {
+	options := ParseOptions{
+		KeepRawInput:          keepRawInput,
+		KeepCountryCodeSource: keepRawInput,
+	}
+	return md.parseHelperWithOptions(numberToParse, defaultRegion, checkRegion, options, phoneNumber)
+}
+
+func (md *Metadata) parseHelperWithOptions(
+	numberToParse, defaultRegion string,
+	checkRegion bool,
+	options ParseOptions,
+	phoneNumber *PhoneNumber) error {
+	maxInputLength := options.MaxInputLength
+	if maxInputLength <= 0 {
+		maxInputLength = MAX_INPUT_STRING_LENGTH
+	}
 	if len(numberToParse) == 0 {
-		return ErrNotANumber
-	} else if len(numberToParse) > MAX_INPUT_STRING_LENGTH {
-		return ErrNumTooLong
+		return newParseError(NOT_A_NUMBER_ERROR, numberToParse, defaultRegion, -1)
+	} else if len(numberToParse) > maxInputLength {
+		return newParseError(TOO_LONG_ERROR, numberToParse, defaultRegion, maxInputLength)
 	}
 
 	nationalNumber := NewBuilder(nil)
-	buildNationalNumberForParsing(numberToParse, nationalNumber)
+	if err := buildNationalNumberForParsing(numberToParse, nationalNumber); err != nil {
+		return newParseError(NOT_A_NUMBER_ERROR, numberToParse, defaultRegion,
+			strings.Index(numberToParse, RFC3966_PHONE_CONTEXT)+len(RFC3966_PHONE_CONTEXT))
+	}
 
 	if !isViablePhoneNumber(nationalNumber.String()) {
-		return ErrNotANumber
+		return newParseError(NOT_A_NUMBER_ERROR, numberToParse, defaultRegion,
+			notANumberOffset(numberToParse, nationalNumber.String()))
+	}
+
+	// The start of the number within the input, used to report offsets
+	numberStart := strings.Index(numberToParse, nationalNumber.String())
+
+	if options.RequirePlusSign {
+		if inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumber.String()); len(inds) == 0 || inds[0] != 0 {
+			return newParseError(MISSING_PLUS_SIGN_ERROR, numberToParse, defaultRegion, numberStart)
+		}
 	}
 
 	// Check the region supplied is valid, or that the extracted number
 	// starts with some sort of + sign so the number's region can be determined.
 	if checkRegion &&
-		!checkRegionForParsing(nationalNumber.String(), defaultRegion) {
-		return ErrInvalidCountryCode
+		!md.checkRegionForParsing(nationalNumber.String(), defaultRegion) {
+		return newParseError(INVALID_COUNTRY_CODE_ERROR, numberToParse, defaultRegion, -1)
 	}
 
-	if keepRawInput {
+	if options.KeepRawInput {
 		phoneNumber.RawInput = proto.String(numberToParse)
 	}
 	// Attempt to parse extension first, since it doesn't require
@@ -2877,41 +3250,66 @@ func parseHelper(
 	// number here.
 	extension := maybeStripExtension(nationalNumber)
 	if len(extension) > 0 {
+		if options.RejectExtensions {
+			offset := -1
+			if numberStart >= 0 {
+				offset = numberStart + nationalNumber.Len()
+			}
+			return newParseError(EXTENSION_NOT_ALLOWED_ERROR, numberToParse, defaultRegion, offset)
+		}
 		phoneNumber.Extension = proto.String(extension)
 	}
-	var regionMetadata *PhoneMetadata = getMetadataForRegion(defaultRegion)
+
+	// Without vanity conversion, letters would silently be dropped when
+	// normalizing, so numbers spelled out with letters are rejected.
+	if options.DisableAlphaConversion && VALID_ALPHA_PHONE_PATTERN.MatchString(nationalNumber.String()) {
+		offset := -1
+		if loc := alphaCharPattern.FindStringIndex(nationalNumber.String()); numberStart >= 0 && loc != nil {
+			offset = numberStart + loc[0]
+		}
+		return newParseError(NOT_A_NUMBER_ERROR, numberToParse, defaultRegion, offset)
+	}
+	var regionMetadata *PhoneMetadata = md.getMetadataForRegion(defaultRegion)
 	// Check to see if the number is given in international format so we
 	// know whether this number is from the default region or not.
 	normalizedNationalNumber := NewBuilder(nil)
 	// TODO: This method should really just take in the string buffer that
 	// has already been created, and just remove the prefix, rather than
 	// taking in a string and then outputting a string buffer.
-	countryCode, err := maybeExtractCountryCode(
+	countryCode, err := md.maybeExtractCountryCode(
 		nationalNumber.String(), regionMetadata,
-		normalizedNationalNumber, keepRawInput, phoneNumber)
+		normalizedNationalNumber, options.KeepCountryCodeSource, phoneNumber)
 	if err != nil {
 		// There might be a plus at the beginning
 		inds := PLUS_CHARS_PATTERN.FindStringIndex(nationalNumber.String())
 		if err == ErrInvalidCountryCode && len(inds) > 0 {
 			// Strip the plus-char, and try again.
-			countryCode, err = maybeExtractCountryCode(
+			countryCode, err = md.maybeExtractCountryCode(
 				nationalNumber.String()[inds[1]:], regionMetadata,
-				normalizedNationalNumber, keepRawInput, phoneNumber)
-			if err != nil {
-				return err
-			} else if countryCode == 0 {
-				return ErrInvalidCountryCode
+				normalizedNationalNumber, options.KeepCountryCodeSource, phoneNumber)
+			if err == nil && countryCode == 0 {
+				err = ErrInvalidCountryCode
 			}
-		} else {
+		}
+		if err == ErrInvalidCountryCode {
+			// Point at the country calling code following the plus sign
+			offset := -1
+			if numberStart >= 0 && len(inds) > 0 && inds[0] == 0 {
+				offset = numberStart + inds[1]
+			}
+			return newParseError(INVALID_COUNTRY_CODE_ERROR, numberToParse, defaultRegion, offset)
+		} else if err == ErrTooShortAfterIDD {
+			return newParseError(TOO_SHORT_AFTER_IDD_ERROR, numberToParse, defaultRegion, -1)
+		} else if err != nil {
 			return err
 		}
 	}
 	if countryCode != 0 {
-		phoneNumberRegion := GetRegionCodeForCountryCode(countryCode)
+		phoneNumberRegion := md.GetRegionCodeForCountryCode(countryCode)
 		if phoneNumberRegion != defaultRegion {
 			// Metadata cannot be null because the country calling
 			// code is valid.
-			regionMetadata = getMetadataForRegionOrCallingCode(
+			regionMetadata = md.getMetadataForRegionOrCallingCode(
 				countryCode, phoneNumberRegion)
 		}
 	} else {
@@ -2922,12 +3320,12 @@ func parseHelper(
 		if len(defaultRegion) != 0 {
 			countryCode = int(regionMetadata.GetCountryCode())
 			phoneNumber.CountryCode = proto.Int(countryCode)
-		} else if keepRawInput {
+		} else if options.KeepCountryCodeSource {
 			phoneNumber.CountryCodeSource = nil
 		}
 	}
 	if len(normalizedNationalNumber.String()) < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(TOO_SHORT_NSN_ERROR, numberToParse, defaultRegion, -1)
 	}
 
 	if regionMetadata != nil {
@@ -2944,7 +3342,7 @@ func parseHelper(
 		validationResult := testNumberLength(potentialNationalNumber.String(), regionMetadata, UNKNOWN)
 		if validationResult != TOO_SHORT && validationResult != IS_POSSIBLE_LOCAL_ONLY && validationResult != INVALID_LENGTH {
 			normalizedNationalNumber = potentialNationalNumber
-			if keepRawInput {
+			if options.KeepRawInput {
 				phoneNumber.PreferredDomesticCarrierCode =
 					proto.String(carrierCode.String())
 			}
@@ -2952,10 +3350,10 @@ func parseHelper(
 	}
 	lengthOfNationalNumber := len(normalizedNationalNumber.String())
 	if lengthOfNationalNumber < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(TOO_SHORT_NSN_ERROR, numberToParse, defaultRegion, -1)
 	}
 	if lengthOfNationalNumber > MAX_LENGTH_FOR_NSN {
-		return ErrNumTooLong
+		return newParseError(TOO_LONG_ERROR, numberToParse, defaultRegion, -1)
 	}
 	setItalianLeadingZerosForPhoneNumber(
 		normalizedNationalNumber.String(), phoneNumber)
@@ -2966,29 +3364,48 @@ func parseHelper(
 
 var ErrNumTooLong = errors.New("the string supplied is too long to be a phone number")
 
+// Returns the value of the phone-context parameter of numberToParse, which
+// starts at indexOfPhoneContext, or false if it has none.
+func extractPhoneContext(numberToParse string, indexOfPhoneContext int) (string, bool) {
+	if indexOfPhoneContext < 0 {
+		return "", false
+	}
+	phoneContextStart := indexOfPhoneContext + len(RFC3966_PHONE_CONTEXT)
+	// Additional parameters might follow the phone context. If so, we
+	// remove them here because the parameters after phone context are not
+	// important for parsing the phone number.
+	phoneContextEnd := strings.Index(numberToParse[phoneContextStart:], ";")
+	if phoneContextEnd >= 0 {
+		return numberToParse[phoneContextStart : phoneContextStart+phoneContextEnd], true
+	}
+	return numberToParse[phoneContextStart:], true
+}
+
+// Returns whether the passed in phone-context value is a global number
+// digits or a domain name, as RFC 3966 requires.
+func isPhoneContextValid(phoneContext string) bool {
+	return RFC3966_GLOBAL_NUMBER_DIGITS_PATTERN.MatchString(phoneContext) ||
+		RFC3966_DOMAINNAME_PATTERN.MatchString(phoneContext)
+}
+
 // Converts numberToParse to a form that we can parse and write it to
 // nationalNumber if it is written in RFC3966; otherwise extract a possible
-// number out of it and write to nationalNumber.
+// number out of it and write to nationalNumber. Returns ErrNotANumber if
+// it has a phone-context which is empty or invalid.
 func buildNationalNumberForParsing(
 	numberToParse string,
-	nationalNumber *Builder) {
+	nationalNumber *Builder) error {
 
 	indexOfPhoneContext := strings.Index(numberToParse, RFC3966_PHONE_CONTEXT)
-	if indexOfPhoneContext > 0 {
-		phoneContextStart := indexOfPhoneContext + len(RFC3966_PHONE_CONTEXT)
+	phoneContext, hasPhoneContext := extractPhoneContext(numberToParse, indexOfPhoneContext)
+	if hasPhoneContext {
+		if !isPhoneContextValid(phoneContext) {
+			return ErrNotANumber
+		}
 		// If the phone context contains a phone number prefix, we need
 		// to capture it, whereas domains will be ignored.
-		if numberToParse[phoneContextStart] == PLUS_SIGN {
-			// Additional parameters might follow the phone context. If so,
-			// we will remove them here because the parameters after phone
-			// context are not important for parsing the phone number.
-			phoneContextEnd := strings.Index(numberToParse[phoneContextStart:], ";")
-			if phoneContextEnd > 0 {
-				nationalNumber.WriteString(
-					numberToParse[phoneContextStart:phoneContextEnd])
-			} else {
-				nationalNumber.WriteString(numberToParse[phoneContextStart:])
-			}
+		if phoneContext[0] == PLUS_SIGN {
+			nationalNumber.WriteString(phoneContext)
 		}
 		// Now append everything between the "tel:" prefix and the
 		// phone-context. This should include the national number, an
@@ -2998,7 +3415,7 @@ func buildNationalNumberForParsing(
 		// from the beginning.
 		indexOfRfc3966Prefix := strings.Index(numberToParse, RFC3966_PREFIX)
 		indexOfNationalNumber := 0
-		if indexOfRfc3966Prefix >= 0 {
+		if indexOfRfc3966Prefix >= 0 && indexOfRfc3966Prefix+len(RFC3966_PREFIX) <= indexOfPhoneContext {
 			indexOfNationalNumber = indexOfRfc3966Prefix + len(RFC3966_PREFIX)
 		}
 		nationalNumber.WriteString(
@@ -3023,6 +3440,7 @@ func buildNationalNumberForParsing(
 	// This is because we are concerned about deleting content from a
 	// potential number string when there is no strong evidence that the
 	// number is actually written in RFC3966.
+	return nil
 }
 
 // Takes two phone numbers and compares them for equality.
@@ -3123,26 +3541,32 @@ func isNationalNumberSuffixOfTheOther(firstNumber, secondNumber *PhoneNumber) bo
 // a convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
 // default region is known.
 func IsNumberMatch(firstNumber, secondNumber string) MatchType {
-	firstNumberAsProto, err := Parse(firstNumber, UNKNOWN_REGION)
+	return CurrentMetadata().IsNumberMatch(firstNumber, secondNumber)
+}
+
+// IsNumberMatch is the same as the package level IsNumberMatch, using this
+// metadata.
+func (md *Metadata) IsNumberMatch(firstNumber, secondNumber string) MatchType {
+	firstNumberAsProto, err := md.Parse(firstNumber, UNKNOWN_REGION)
 	if err == nil {
-		return isNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
-	} else if err != ErrInvalidCountryCode {
+		return md.isNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := md.Parse(secondNumber, UNKNOWN_REGION)
 	if err == nil {
-		return isNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
-	} else if err != ErrInvalidCountryCode {
+		return md.isNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
 	var firstNumberProto, secondNumberProto *PhoneNumber
-	err = parseHelper(firstNumber, "", false, false, firstNumberProto)
+	err = md.parseHelper(firstNumber, "", false, false, firstNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
-	err = parseHelper(secondNumber, "", false, false, secondNumberProto)
+	err = md.parseHelper(secondNumber, "", false, false, secondNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
@@ -3152,26 +3576,26 @@ func IsNumberMatch(firstNumber, secondNumber string) MatchType {
 // Takes two phone numbers and compares them for equality. This is a
 // convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
 // default region is known.
-func isNumberMatchWithOneNumber(
+func (md *Metadata) isNumberMatchWithOneNumber(
 	firstNumber *PhoneNumber, secondNumber string) MatchType {
 	// First see if the second number has an implicit country calling
 	// code, by attempting to parse it.
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := md.Parse(secondNumber, UNKNOWN_REGION)
 	if err == nil {
 		return isNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
 	}
-	if err != ErrInvalidCountryCode {
+	if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 	// The second number has no country calling code. EXACT_MATCH is no
 	// longer possible. We parse it as if the region was the same as that
 	// for the first number, and if EXACT_MATCH is returned, we replace
 	// this with NSN_MATCH.
-	firstNumberRegion := GetRegionCodeForCountryCode(int(firstNumber.GetCountryCode()))
+	firstNumberRegion := md.GetRegionCodeForCountryCode(int(firstNumber.GetCountryCode()))
 
 	if firstNumberRegion != UNKNOWN_REGION {
 		secondNumberWithFirstNumberRegion, err :=
-			Parse(secondNumber, firstNumberRegion)
+			md.Parse(secondNumber, firstNumberRegion)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
@@ -3185,7 +3609,7 @@ func isNumberMatchWithOneNumber(
 		// If the first number didn't have a valid country calling
 		// code, then we parse the second number without one as well.
 		var secondNumberProto *PhoneNumber
-		err := parseHelper(secondNumber, "", false, false, secondNumberProto)
+		err := md.parseHelper(secondNumber, "", false, false, secondNumberProto)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
@@ -3198,8 +3622,8 @@ func isNumberMatchWithOneNumber(
 // returns false. Does not check the number is a valid number. Note that,
 // at the moment, this method does not handle short numbers.
 // TODO: Make this method public when we have enough metadata to make it worthwhile.
-func canBeInternationallyDialled(number *PhoneNumber) bool {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+func (md *Metadata) canBeInternationallyDialled(number *PhoneNumber) bool {
+	metadata := md.getMetadataForRegion(md.GetRegionCodeForNumber(number))
 	if metadata == nil {
 		// Note numbers belonging to non-geographical entities
 		// (e.g. +800 numbers) are always internationally diallable,
@@ -3215,7 +3639,13 @@ func canBeInternationallyDialled(number *PhoneNumber) bool {
 // Returns false for invalid, unknown or regions that don't support mobile
 // number portability.
 func IsMobileNumberPortableRegion(regionCode string) bool {
-	metadata := getMetadataForRegion(regionCode)
+	return CurrentMetadata().IsMobileNumberPortableRegion(regionCode)
+}
+
+// IsMobileNumberPortableRegion is the same as the package level
+// IsMobileNumberPortableRegion, using this metadata.
+func (md *Metadata) IsMobileNumberPortableRegion(regionCode string) bool {
+	metadata := md.getMetadataForRegion(regionCode)
 	if metadata == nil {
 		return false
 	}
@@ -3230,26 +3660,118 @@ func init() {
 }
 
 func loadDataAndAtomicReplaceVar() (err error) {
+	metadata, err := loadMetadata(builtinMetadataRaw(), nil)
+	if err != nil {
+		return err
+	}
+	metadata.store()
+	return nil
+}
+
+// Metadata is a version of all the data numbers are parsed, formatted and
+// looked up with. It never changes once loaded; updates replace it as a
+// whole. Its methods are the package level functions of the same names,
+// using it rather than the metadata in use.
+type Metadata struct {
+	// the raw data it was loaded from
+	raw *metadataRaw
+
+	collection *PhoneMetadataCollection
+
+	// A mapping from a country calling code for a non-geographical
+	// entity to the PhoneMetadata for that country calling code.
+	// Examples of the country calling codes include 800 (International
+	// Toll Free Service) and 808 (International Shared Cost Service).
+	countryCodeToNonGeographicalMetadataMap map[int]*PhoneMetadata
+
+	// A mapping from a region code to the PhoneMetadata for that region.
+	regionToMetadataMap map[string]*PhoneMetadata
+
+	// The set of regions that share country calling code 1.
+	// There are roughly 26 regions.
+	nanpaRegions map[string]struct{}
+
+	// Our map from country code (as integer) to two letter region codes
+	countryCodeToRegion map[int][]string
+
+	timezoneMap *intStringArrayMap
+
+	// The set of calling codes that map to the non-geo entity
+	// region ("001"). This set currently contains < 12 elements so the
+	// default capacity of 16 (load factor=0.75) is fine.
+	countryCodesForNonGeographicalRegion map[int]bool
+
+	// The set of regions the library supports.
+	// There are roughly 240 of them and we set the initial capacity of
+	// the HashSet to 320 to offer a load factor of roughly 0.75.
+	supportedRegions map[string]bool
+
+	// All the calling codes we support
+	supportedCallingCodes map[int]bool
+
+	// A mapping from a country calling code to the alternate formats
+	// used for that country calling code when finding numbers in text.
+	alternateFormatsMap map[int]*PhoneMetadata
+
+	// A mapping from a region code to the short number metadata for
+	// that region.
+	shortNumberMetadataMap map[string]*PhoneMetadata
+
+	// our prefix to carrier and prefix to geocoding maps, decoded per
+	// language on first use
+	carrierPrefixMap   *prefixMapCache
+	geocodingPrefixMap *prefixMapCache
+}
+
+// Version returns the version of the metadata, the time it was fetched
+// for updated metadata
+func (md *Metadata) Version() string {
+	return md.raw.Version
+}
+
+// Loads metadata from the passed in raw data, with the passed in overrides
+// applied if they aren't nil, without putting it in use.
+func loadMetadata(m *metadataRaw, overrides *MetadataOverrides) (*Metadata, error) {
 	// load our regions
-	regionMap, err := loadIntStringArrayMap(getRegionMapData())
+	regionMap, err := loadIntStringArrayMap(m.RegionMapData)
 	if err != nil {
-		return fmt.Errorf("failed to load RegionMapData, err:%s", err)
+		return nil, fmt.Errorf("failed to load RegionMapData, err:%s", err)
 	}
 
-	timezoneMap, err := loadIntStringArrayMap(getTimezoneMapData())
+	timezoneMap, err := loadIntStringArrayMap(m.TimezoneMapData)
 	if err != nil {
-		return fmt.Errorf("failed to load TimezoneMapData, err:%s", err)
+		return nil, fmt.Errorf("failed to load TimezoneMapData, err:%s", err)
 	}
 
 	// then our metadata
-	metadataCollection, err := MetadataCollection()
+	metadataCollection, err := loadMetadataCollection(m.MetadataData)
 	if err != nil {
-		return fmt.Errorf("failed to load Metadata, err:%s", err)
+		return nil, fmt.Errorf("failed to load Metadata, err:%s", err)
 	}
 
 	metadataList := metadataCollection.GetMetadata()
 	if len(metadataList) == 0 {
-		panic(ErrEmptyMetadata)
+		return nil, ErrEmptyMetadata
+	}
+
+	// and any overrides on top of it, regions they add need mapping too
+	if overrides != nil {
+		metadataCollection, err = overrides.Apply(metadataCollection)
+		if err != nil {
+			return nil, err
+		}
+		metadataList = metadataCollection.GetMetadata()
+		for countryCode, regionCodes := range BuildCountryCodeToRegionMap(metadataCollection) {
+		nextRegion:
+			for _, regionCode := range regionCodes {
+				for _, mapped := range regionMap.Map[countryCode] {
+					if mapped == regionCode {
+						continue nextRegion
+					}
+				}
+				regionMap.Map[countryCode] = append(regionMap.Map[countryCode], regionCode)
+			}
+		}
 	}
 
 	countryCodeToNonGeographicalMetadataMap := make(map[int]*PhoneMetadata)
@@ -3299,88 +3821,167 @@ func loadDataAndAtomicReplaceVar() (err error) {
 		nanpaRegions[val] = struct{}{}
 	}
 
-	// carriers
-	carrierPrefixMap := make(map[string]*intStringMap)
-	for lang := range getCarrierMapData() {
-		prefixMap, err := loadPrefixMap(getCarrierMapData()[lang])
-		if err != nil {
-			return fmt.Errorf("failed to load CarrierMapData PrefixMap for lang:%s, err:%s", lang, err)
-		}
-		carrierPrefixMap[lang] = prefixMap
+	// alternate formats, keyed by country calling code
+	alternateFormatsMap, err := loadAlternateFormatsMap(m.AlternateFormatsData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AlternateFormatData, err:%s", err)
 	}
-	// 
-	geocodingPrefixMap := make(map[string]*intStringMap)
-	for lang := range getGeocodingMapData() {
-		prefixMap, err := loadPrefixMap(getGeocodingMapData()[lang])
-		if err != nil {
-			return fmt.Errorf("failed to load GeocodingMapData PrefixMap for lang:%s, err:%s", lang, err)
-		}
-		geocodingPrefixMap[lang] = prefixMap
+
+	// short numbers, keyed by region code
+	shortNumberMetadataMap, err := loadShortNumberMetadataMap(m.ShortNumberMetadataData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ShortNumberMetadataData, err:%s", err)
 	}
 
-	// atomic replace map
-	_countryCodeToNonGeographicalMetadataMap.Store(countryCodeToNonGeographicalMetadataMap)
-	_regionToMetadataMap.Store(regionToMetadataMap)
+	return &Metadata{
+		raw:                                     m,
+		collection:                              metadataCollection,
+		countryCodeToNonGeographicalMetadataMap: countryCodeToNonGeographicalMetadataMap,
+		regionToMetadataMap:                     regionToMetadataMap,
+		nanpaRegions:                            nanpaRegions,
+		countryCodeToRegion:                     regionMap.Map,
+		timezoneMap:                             timezoneMap,
+		countryCodesForNonGeographicalRegion:    countryCodesForNonGeographicalRegion,
+		supportedRegions:                        supportedRegions,
+		supportedCallingCodes:                   supportedCallingCodes,
+		alternateFormatsMap:                     alternateFormatsMap,
+		shortNumberMetadataMap:                  shortNumberMetadataMap,
 
-	_nanpaRegions.Store(nanpaRegions)
+		// carriers and geocodings, decoded per language on first use
+		carrierPrefixMap:   newPrefixMapCache("CarrierMapData", m.CarrierMapData),
+		geocodingPrefixMap: newPrefixMapCache("GeocodingMapData", m.GeocodingMapData),
+	}, nil
+}
 
-	_countryCodeToRegion.Store(regionMap.Map)
-	_timezoneMap.Store(timezoneMap)
+// Puts the metadata in use.
+func (md *Metadata) store() {
+	_metadata.Store(md)
+}
 
-	_countryCodesForNonGeographicalRegion.Store(countryCodesForNonGeographicalRegion)
-	_supportedRegions.Store(supportedRegions)
-	_supportedCallingCodes.Store(supportedCallingCodes)
+func loadAlternateFormatsMap(data string) (map[int]*PhoneMetadata, error) {
+	rawBytes, err := decodeUnzipString(data)
+	if err != nil {
+		return nil, err
+	}
 
-	_carrierPrefixMap.Store(carrierPrefixMap)
-	_geocodingPrefixMap.Store(geocodingPrefixMap)
+	var collection = &PhoneMetadataCollection{}
+	if err = proto.Unmarshal(rawBytes, collection); err != nil {
+		return nil, err
+	}
 
-	// extra atomic replace map
-	_currMetadataColl.Store(metadataCollection)
-	return nil
+	alternateFormatsMap := make(map[int]*PhoneMetadata, len(collection.GetMetadata()))
+	for _, meta := range collection.GetMetadata() {
+		alternateFormatsMap[int(meta.GetCountryCode())] = meta
+	}
+	return alternateFormatsMap, nil
 }
 
+func loadShortNumberMetadataMap(data string) (map[string]*PhoneMetadata, error) {
+	rawBytes, err := decodeUnzipString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var collection = &PhoneMetadataCollection{}
+	if err = proto.Unmarshal(rawBytes, collection); err != nil {
+		return nil, err
+	}
+
+	shortNumberMetadataMap := make(map[string]*PhoneMetadata, len(collection.GetMetadata()))
+	for _, meta := range collection.GetMetadata() {
+		shortNumberMetadataMap[meta.GetId()] = meta
+	}
+	return shortNumberMetadataMap, nil
+}
+
+// ErrInvalidPrefix is returned when a prefix to look up isn't made of digits
+var ErrInvalidPrefix = errors.New("the prefix supplied is not made of digits")
+
 // GetTimezonesForPrefix returns a slice of Timezones corresponding to the number passed
-// or error when it is impossible to convert the string to int
+// or ErrInvalidPrefix when it isn't made of digits, an optional leading + aside.
 // The algorythm tries to match the timezones starting from the maximum
 // number of phone number digits and decreasing until it finds one or reaches 0
 func GetTimezonesForPrefix(number string) ([]string, error) {
+	return CurrentMetadata().GetTimezonesForPrefix(number)
+}
+
+// GetTimezonesForPrefix is the same as the package level
+// GetTimezonesForPrefix, using this metadata.
+func (md *Metadata) GetTimezonesForPrefix(number string) ([]string, error) {
 	// strip any leading +
 	number = strings.TrimLeft(number, "+")
+	if number == "" || !isDigits(number) {
+		return nil, ErrInvalidPrefix
+	}
+	return md.getTimezonesForDigits(number, 1), nil
+}
+
+// GetTimezonesForPrefixWithRegion returns a slice of Timezones corresponding to the
+// passed in national number prefix of the passed in country calling code, as for
+// GetTimezonesForPrefix. Only timezones of that country calling code are matched, and
+// an empty prefix matches the timezones of the whole country calling code. Returns
+// ErrInvalidCountryCode for unknown country calling codes.
+func GetTimezonesForPrefixWithRegion(prefix string, countryCode int) ([]string, error) {
+	return CurrentMetadata().GetTimezonesForPrefixWithRegion(prefix, countryCode)
+}
+
+// GetTimezonesForPrefixWithRegion is the same as the package level
+// GetTimezonesForPrefixWithRegion, using this metadata.
+func (md *Metadata) GetTimezonesForPrefixWithRegion(prefix string, countryCode int) ([]string, error) {
+	if !isDigits(prefix) {
+		return nil, ErrInvalidPrefix
+	}
+	if len(md.GetRegionCodesForCountryCode(countryCode)) == 0 {
+		return nil, ErrInvalidCountryCode
+	}
+	code := strconv.Itoa(countryCode)
+	return md.getTimezonesForDigits(code+prefix, len(code)), nil
+}
 
-	for i := getTimezoneMap().MaxLength; i > 0; i-- {
-		index, err := strconv.Atoi(number[0:i])
+// Returns the timezones of the longest prefix of the passed in digits which is
+// at least minLength digits long, or UNKNOWN_TIMEZONE if there is none.
+func (md *Metadata) getTimezonesForDigits(digits string, minLength int) []string {
+	maxLength := md.timezoneMap.MaxLength
+	if maxLength > len(digits) {
+		maxLength = len(digits)
+	}
+	for i := maxLength; i >= minLength; i-- {
+		index, err := strconv.Atoi(digits[0:i])
 		if err != nil {
-			return nil, err
+			break
 		}
-		tzs, found := getTimezoneMap().Map[index]
+		tzs, found := md.timezoneMap.Map[index]
 		if found {
-			return tzs, nil
+			return tzs
 		}
 	}
-	return []string{UNKNOWN_TIMEZONE}, nil
+	return []string{UNKNOWN_TIMEZONE}
 }
 
 // GetTimezonesForNumber returns the names of timezones which we believe maps to the
 // passed in number.
 func GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
-	e164 := Format(number, E164)
-	return GetTimezonesForPrefix(e164)
+	return CurrentMetadata().GetTimezonesForNumber(number)
 }
 
-func getValueForNumber(langMap map[string]*intStringMap, binMap map[string]string, language string, maxLength int, number *PhoneNumber) (string, error) {
-	// do we have data for this language
-	_, existing := binMap[language]
-	if !existing {
-		return "", nil
-	}
+// GetTimezonesForNumber is the same as the package level
+// GetTimezonesForNumber, using this metadata.
+func (md *Metadata) GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
+	e164 := md.Format(number, E164)
+	return md.GetTimezonesForPrefix(e164)
+}
 
+func (md *Metadata) getValueForNumber(prefixMaps *prefixMapCache, language string, maxLength int, number *PhoneNumber) (string, error) {
 	// do we have a map for this language?
-	prefixMap, ok := langMap[language]
-	if !ok {
-		return "", fmt.Errorf("error loading language map for %s", language)
+	prefixMap, err := prefixMaps.getPrefixMap(language)
+	if err != nil {
+		return "", err
+	}
+	if prefixMap == nil {
+		return "", nil
 	}
 
-	e164 := Format(number, E164)
+	e164 := md.Format(number, E164)
 
 	l := len(e164)
 	if maxLength > l {
@@ -3398,34 +3999,44 @@ func getValueForNumber(langMap map[string]*intStringMap, binMap map[string]strin
 	return "", nil
 }
 
+// Returns the value for the number from the first language in the fallback
+// chain of the passed in locale which has one.
+func (md *Metadata) getValueForLocale(prefixMaps *prefixMapCache, locale string, maxLength int, number *PhoneNumber) (string, error) {
+	for _, language := range localeFallbackChain(locale) {
+		value, err := md.getValueForNumber(prefixMaps, language, maxLength, number)
+		if err != nil || value != "" {
+			return value, err
+		}
+	}
+	return "", nil
+}
+
 // GetCarrierForNumber returns the carrier we believe the number belongs to. Note due
 // to number porting this is only a guess, there is no guarantee to its accuracy.
+//
+// The lang is a BCP 47 language tag such as "zh-TW". If there is no carrier name in
+// that locale, the language and script, the language alone and then English are tried.
 func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
-	carrierPrefixMap, carrierMapData := getCarrierPrefixMap(), getCarrierMapData()
-	carrier, err := getValueForNumber(carrierPrefixMap, carrierMapData, lang, 10, number)
-	if err != nil {
-		return "", err
-	}
-	if carrier != "" {
-		return carrier, nil
-	}
+	return CurrentMetadata().GetCarrierForNumber(number, lang)
+}
 
-	// fallback to english
-	return getValueForNumber(carrierPrefixMap, carrierMapData, "en", 10, number)
+// GetCarrierForNumber is the same as the package level
+// GetCarrierForNumber, using this metadata.
+func (md *Metadata) GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
+	return md.getValueForLocale(md.carrierPrefixMap, lang, 10, number)
 }
 
 // GetGeocodingForNumber returns the location we think the number was first acquired in. This is
 // just our best guess, there is no guarantee to its accuracy.
+//
+// The lang is a BCP 47 language tag such as "pt-BR". If there is no location name in
+// that locale, the language and script, the language alone and then English are tried.
 func GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
-	geocodingPrefixMap, geocodingMapData := getGeocodingPrefixMap(), getGeocodingMapData()
-	geocoding, err := getValueForNumber(geocodingPrefixMap, geocodingMapData, lang, 10, number)
-	if err != nil {
-		return "", err
-	}
-	if geocoding != "" {
-		return geocoding, nil
-	}
+	return CurrentMetadata().GetGeocodingForNumber(number, lang)
+}
 
-	// fallback to english
-	return getValueForNumber(geocodingPrefixMap, geocodingMapData, "en", 10, number)
+// GetGeocodingForNumber is the same as the package level
+// GetGeocodingForNumber, using this metadata.
+func (md *Metadata) GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
+	return md.getValueForLocale(md.geocodingPrefixMap, lang, 10, number)
 }
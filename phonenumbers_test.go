@@ -1,9 +1,11 @@
 package phonenumbers
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -81,7 +83,7 @@ func TestParse(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if num.GetNationalNumber() != test.expectedNum {
@@ -90,6 +92,145 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseWithOptions(t *testing.T) {
+	var tests = []struct {
+		input    string
+		region   string
+		options  ParseOptions
+		err      error
+		offset   int
+		expected *PhoneNumber
+	}{
+		{
+			input:    "1-800-FLOWERS",
+			region:   "US",
+			expected: &PhoneNumber{CountryCode: proto.Int32(1), NationalNumber: proto.Uint64(8003569377)},
+		}, {
+			input:   "1-800-FLOWERS",
+			region:  "US",
+			options: ParseOptions{DisableAlphaConversion: true},
+			err:     ErrNotANumber,
+			offset:  6,
+		}, {
+			input:    "650 253 0000 ext 123",
+			region:   "US",
+			expected: &PhoneNumber{CountryCode: proto.Int32(1), NationalNumber: proto.Uint64(6502530000), Extension: proto.String("123")},
+		}, {
+			input:   "650 253 0000 ext 123",
+			region:  "US",
+			options: ParseOptions{RejectExtensions: true},
+			err:     ErrExtensionNotAllowed,
+			offset:  12,
+		}, {
+			input:   "(650) 253 0000",
+			region:  "US",
+			options: ParseOptions{RequirePlusSign: true},
+			err:     ErrMissingPlusSign,
+			offset:  1,
+		}, {
+			input:   "011 44 20 7031 3000",
+			region:  "US",
+			options: ParseOptions{RequirePlusSign: true},
+			err:     ErrMissingPlusSign,
+			offset:  0,
+		}, {
+			input:    "+1 650 253 0000",
+			region:   "",
+			options:  ParseOptions{RequirePlusSign: true},
+			expected: &PhoneNumber{CountryCode: proto.Int32(1), NationalNumber: proto.Uint64(6502530000)},
+		}, {
+			input:   "650 253 0000",
+			region:  "US",
+			options: ParseOptions{MaxInputLength: 10},
+			err:     ErrNumTooLong,
+			offset:  10,
+		}, {
+			input:   "650 253 0000",
+			region:  "US",
+			options: ParseOptions{KeepRawInput: true},
+			expected: &PhoneNumber{
+				CountryCode:                  proto.Int32(1),
+				NationalNumber:               proto.Uint64(6502530000),
+				RawInput:                     proto.String("650 253 0000"),
+				PreferredDomesticCarrierCode: proto.String(""),
+			},
+		}, {
+			input:   "+1 650 253 0000",
+			region:  "US",
+			options: ParseOptions{KeepCountryCodeSource: true},
+			expected: &PhoneNumber{
+				CountryCode:       proto.Int32(1),
+				NationalNumber:    proto.Uint64(6502530000),
+				CountryCodeSource: PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN.Enum(),
+			},
+		},
+	}
+
+	for i, test := range tests {
+		num, err := ParseWithOptions(test.input, test.region, test.options)
+		if !errors.Is(err, test.err) {
+			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
+			continue
+		}
+		if test.err != nil {
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Offset != test.offset {
+				t.Errorf("[test %d:offset] failed: %v != %d\n", i, parseErr, test.offset)
+			}
+			continue
+		}
+		if !proto.Equal(num, test.expected) {
+			t.Errorf("[test %d:num] failed: %v != %v\n", i, num, test.expected)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var tests = []struct {
+		input    string
+		region   string
+		sentinel error
+		kind     ParseErrorKind
+		offset   int
+	}{
+		{"", "US", ErrNotANumber, NOT_A_NUMBER_ERROR, -1},
+		{"This is not a phone number", "US", ErrNotANumber, NOT_A_NUMBER_ERROR, -1},
+		{"650 253 0000 @ 5", "US", ErrNotANumber, NOT_A_NUMBER_ERROR, 13},
+		{"+999 1234567", "", ErrInvalidCountryCode, INVALID_COUNTRY_CODE_ERROR, 1},
+		{"  +999 1234567", "US", ErrInvalidCountryCode, INVALID_COUNTRY_CODE_ERROR, 3},
+		{"2530000", "", ErrInvalidCountryCode, INVALID_COUNTRY_CODE_ERROR, -1},
+		{"+49 0", "", ErrTooShortNSN, TOO_SHORT_NSN_ERROR, -1},
+		{"01112", "US", ErrTooShortAfterIDD, TOO_SHORT_AFTER_IDD_ERROR, -1},
+		{"01495 72553301873 810104", "GB", ErrNumTooLong, TOO_LONG_ERROR, -1},
+		{strings.Repeat("1", MAX_INPUT_STRING_LENGTH+1), "US", ErrNumTooLong, TOO_LONG_ERROR, MAX_INPUT_STRING_LENGTH},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.input, test.region)
+		if !errors.Is(err, test.sentinel) {
+			t.Errorf("%q: expected %v, got %v", test.input, test.sentinel, err)
+			continue
+		}
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%q: expected a *ParseError, got %T", test.input, err)
+			continue
+		}
+		if parseErr.Kind != test.kind {
+			t.Errorf("%q: expected kind %s, got %s", test.input, test.kind, parseErr.Kind)
+		}
+		if parseErr.Input != test.input || parseErr.DefaultRegion != test.region {
+			t.Errorf("%q: unexpected input %q or region %q", test.input, parseErr.Input, parseErr.DefaultRegion)
+		}
+		if parseErr.Offset != test.offset {
+			t.Errorf("%q: expected offset %d, got %d", test.input, test.offset, parseErr.Offset)
+		}
+		if err.Error() != test.sentinel.Error() {
+			t.Errorf("%q: expected message %q, got %q", test.input, test.sentinel.Error(), err.Error())
+		}
+	}
+}
+
 func TestConvertAlphaCharactersInNumber(t *testing.T) {
 	var tests = []struct {
 		input, output string
@@ -314,7 +455,7 @@ func TestIsValidNumber(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -400,7 +541,7 @@ func TestIsValidNumberForRegion(t *testing.T) {
 
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
-		if err != test.err {
+		if !errors.Is(err, test.err) {
 			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
 		}
 		if test.err != nil {
@@ -471,7 +612,7 @@ func TestIsPossibleNumberWithReason(t *testing.T) {
 	for i, test := range tests {
 		num, err := Parse(test.input, test.region)
 		if err != nil {
-			if test.err == err {
+			if errors.Is(err, test.err) {
 				continue
 			}
 			t.Errorf("[test %d:err] failed: %v\n", i, err)
@@ -940,7 +1081,7 @@ func TestGetMetadata(t *testing.T) {
 		},
 	}
 	for i, test := range tests {
-		meta := getMetadataForRegion(test.name)
+		meta := CurrentMetadata().getMetadataForRegion(test.name)
 		if meta.GetId() != test.name {
 			t.Errorf("[test %d:name] %s != %s\n", i, meta.GetId(), test.name)
 		}
@@ -963,12 +1104,19 @@ func TestGetMetadata(t *testing.T) {
 }
 
 func TestIsNumberGeographical(t *testing.T) {
-	if !isNumberGeographical(getTestNumber("AU_NUMBER")) {
+	if !CurrentMetadata().isNumberGeographical(getTestNumber("AU_NUMBER")) {
 		t.Error("Australia should be a geographical number")
 	}
-	if isNumberGeographical(getTestNumber("INTERNATIONAL_TOLL_FREE")) {
+	if CurrentMetadata().isNumberGeographical(getTestNumber("INTERNATIONAL_TOLL_FREE")) {
 		t.Error("An international toll free number should not be geographical")
 	}
+	// mobile numbers can be geocoded in some countries only
+	if !isNumberTypeGeographical(MOBILE, 52) {
+		t.Error("Mexican mobile numbers should be geographical")
+	}
+	if isNumberTypeGeographical(MOBILE, 44) {
+		t.Error("British mobile numbers should not be geographical")
+	}
 }
 
 func TestGetLengthOfGeographicalAreaCode(t *testing.T) {
@@ -1289,6 +1437,9 @@ func TestParsing(t *testing.T) {
 }
 
 func TestGetTimeZonesForPrefix(t *testing.T) {
+	if getTimezoneMap().MaxLength == 0 {
+		t.Skip("built without timezone data")
+	}
 	tests := []timeZonesTestCases{
 		{
 			num:              "+442073238299",
@@ -1388,10 +1539,62 @@ func TestGetTimeZonesForPrefix(t *testing.T) {
 	}
 }
 
+func TestGetTimezonesForPrefixInvalid(t *testing.T) {
+	tests := []string{"", "+", "abc", "+44 20", "4420x", "１２"}
+	for i, test := range tests {
+		_, err := GetTimezonesForPrefix(test)
+		if err != ErrInvalidPrefix {
+			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, ErrInvalidPrefix)
+		}
+	}
+}
+
+func TestGetTimezonesForPrefixWithRegion(t *testing.T) {
+	if getTimezoneMap().MaxLength == 0 {
+		t.Skip("built without timezone data")
+	}
+	tests := []struct {
+		prefix      string
+		countryCode int
+		expected    string
+		err         error
+	}{
+		{"2073238299", 44, "Europe/London", nil},
+		{"20", 44, "Europe/London", nil},
+		{"5167706076", 1, "America/New_York", nil},
+		{"", 1, "America/Adak", nil},
+		{"9", 61, "Australia/Adelaide", nil},
+		{"12345678", 800, "Etc/Unknown", nil},
+		{"20", 999, "", ErrInvalidCountryCode},
+		{"2O", 44, "", ErrInvalidPrefix},
+	}
+
+	for i, test := range tests {
+		timezones, err := GetTimezonesForPrefixWithRegion(test.prefix, test.countryCode)
+		if err != test.err {
+			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(timezones) == 0 || timezones[0] != test.expected {
+			t.Errorf("[test %d:timezone] failed: %v != %v\n", i, timezones, test.expected)
+		}
+	}
+}
+
 func TestGetCarrierForNumber(t *testing.T) {
+	if len(getCarrierMapData()) == 0 {
+		t.Skip("built without carrier data")
+	}
 	tests := []prefixMapTestCases{
 		{num: "+8613702032331", lang: "en", expected: "China Mobile"},
 		{num: "+8613702032331", lang: "zh", expected: "中国移动"},
+		{num: "+8613702032331", lang: "zh_Hant", expected: "中國移動"},
+		{num: "+8613702032331", lang: "zh-TW", expected: "中國移動"},
+		{num: "+8613702032331", lang: "zh-Hans-CN", expected: "中国移动"},
+		{num: "+8613702032331", lang: "de-CH", expected: "China Mobile"},
 		{num: "+6281377468527", lang: "en", expected: "Telkomsel"},
 		{num: "+8613323241342", lang: "en", expected: "China Telecom"},
 		{num: "+61491570156", lang: "en", expected: "Telstra"},
@@ -1414,9 +1617,17 @@ func TestGetCarrierForNumber(t *testing.T) {
 }
 
 func TestGetGeocodingForNumber(t *testing.T) {
+	if len(getGeocodingMapData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
 	tests := []prefixMapTestCases{
 		{num: "+8613702032331", lang: "en", expected: "Tianjin"},
 		{num: "+8613702032331", lang: "zh", expected: "天津市"},
+		{num: "+8613702032331", lang: "zh-TW", expected: "天津市"},
+		{num: "+551155256325", lang: "pt-BR", expected: "São Paulo - SP"},
+		{num: "+41446681800", lang: "de-CH", expected: "Zürich"},
+		{num: "+41446681800", lang: "fr_CH", expected: "Zurich"},
+		{num: "+97226442222", lang: "he-IL", expected: "ירושלים"},
 		{num: "+863197785050", lang: "zh", expected: "河北省邢台市"},
 		{num: "+8613323241342", lang: "en", expected: "Baoding, Hebei"},
 		{num: "+917999999543", lang: "en", expected: "Ahmedabad Local, Gujarat"},
@@ -1,3 +1,6 @@
+//go:build !phonenumbers_nocarrier
+// +build !phonenumbers_nocarrier
+
 package phonenumbers
 
 import (
@@ -0,0 +1,19 @@
+//go:build phonenumbers_nocarrier
+// +build phonenumbers_nocarrier
+
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+// Built with the phonenumbers_nocarrier tag, prefix_to_carriers_bin.go
+// is left out and there is no carrier data unless the updater loads it.
+var _carrierMapData atomic.Value
+
+func getCarrierMapData() map[string]string {
+	if _carrierMapData.Load() == nil {
+		_carrierMapData.Store(map[string]string{})
+	}
+	return _carrierMapData.Load().(map[string]string)
+}
@@ -1,3 +1,6 @@
+//go:build !phonenumbers_nogeocoding
+// +build !phonenumbers_nogeocoding
+
 package phonenumbers
 
 import (
@@ -0,0 +1,19 @@
+//go:build phonenumbers_nogeocoding
+// +build phonenumbers_nogeocoding
+
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+// Built with the phonenumbers_nogeocoding tag, prefix_to_geocodings_bin.go
+// is left out and there is no geocoding data unless the updater loads it.
+var _geocodingMapData atomic.Value
+
+func getGeocodingMapData() map[string]string {
+	if _geocodingMapData.Load() == nil {
+		_geocodingMapData.Store(map[string]string{})
+	}
+	return _geocodingMapData.Load().(map[string]string)
+}
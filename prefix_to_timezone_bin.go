@@ -1,3 +1,6 @@
+//go:build !phonenumbers_notimezone
+// +build !phonenumbers_notimezone
+
 package phonenumbers
 
 import (
@@ -0,0 +1,21 @@
+//go:build phonenumbers_notimezone
+// +build phonenumbers_notimezone
+
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+// Built with the phonenumbers_notimezone tag, prefix_to_timezone_bin.go
+// is left out and there is no timezone data unless the updater loads it,
+// so every number has the unknown timezone.
+var _timezoneMapData atomic.Value
+
+func getTimezoneMapData() string {
+	if _timezoneMapData.Load() == nil {
+		// an empty prefix map
+		_timezoneMapData.Store("H4sIAAAAAAAA/wAIAPf/AAAAAAAAAAADAGnfImUIAAAA")
+	}
+	return _timezoneMapData.Load().(string)
+}
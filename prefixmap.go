@@ -0,0 +1,159 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The default time the decoded geocoding or carrier data for a language
+// is kept after it was last used.
+const DEFAULT_PREFIX_MAP_IDLE_TIMEOUT = 5 * time.Minute
+
+var (
+	// how long decoded prefix maps are kept after their last use, in
+	// nanoseconds, zero keeps them forever
+	_prefixMapIdleTimeout = int64(DEFAULT_PREFIX_MAP_IDLE_TIMEOUT)
+
+	// whether geocoding and carrier data is loaded at all, 1 if so
+	_prefixMapsEnabled int32 = 1
+)
+
+// SetPrefixMapIdleTimeout sets how long the decoded geocoding or carrier
+// data for a language is kept after it was last used. The data for each
+// language is decoded on first use, so dropped data is decoded again the
+// next time it is needed. A timeout of zero keeps decoded data forever.
+func SetPrefixMapIdleTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&_prefixMapIdleTimeout, int64(timeout))
+}
+
+func getPrefixMapIdleTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64(&_prefixMapIdleTimeout))
+}
+
+// SetPrefixMapsEnabled sets whether geocoding and carrier data is loaded.
+// When disabled, nothing is decoded and GetGeocodingForNumber and
+// GetCarrierForNumber behave as if there was no data for any language,
+// returning empty strings. Disabling also drops any decoded data.
+func SetPrefixMapsEnabled(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&_prefixMapsEnabled, 1)
+	} else {
+		atomic.StoreInt32(&_prefixMapsEnabled, 0)
+		getCarrierPrefixMap().clear()
+		getGeocodingPrefixMap().clear()
+	}
+}
+
+func prefixMapsEnabled() bool {
+	return atomic.LoadInt32(&_prefixMapsEnabled) == 1
+}
+
+// prefixMapCache decodes the prefix maps of the languages in its data on
+// first use, and drops them again once they haven't been used for the
+// prefix map idle timeout.
+type prefixMapCache struct {
+	name string
+	data map[string]string
+
+	mutex sync.Mutex
+	maps  map[string]*cachedPrefixMap
+	timer *time.Timer
+}
+
+type cachedPrefixMap struct {
+	prefixMap *intStringMap
+	lastUsed  time.Time
+}
+
+func newPrefixMapCache(name string, data map[string]string) *prefixMapCache {
+	return &prefixMapCache{
+		name: name,
+		data: data,
+		maps: make(map[string]*cachedPrefixMap),
+	}
+}
+
+// Returns the prefix map for the passed in language, decoding it if this
+// is its first use. Returns nil if there is no data for the language.
+func (c *prefixMapCache) getPrefixMap(language string) (*intStringMap, error) {
+	if !prefixMapsEnabled() {
+		return nil, nil
+	}
+	data, ok := c.data[language]
+	if !ok {
+		return nil, nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, ok := c.maps[language]
+	if !ok {
+		prefixMap, err := loadPrefixMap(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s PrefixMap for lang:%s, err:%s", c.name, language, err)
+		}
+		cached = &cachedPrefixMap{prefixMap: prefixMap}
+		c.maps[language] = cached
+
+		if timeout := getPrefixMapIdleTimeout(); timeout > 0 && c.timer == nil {
+			c.timer = time.AfterFunc(timeout, c.evictIdle)
+		}
+	}
+	cached.lastUsed = time.Now()
+	return cached.prefixMap, nil
+}
+
+// Drops the prefix maps which haven't been used for the idle timeout,
+// checking again later while any are left.
+func (c *prefixMapCache) evictIdle() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	timeout := getPrefixMapIdleTimeout()
+	c.evict(time.Now(), timeout)
+
+	c.timer = nil
+	if timeout > 0 && len(c.maps) > 0 {
+		c.timer = time.AfterFunc(timeout, c.evictIdle)
+	}
+}
+
+// Drops the prefix maps last used more than timeout before now. Must be
+// called with the mutex held.
+func (c *prefixMapCache) evict(now time.Time, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	for language, cached := range c.maps {
+		if now.Sub(cached.lastUsed) >= timeout {
+			delete(c.maps, language)
+		}
+	}
+}
+
+// Drops all decoded prefix maps.
+func (c *prefixMapCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.maps = make(map[string]*cachedPrefixMap)
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+}
+
+// Returns the languages whose prefix maps are currently decoded.
+func (c *prefixMapCache) loadedLanguages() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	languages := make([]string, 0, len(c.maps))
+	for language := range c.maps {
+		languages = append(languages, language)
+	}
+	return languages
+}
@@ -0,0 +1,82 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestPrefixMapCache(t *testing.T) {
+	if len(getGeocodingMapData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
+	cache := newPrefixMapCache("GeocodingMapData", getGeocodingMapData())
+	defer cache.clear()
+
+	// nothing is decoded until used
+	if languages := cache.loadedLanguages(); len(languages) != 0 {
+		t.Errorf("expected no loaded languages, got %v", languages)
+	}
+
+	for _, language := range []string{"zh", "en", "xx"} {
+		if _, err := cache.getPrefixMap(language); err != nil {
+			t.Errorf("failed to get prefix map for %s: %s", language, err)
+		}
+	}
+	languages := cache.loadedLanguages()
+	sort.Strings(languages)
+	if !reflect.DeepEqual(languages, []string{"en", "zh"}) {
+		t.Errorf("expected en and zh to be loaded, got %v", languages)
+	}
+
+	// maps unused for the idle timeout are dropped
+	cache.mutex.Lock()
+	cache.maps["zh"].lastUsed = time.Now().Add(-time.Hour)
+	cache.evict(time.Now(), time.Minute)
+	cache.mutex.Unlock()
+
+	if languages := cache.loadedLanguages(); !reflect.DeepEqual(languages, []string{"en"}) {
+		t.Errorf("expected only en to be loaded, got %v", languages)
+	}
+
+	// and decoded again on their next use
+	prefixMap, err := cache.getPrefixMap("zh")
+	if err != nil || prefixMap == nil {
+		t.Errorf("expected prefix map for zh, got %v, %v", prefixMap, err)
+	}
+
+	// broken data is reported when used
+	broken := newPrefixMapCache("CarrierMapData", map[string]string{"en": "broken"})
+	if _, err := broken.getPrefixMap("en"); err == nil {
+		t.Errorf("expected error loading broken prefix map")
+	}
+}
+
+func TestSetPrefixMapsEnabled(t *testing.T) {
+	if len(getGeocodingMapData()) == 0 {
+		t.Skip("built without geocoding data")
+	}
+	number, err := Parse("+8613702032331", "ZZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetPrefixMapsEnabled(false)
+	defer SetPrefixMapsEnabled(true)
+
+	if languages := getGeocodingPrefixMap().loadedLanguages(); len(languages) != 0 {
+		t.Errorf("expected no loaded languages, got %v", languages)
+	}
+	if geocoding, err := GetGeocodingForNumber(number, "en"); geocoding != "" || err != nil {
+		t.Errorf("expected no geocoding, got %s, %v", geocoding, err)
+	}
+	if carrier, err := GetCarrierForNumber(number, "en"); carrier != "" || err != nil {
+		t.Errorf("expected no carrier, got %s, %v", carrier, err)
+	}
+
+	SetPrefixMapsEnabled(true)
+	if geocoding, _ := GetGeocodingForNumber(number, "en"); geocoding != "Tianjin" {
+		t.Errorf("expected Tianjin, got %s", geocoding)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build !phonenumbers_nogeocoding
+// +build !phonenumbers_nogeocoding
+
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+var _regionNameData atomic.Value
+
+func getRegionNameData() map[string]string {
+	if _regionNameData.Load() == nil {
+		_regionNameData.Store(map[string]string {
+	"ar": "H4sIAAAAAAAA/5xYTZbaSBJeK06hpb3oQ4AAQYEojAS4uA0FyBpt5gzTfu6kmLIZlaraz5wk4jbzvsiQALtnFr2oVyiUmfH7fRGpThTwM79IyRU/hfyZaykl51cppZCcOr2AP0vOJym4YkedfsBOdvxF9uwg4WOogh9c8UFKnOFkJ3s+8lc+8RN1Bjhhw2d2knPNR/ynTgxpzkcp+SwFu1AKPugZBZ+gaOQXnKWQUnaQTCDZ8QEHSAlJEvBnrmQPk73k/rJL98y9uZ+54mevDsrTgGt2slfFfoHsuZJStnCBOpluk1z2WEidBRTB9krlqmoFWaVmO+p81DByFfLvsBYho84aS/5jkXmGlLodPVr9rCXnJziOMz9xxbVsqdsNfCDYadRr6vYCPqhTO5WV/ErdPmQ7fobN7Kg7wHPBFTyQHPHcsONaCurGeLVDAuAiFg/NBv4KCawaNdsLWC4lde8gyf3biY9XHqpVFR8RA4Sduolaq6FE2rpT/4xjHN7f45hC12+88g+BfFJJjkT7ctmabVpB1LWsIQwOtSk76qYmk0/IHA7K9GgrqO6qeao1oDlWPECm1aOJqqm7hmQnJb9Q1AlkC2/ZURQ16ZOtFPjjOnynsYTlOZ/fU9RTC/BSk1GEv4UW7VeEGscMAn6WPfxTdwAo2eCnPMJ572zBNX+Xf1AU/3pg4zM72UA5RcMAWZSSa7yhaKS54K+ya4Dn+Jmi8a0DFE0CPiqMd1JSlJgqxA5Yhw8UTVXKb3CConvEA4kpZI+4QdtcZR626scW0oWXHvB76c+oAJDQwPaN/+SKopVfhiSifAqKPl6TjWxxItfIJtcUPQTyqP6/UbT2p3oPttTrA9eglQb7vTuEuvQ5l5J6Y7/jhNwrmLfUSwJAqOEHBUrv/pcU6bbbZZJTz0xAVB3/wRX1o4b74BWQWEDaD/gLAoSQatD6cSB7fsM7A9obgMaO/+UDdG64kvpzbIYdR8NmP4WkvmK5fgbJv0HIlpXBKJCN5A2EaHCH5xLxoMGlEDYohJaMBkmgHiLo3lKQvqPB/WWD5rigwTzQqgVdO4qNscAfgHJOcVclspe91tQvnB/3Aj7DIbCAmhj3EXbUApLmKB4EgBB+Y40/wHRqZOIYC1CpOQqG4mFgLcRRPILJByDgu5r8SPGk1dh6HCeQubaY46keaWGNZ3jyeQQmDxR/uHqvFqEukVh2/Ie3yjMTcgGzJac4a45Bb0F9ou/EC5PKnuLVzbGwxaMhftAX5tRw7Hkx53PYUEJ4gSfUh+9kLzl/l0e02S8IbFPD39jxGz+9p+EUx7QNm2saAsPIK5q1uj7MAmXREs80XPgdl/4w6gX8BSG0Qql110gxiIw0dTeaoDKVlxAf2dEouQE4wpHTyNOMKjnR6L5B0aMGch82XC8lf8cGKVG7ZVNUXyEPLydISaMPgXEf+vEjjQAjZRXVlwb8u5rdGgoI2fEgf0d3Wo/YUUtJd2o2KsUZTdyZlRgcTpLT3cwSDxDASBr3wW6WVhrHgVJ8pRl9aeec8RCr9kpTJ9U8HkGCMJY4SVMwThoMqpJH8BeNp9Z1+YiCAEXUoc8IwsM1jWdGsJeCfVUfYOcTjY28r14/S24s8kTj1aX9lHyk8UNLBFsUh56U03iN8nHKgt9avyadQHGGpjrp4vfBADGJrswGsHz1TEYB0s3f+KgNE10bXWcyxnIAF8flUEWTuV97sDA5mqRegt5+lIImmX9WbFoKJgvICtlqR0HAKz7TZAnjUPmImaPJw+VsR0nHmAzkwQdKIpAksOcQOkp6/tk3iA07SmwANv5BzDEzF3yiZGCehzYcHyWnRJvBic/8Ko9cUTJso+wXveI4SsZQ9NhCzlEyCZpcUuK521qb4yp8Z+Pant17SqZ4bVMvllFyDwnSWPwNDklmtzbesPRNiSUfmpUWRnh0bPooJXOYUViDaxpakjZBPhp5HCnJzFtglJLFZR8GAFRZsvxZlbbtUjaUrLAc9VNIScnHpjuhEtSMhzaU1vSSNXYU/KKVUsojTTsBXNQw+9qYRlr46mmbFoMRFGunm/abm0LJz1zRdHBDgEBbpY14J1uaxoFf2Nb1dBQ0I4cGQnsGTSc3IzJN700JWBz7aTrTfSAQ2dF07m2HroKmC32HlTRd22+Y1J53nwT8Q/5pAJ91MBPjquNoptcKWFfQbHAZ3dtx4ddGPcP1AqwI08OfG+glUjM/C8lGuQLQn411p2xbVplNGpXe8VnSIEq3lBhSMKcpZSnYc5rN7eqijRpEAuDO7LoA/nb8Z9NNoBzDt4GBZtnlpnHUYWFHM9wk8Av1RDPcIa6zIyV96GBS/c4VzfsBYmUFj9lofu8ll0l1ngYYBo1x5gt9b6w4X/knZw6nnb+arH66XHPNP6ydPFHabWFRa6pUMaVgYUXPjlJ/cfEs5d8aifmLxYnSGKt1BECxonYpHVrs0X4+tRchRyluIBr8TZNrSu8g21gsEa0T7rXPLRtiJaXjdqfyq+7E3dJat81VlLZpb/gHbYtSu640lu4otS79ZgHfUTrHcUUze8qe0hSdHkA8hD+HIfN6ihC1A+jbVwgoVKsOUlJqNxyEd9OO/enHq/Bc6D59aPXDubV/skuszaVZJ7Bx3+o+RFqaoc9R1l5GraS3vvGDjLS6uaashzNw7zxRFge68CwFZUPI3bW2uwCcal8KGqRlY91jN9yCMlwXUT1Q+FuIZKNJS0lZghPxXWF/8wUnm2IHLK4pu29+n9lRNm92IMFZZt4CInDUlwYMxvijRi/9dq0fPK8aJxQZlK3xPueXFlOLDmZR9PrqItNPSgWfDUsLTwFgMASu/VR1dU9ZPPgtVQvtBT7ZSMEvfLiK1tJgubExH5nIaXk96WwQCP+zaLJ3dQVCRdGyj3sa7jPNN61l3KRaRzrcjvK/mIf5iZaj/7XyCPLlH2g3u//v7nIK/ZiaPDSWC9zzoAElirlqNWi1IB47X3ighKMV5+r62xl9HNv3AWBaCnqwdohCyunBmjryeKR15wqIN59FaJ0Emty9keR6FShi9nxgxwcppKT/DgCyuE6sqRQAAA==",
+	"be": "H4sIAAAAAAAA/5RY23La2NK+Xv0UXE6qfj8EJwsMAoKEHfM0gBKPq7CD7fm3UpPzac/kJjWyDXFxEH6F7leYJ9n19VqSwY734QrU6u7Vx697qVg2fMFrGcpIBpzI+wJ/4RtOZSJDnnGKf1SsGD7jlKe8BhMVq3i+/Hvwu0x4yiknnMpYJgU+w3u+lCEvJAbhO68kBlFGMqbiruEzec7XKhHjVPyjogeFKXj4WiJOChIX+JwTGfClRDzFoXXl4WuJeSknMKsJypIvnbYJFX3oH/AKpltK20nxmpfQ0rUc18pxJDGsp2IArhXPZCBjXqi+of7OCvyJE17xGmwhhN/DbDBCfW+Dwgkv7aEHoA4kgmlUfAblSyjl6V1kklzoCpHpg+kGFkCI5zzlHxChUtHwOTJkPdLI/AY+OeJEI3nlvCiVTBYzThAzGVKpojSNAEyYyqkcU6kK6gxxRDxlQqVdw+cSyQDmQdsOf4UCXlPJA3PCSxzm/C7VlCYvZCCnPIeZdadAIvjJNxJTaQ9cCHQMjqbhTwj7jooOZCSnasEKrL6yyoBXEj0eplILpyCyKc94TqW2s01ivoIAlZ4afq3qx5t1+E5i9X2QJ2JMpa4q4wTGutSVAqsQcV09akUIwcgVb+lARWQkQ74CBak4dAFWSyIZygmV+lnQY76hclHtBDdSReWyfV5oqvUcmRR+4dfWNCTwyX07yhXIrPGOE8ebyjG4OHlC5V3Df8gpp04EsdbMnsnz+4UOJz/KqQz5VssWRi5glrd5xE4hC5gru6WcULlm+BtfIR9ylFdIua6CMtrh6d+D3/mIr9DYMqByA92x4UeBX0sEI6jcNPynjOEwlX0oSFxXRpxSuWXgooZ9TuW2fb+UiFd8iTxRuQuaohn8/Gh9pHIPgnArofI+WBL83+EL9BHqgsoHYHkJ5xA8CD17gIyQWwLyqHwI9phv4U8fRp/KCzWhUjW2PXmVw1Jlz/D/8w+tyEiOJKZKA5T8vW+fVpq/WG2utB/Q/lOuKoohSxwkA6qWDX/nhZakAjdVq4a/a2zW7uCqZ/gfQAC+5ZmMqFoz/IoTecFr4LpMcNQnJQBWEqp2oQIYMELnQ0Vg+Ahlk3tTDcHzXGJe861SduuGv8I3jZ42H6h7ljqFwbTbMEAcXvKClzz7d1i56xt+i/qESQoEN2Cj3baqUMMGjwp3cSqeUjlSO7wiMoaKWHNKXsnwhUyyoPKl1vMo986rGP4NR+Sd6yHj6HJnhre7eULEN3dZ+w1NoyFIyPOyQkllKDF5NTxb+PDqUBnjcIQEFS8D8pp3R+cohgN9K5l1gdfCs50KM7zv6DPMVcSNkCzynub1YfUjGBlG5MZmKrru6CxmoVOpII5Kx3D1euCKAJ3kHWwZscPnEqOzJCLvEK8SF4daI/N7YXHm/1ByZ/zRAhps4/kTqrUytqmOqUSGVOsa/idMd5YgmzW1LJFY+6zWQxOjmq4dLtUrho+gZat06lVU8cZwAK0Jzhs9Cxh8QnX/ASS8RSFQveWU2iMwlDbKJks/YuXIa/2dFLBvOUGeo04k5lWBE/zR+Myo/tSalvCC6t3sf0p113hbJoegjfJFZK8KEPmhx6LG9nzDfykwzWET7bVhNwI4zQt8rwOe2xwjGlUAZrZPNTAPgC98LWOUthuCjVqG1mgj3V2ogRGA7Q/Ax4kmpJGj+vrxHm203KaA6aGIL0Pde97xTDtoSI2O4Q/YVznltfya1exr1/4TanQzBgDZOV89YFDEj9zgGlEDkK6RWbmxrKPpnmV9y3QDTMydbxYNv+EEk5uaJcNvsI3YN+UNT97IS3S6TKhZVyZ5gZLgVI71F2tUs2H4GyK2oxrRFAk1u8rOl5grqBtqBjhxhv0MNlAzVA4Z4Vxq9vD0khe6wwMWIiSMmvuQSsBlzTh0tuqTXzT8FvHjtZ7ql/UZobXPFfu8xD1Az/Grhv9UCfBcZ1VN/q7zegcCbtNLyfesAtQaVknbFwPya+5grC68/Hnk/YYy8YJner4tR7/pbJKYfIwFeydJUeOFXxRzcBtInpDfyrzRq4CVbmc6E05+Djv+/TqDgwVnLgAzfayI/aeZV0cbY93vWur7DXxQUwJnnq6RmAqJjMgPM/dOwEt+b0MaGMFz8vdzHgxWe/aBo6ktMfnP8DxDQWR2HN5xzB0I+n1Lu8lmCS+oVTT8zq0hdrq0yqCsNxqKl1s5aVWNrtvXqFZq7T7AzHdaKM811wtqeRvsiC616pai1YFaweJIrab56RbfasMecKKT7W2m1QFthjnHS2p18ZRgbEhErZ7qkUhOqdXf8uUVz7YmQBvXSZQPp9QpaiFoN6w4oU4VzzA5os7jE/+Du5zcbSkdD4KJ7tnJzubxmwO3U4NKjB0smaifMXUaVhLllkFPpwkaCvpEjuVXGObnzfcBi/dMBoqdujGpg1hzOoBHxEAG27tyJ4BCpGYmQ1e6eYV/vjfAYuqEyg4tEvF1Pnk6B5maRCLqHDouZBPu8pye2guQ2226VaM77UtO5UTOOaVuG5SIVzJ2hdUN1DPckO1zz/BH7Tp9OrD8WhecUFAEd4LbJPr3LiVnrkUhFJSUSbOOO/4jsB84GJ/LsW5QJ481fVAx/MkeySkFVXczcltT4OEtUoxA3aIgKag96I9PfCUTxJjnBV3oMT/GFNRhBCy9ysdxsIcTsGUf2elgP2xowv9y+AsCBY07YbddUIALuZyoWIJ5M0ME5JQCLaHkDr/HKHsKsrGMPkOmKWgb93lEq5yCLjRGmQSvKAgy/HRjGMi+EaEwP+kzUiCnavoHqwGZRfVTsO/SJCcb95ngmQunjLYHTXCoLxycBH0IX2XXfHVgSmHR8GflcK20g6Rh3Yi0BCcUlh/cU/8QXH8Xbht5ne1RMqSwoqOQpxR6hj/zGhGisIb/4HKwQuGepeilZ6uTw4Z9s3CfDSIKmzBxbHclzY8coyMp9PEigin5p65cTUvfWRqFbSh113cKu07O1WMYuhiAG+7zVB2DGfgIpTL74MGahPBHFB5kLoGSygmFfUtJ9asAzp1Qr2j4i72guY9TPQ+U67w9ewGumkuJ5Ne7z4ff4AQW+cLm5ziJqXdo+AuwNoePXh/6blD1W2HcL6KfVAkaHpTNHezCARoaaKTObl7mbhzS7lezawNAMELPc0L73oO9Hs4W+ELXjOttrNwGhv36T78w/vfyLcMXFs5HrrX29W6DHOC7ykgiOthFTDAMYleiXyVSYEb7HgRZs+Jb5rOG+1SCAzihwyo+BWg10aFdO+QcjlK/eK+F/8dPSH0fmb67nvYPDL/CPYcvOeFLvpJT+tcABXiN6ocWAAA=",
+	"bg": "H4sIAAAAAAAA/4xYWXIbR9J+rjwFHq2I34fAxgYINAChG6SI02Cx9WsUE6Yk22Fby2ixNPbTuEigycbWvMJXV5iTTHzZ1U2AFCfmhcHKzszK5culUK0bZG7qZm6CDJcVvHLPcY0dEjdFgh1SJFJtGLzADktkbgIr1abBP3CBBEuk5CVfBZYfceGmWPOYYIvUTagcl5Q6MnjhvsMClvxKt9hJNVDtboYUCzeHrVD6paq7cHMsKdtWHv2eYkNKl5QNLry2c6mG1D/BNrfHnUu176WQ5TLDnOOKHHrfjtSIXFskboIUa+pTF2wFH2GxRUammEyXPlKqfLRPsdjkV57qFW5Ow6T6hIHaUCWWRVz2wo1UqmOqudbb6csKS1xRQGpVg5dMDnY+Jl/I5Z4iwYLJ8vbXaqaIFqzmaCq1htLUd16/ROKeSa1JaoINFmps7cjgpZu7CQ2jrgo+w7opMqkF/PRcWa33uNaiuHXfuwkSrGhi2ytwc3qIVGrH5NEE8HvX4COD7fPpZnq7IkNqobK6CbZu/lB4aj3ekOtPsKrgJ+LCzd3UTTXoFlup9amJOU4ZFXr22OA1OZF6QNoK3iHVQEyKjOSsQ70BFtdIfRZrkfdU859KLSbP3AO2dsqvmZu5KYHNwEntrAitN09q44KS4lrqVTWIvAroep3nDGumK0/mHdcr39w54zVSrGgh0/pI6o1cBXOcVb7Rzzv3TBNoH0n9yOCfjH2Jzx2R7L67B3NN541ClsrXNC841F0EyNISbB5JvWXwJy6JA/e0REi9rWJuVsHy35Pf8Csu9c6J1DsHfUbdcXOspd41+IM6kUo9pDhDTpzPsZN6j5RUA7+Sep+nDBs3xxYX+ZVDpTFSxO+HwoGRwWtfhfUTslhcIKvglQJgiUTqp2T5gZ4xYsik/uROM/yADFeKGaq/JMsZdaW4cc/dROpjGp+479WURtPgR1W/LZtS49jgJ1wpDImfVBodUsrvIU8ZUUaK2t7o36P9t1w1tINseImbSLNu8DPWCkz2gok0mwY/a3wyf2kzIM8CKW6QuJk0Wwa/wOJGZbQPfMzRz+BIc0gF7AEMTOLOpRkZ/OqmKuBVxuRhS81wo5SjtsFn9Wq/2o6OcyqbXCpHHZ5YuGttCw92yaPQ4C3DoxROnOv8kj4VsOT+Tl8fEB4afKYn2LmnKhZUmSlFBHYS1O6Ps4zhquh9Fhs/wzIJGgY/MghlIQdMuqI1tyg4yi9LypThR7rg+0QQkP25m2DHfiNBK7eEPSRo838ihbZuCGg3kaB7e+V+JIOQ3LYshKBn/E0c3ucSDPIzU5poydzwjsclPOih9gK20d2toV586A0tQhZ7ddrDCXBO1GBErjnbpASnBwZ8i5cc8rBuLsEZP1l3rjFodQy+aBrX+nfxf5y0L/Chws+VvYJv9QrOpY4p66bSGhp8YfV5Y+h7KyafVblUWiOD36m37EvthsGv2vH3wdNuEsWH06DdJec17+KoknZ4pyW8ZQqk3SsUqhDnj1412y/VmfaCtIizO69wwfJiWOVgJZVjIKGktB/nJlmspT0s/t9J2xfcgakxabNy9Thusm1cMTRuilSOQ4O/NFMrtg457hv8pilflmV7PCDPTdkZOk22t2J/6ugUSN2E+wKuy42t0yr6NAuIpWyl0y54UzZdTURH+zkb2YO12enlG8KKc8qn3k1123mHhCxuKp1BznSpAfUYyXwv6gwN/oWre2Tt7nM/ombS0cbNWDAiu2II3bFmnDNds/uV7narBm84INxUujX+zzWDaenWD6x/w71E49htK5v7Xqtl5/6mWdhJt2PwJ2NUUY07TUx3qMxcqD1euxEpXMG1D0k35jn1i3R3ZPDG/YC1ruhbnSwTLKR7Qi6rXGrEWaGXp7DKFsqKINwyCes8M/E2Pzfy80arhPeETYM/bkO+8Nt/eFQudW/LpW4nYUB5dhurlcd0WzeRsOXv5WqCzdcDH3aUSetgWaIx7ObUDVIJ/QRg7ra8tvKNNhju+/aRhL3CG67GeUGE/UKpRfZgjwnvgCv1buV3fR214ePCp9ne3A6HSsXlbSvI7Yi8bboxEsLWzSSMvXPklHC0L/uUm56EJ4X77Bd672lB0UVMwic8J4oE2pBJeHbLsfJ9LhyTlun6xpJNsZZe1eCdngt89Oqk0D8ND1Xs56LX5OcUC4ZJekd32uI7gsN9p7FfSy/YY1Ybeu2csvavRD7krPS65oGtvNfnFwKOBZw/V3oD0hLdOjbSG/JkiX03l96Ip9TNkUhvvOfJL0gOFPdDDnvtATKoGrz3S/kWVgZNnnX3lMH9Of7ePzFuJ8ggoIDVhcx+e3vp/iAdtLii6ILLzYiYkEEnl1vvPYMHXdK43D6jKWFZZO/5BncT7Ym6A6lHGUWGBu/psgIxK7bfTAYRdWkKVX9aAvnuUKIxMZmZvpmbY1HOk8FpoYQjfHCmJ4IXC3qJlTzOHzV+URk2DT4gcT/QRdawDPsGH7iru+ceRcPI4CMnlAfdcKQcvmsOT/Xks2UlqjIIlq9DWlzkgU946xVENbIwLzpmHmrsUdGo3TONXipRg5ZQM5EQNf1zxm87UUB+ppDxuCHIJGqRRp4ZrfjZb6xWojY/0ILLcnRGx6QxTPoTBYeuJvAv3zaxIqtEnVvRHA7nEumjWXPOe94g0SJMJFJM2LLxKpgyifwIJSxp7UaiPikMSJHLaKjeehmLrURRPjYVYnuBiClquft+Ug2Jmv0+l2SeCGKJTnK2zd47I3riY+Zmh5MhOtMPvhNE4yIy+XubEVtKXDX45Ca39VBhZvJH4s6dS3z4OxVt+qQ4Wvtt4bXfczI3lbjBwWWxlDgw6scCmcQt/k8u3w8kPs4p3GDSg2KMO/yS+Voj/mO/GHKZ+39GmwFKiTk3kTik9dxQ1+XPT6Wqnn7LaRL3c8UKLImHXs7DLo59FMjNAHjUUII/DqkbJ+ThYsPZMZf4tHCLlJ3E4/zM90UxgUZVbsT5Y2ZFAMgoIGVRltooL8w7P+lxb2H7kNGZwe/si2Xtj8ZUcM2l5SByJ1WDV7kUq5WUwyXpFfW7KVsCj2nl4EFFSKdy0qQSIiNxT9ng2MLlJLi/ZqeqkOWFq702d6f8T9pf/4nvf5Tu0RoW5MwXz8mIFK76c7rq5nJ6xHCwD6UekJ/dnF8oIaeR2fst8UnH/2Sh1mVy1uRizh/FWC1nfi9YIXMzGVf3ttsXtz/eyDjM3+zF8298ynOqZ4sLXCKR/wwAMdEVm90VAAA=",
+	"bs": "H4sIAAAAAAAA/2xXTZajuNIdR6yCYffgWwRgjG0QphB2VnoWTtQuGZB8BOKcLzfwJl2Dt4OqjeQova93ApzV2X16lEpxpfi7cRUOY9gPo5tsEA4vygzaGgxXEJrGOsIwgcNVNdpcldFB6Og2tDpIeu1o1BiuIfzjQkYPIxkMUwjNqC8TBTqIyJ19QxhuITSXSXeEYQ5hdyajr4ShgND1alnvGWIZUUHoLsqM2hCGEsJeOf3+vaVAUm8JwxpCP4xuPnVY1tTN/z1B6PyZMPwK+45MM7QUWI6LMDxB+KrcWV2b+xsZjEKI7GDYy41yL+piJ7YXRcBOU2MHjFYQkbl01Kj7T4wSiFR3YTvRGiLvWm0oWNNgMUoh8hdyQ0sYbSCib05pg9GWYd40GqMdRMrwXg5yUuOcmtF2tldXjAREyvWcqKiAyHnDm3uIbKen2d4XyMjpG4ezsRzYvFtB5OhVdxhJtkm9xqiGyHMZoieI7MtEHNIzRFfVWeeH+diJ49CvCuMQMjLUEMYxZLa1g50o+C1TqtPmYif6/SN58QpWqreto5F9qNTNnzvdUpBZc7EYryFWhqtgLP3xKNYvEMYpPHAbuP+Y9MuSqXgL+zN1FMjOGjvR+3eFcQaZb+30q2xxDu9/6k5hLCCjXjlvMC4g41rFe8hs5/szhxVXkNlhJDdbPEDGPIiPkNFNBUflGoXxE2Te0UAW468Q2fubfv+PsUuQFuNnyPSNHMYneP9T3X+2hKsEiqvqiSPC1Q5W9zd99qPGVQYrMhzGSsDK9tqw3dX+19r8LVG4OkHY3d+0wySGpJ2osQ6TBJJhtDP/kxSSi77RiMkGTnSjxjDhv5EjTCpInB6dYpyE+4/b0j9JDcmo7Y3X6y2sNRvF9Q7Wurm/aVxnsLZd+49OWAsQunXWqNf54B7W5D5/r2DtyLx4viwNIaWzNZhGcFQcShA5PS720xWkTs0MShNInZ8vTNd/nQ/Sia5MwjSFVDnzqjHdQDrvbCHVZ0fdSA7TfL6JPcVUQEpLUdMC0kkbjjstIZ2ooc7fFKZf5hyOlkWgMxT8QlWQurlYac34UfXUEaYHSD31mD593Pd/kWYepM/w4eEmg401l5nSwW8yrAIm2e+4KXi/YeLgpoKNm+YmwE0NG9Kjxs0BBL3/d84hblewNc1HbrcJbJftHLavjlSHW/GhtIIMbguGz9A9LInlStTK6SW4wAczYGhtH+xbRcbj9gtsHbW4rfivwa2E7TCnblvDdly0cJfATrlB/T/uBOyop2tLuNvDzrqGDO5K2NGNDGYJZIsCZylk2l0WGc823G5ny2KJ2Za/6DOrfSYgs711GrPiIWWZHodAB4Wa9IBZCfKqJuUMq8NM2ayCnb+/fdrgRpzoOmL2DBldezKf+JedIKNX+jb7kYeQkx0wjyDXZ96IZ6sU5P6Fvc63kOtvozL3nyNdDeYZ3H84HeRkWsK84mOKM4m5hFwNdrSY15DrcVpInB8g9+2g+rN3F8yPkNM4q27+zGd5JUIQ5GxrUcQgrCFerUDYrqEZKhKIOeCUX0uxfuRFkBu1QZGCoIYuNLTkUGz4rvtP6j6pnMhAUKuaRQpEDoI6jUKAuJLp+VTBdi92Lq3YM5rsZ5aKT2kXxPF+Tqn4wkZHFiYUFQjyv3pYSL55UI5GFDUbHgkFU9o7/aKvfkBx5O1GTxrFEy+JV19BqHbQnIpn3lwYL04g7Cu3b4tFCAX1SwqLGAoOOKPuI8wigUJflMNi/dEShXV/2K7FIl0+zbAtFLolR5eJsMjhrwew2ENh3bQodVFCoW7UYVFBQd55LA5QaK+wOLFpHZwUH8S9gH1PBssQSjLUE5YJlMp5LD8LV2k7/ejiMoWSbp6COYIPqSk3sNadvmmjscygpHbpnDKH0nZX7vpSPJhQqpFcoAOhW9VZg2UFpXWjDSrOXymhpE4No/5H82NZzzh/oQ7LJ0aRx/IZyiUdV/zCTzgLaJVApbzhua3aQ+V7P1e3kiDdXIDqANUyAFRPUHkyDaEMQZKf1YV4rJuBMgJpO9vb2Zm5MIQyBqmu95+q0yhXID1riEz4OVc8ZaFMQWpzoZt3KDePFt2oThlCuQXZ2WlRGbkDOVF3JtcEOtiRCQRdlUGZzaDlpZU5SH1VjoJcWaNQCpAz0mljURYglVGcFLkHaXua20JWIL3ThnqUclEcHTw8rUGSDWrbq0AHpdPmRd8UyiNI6pa3WH7lAEa2MbI7zyD1XAN5YodfdadMg3UItZvrHDQUxN58I6wfszMFtXftEOggI93aAesVvP9JDdYp1PZisd5ATdeZhPUOauI3+kGaOoPatqojj3UO22G079+NDmrdW4e1AL6ZB+UFXEDtjR6w3kNtzYWwrhjBdahrdtDohji9tT0TGz5C7SfqPNZP7MLEl5ygJvO6aOAhhEPr6MpT1SGFw2Wmx0Gypixjvwo+xnAVrNz9jSaFh2c4OD/z8HCCw+tZPaI5hnCkkYcgPMaPFjhqMygzBjrgh54abfCYwFEZ9eJVR3hMP71/q6ua9Pv3v2nYcfvpl8C/Ago46qsamQDHAxzJeBo9Pj3UhYIjdZrLs/ajN4RPEpYfFF8zHh3tZPGZH81eGXyuQdDVjgpP4UKlfxtsTwJOj0nl9AQn3Z/pPCn83wAKjG8uTQ0AAA==",
+	"de": "H4sIAAAAAAAA/3RXO5LjyBG1M0+BA6h1BwAkQRKfxrBAcppeksgBKghUcevTvd2WjNUVFCFbE6FYQ55irPV4E51EUQBntdKGLBJV+X14+UGcQmwvrKzUCuMFxKrVxhDGSziwYalk5ziKDZ2lvfQcLUdpyDHGK4i/dD0paR0pjDOIlZOdp8irNkrInH1LGG8gVp2Xw0AYFxAPZ1KSFcYlxGbk+f9zkNFBYgex6Vg5OV8IiEc28hqcXPonQaMmjBu4/8U6Noblpcd4D7G3ztAwqRwhNv5MGH+G+58HUq1UlgeF8Qliy+ZMsrWXnhQmMSTaBj9TwGs2H9zpN6kIkwRC/NRqi8kCElLdQC3bS4/JEhIeuuAqWUHizVUqilZkNSYZJH7oyEyXa0ioNyQVJpsg51UrMdlCwiqcFSDcHwNKrr//PPD4jkkJCZsxgJZUkBivWEYLMt5aGmjE5BkSPcjXyfonSLQiafgPkZDKRUtvHTnp7ZSLoDNhsoPEkJUTLIkI4dBIFpMGkt6HV5YcIdHOegopv4S8gjdMTuGv/GBMY8hJUUuYppDrq7YPMNMF5Fp1+imXyvZkCdMVnFiFt0Bffn1hHO345s+DvGKaPTQSQx8f9CqHgTFdg7j0byw/MN1Aev+n46j915/+unnV0jCmOaRaX7/7LCDtZdAqIaeRjVeYVuFMEabPkOvBj+eQbLqDVFtH0U5eCNM95IEQ6QFSOuvowKZlTI+QekP3v5PG9DMcWfaKLr2bM8T0BU7vNzYK0xM0IZVLH0wvlrBg7+ylD9TCxRYW9tLLs3cSFzks7l8Vj2SuuChhoUepQgSL58f/38OyOEE8dDxRZpnC8uKp1QaXS1haN7lYZnD/qXu/uSCxhiNbZ6knQ7jcwdJIZ5hwKUDc5sJaNnD/yfVS38LTagMrqdRkabWF1UR+iascVjRcf1MeqxJKeTVasZ30nmF1/2ru39jgagcrQ+o6l1sWQ0ZnrzBLftMfbJTfvynZPWQWkBmeiJMtIWNtpoLJVpOhj/s3O5Vz5uk9cC/LIPNslOV3zNaQ9dPhBjJ5NjQ4MpgVkJn7tzmPrISMxrMkzCrIvFRMmNWQeWp50P7GmH2C+08/eHLaSBq6h8gOMiP50vPDTBNUHI80EGb78DBidnxYfEqkteQxe4HMT1GucxBatWxe2bzR4LzqrOFOahWttequWnW4rmCtVesNWVzvIDeaXMh83cCapJO43sNedWQUbhawUe13vDdL2JgprE0BG2uIB9yUsLEDR/pLVJLCTRUUJuFnSIx0AUO2UcPGyJCpHyM5RkEmXKjo+YOD2ifYGLriZhd+FW5EsDp5amDj5q65XcKWTcB/W8KWRpJXwu0zbLVpZ1pta9jSjRTmS8hZScI8g1yaTtq5/+frUJVnHQhGmG/CpTyTk5iXkOtRG1aYV1Pby6Vzc6eq+FVazGuotGmvOnA534G4//L94Qi5fyPpMH+BnORI6kHY/AQ5Wbr0k/cihoK0xSKBQp5JaYVFOvkq/EUSFhsowrt3rKxjqbDIQRgZFaSuhMUuaLEJggIKttr1GosGCunIs8JiD4X/kcezNx0WByjYzdVZvATNd1ZYxlCS0derxjKFUiu6aCwX8L3Uo1IPLXksl+HSseLOaCxXU5AlGScVlhmU1FJH9koGy3WwaHsahkfOZQ4lfXCrp1dSFlDSILEsoXwnNQaVKhjv9MASy+f/y9eSLiGSGqr7N9MOMoz1kowkFcx+Cm7DBP7BM5Y7KMkbdjMNShEcOMvGkMOyCRE4wnI/SckwgbA8hFNu5WuwdgwP9Cax/Awl/ygDPi/T2buVhOUJSm1DOV+xiqGiUYbKrlKo2F+DmTnZagmV7NhgtQpc+aKHeS5glc0XQWkDlbyQoc4TVgVUklueqoqxeg5qb9yxwqqGim80YLWDirzxWO2hkp6xOgW3ljko4XMJzyMprGOoSdFIWC+hZuOx/p9OVuvh/VHJdQY13Tw9VewfnadeQ93LQd5uMgBc51DTda6auoBaD+GwnIhQy7DWTKVRyh88D1phvYPas3E6zDONtYCahvtX64K1yT9HsXda6VFyx2fJjrFuoNbG+Y4GrI9BgzzWL1DP8LzjpzDbQ2/dLWF3/9mrsP7tnmHnx/vXCfKdAMFmmqi7Pey8ndvG7gg7T6olFDEI8q18mpZDVigSEDToUYc0RQqC3y89DyE/sQDhW1IolvPMb8NhBkKqjm7eoFhPAKx5YEUoNiAG/Tbvh2IL4ibdx5nDbjiBsyUVlRTqTuSTJF1ZoihABAApKlgrRlGCmASNVBpFBSKUHQ0oQm2MNEhCsQPhjVQ0MgoxtR47B9qAuP9NR40e7z9PTmtz/4e6yBujOMByiAQNr9O4Fp9DHi4qiUyY0+IFxPs008UJxFtYwgJyTQyNmd571FKUetUTNik03lzt0+QhJXn5dclqFtPmQS02GTS609isoenpYWwLDYVmKx9UanJo9JUH8tgU0MhRm6eCrWNsyslH2LZnyQoa/6Br8wyNVh1hs4Pm/osJMDZNiFPJltopqkafKXg/QONfafDYHKEh+RZMnaAhZSkMhH0M+2vYeBn3Gey7iSN78duvCOGIAkD7F9gbP/Fwf4K9PfMjiUMMB3Jhf7SOWoeHuYkfpLqwclM0reRo3i4C//EQPlMUf3geCA/ZfyZjtPWq+8Lm+8A4bP7rY+L39xUcJDtFIx72cCDlyXk8ruAYGvA8rlbeeUV4FDB/inzOIddWv2p8CRN0ZIUvoSe+a+cYT/FEp3khxlMg47S2nI4g5Him8xvjvwcANW7WFn4NAAA=",
+	"el": "H4sIAAAAAAAA/4RY3XIaVxK+nn4KLpOLPAT/IBiEGZAsPY0QYJe9KFV27GzFcSpO2evUVoGokTQiA0zmDb5+pa2v58wAq+zmSpqmT5/+/br7lKsefsGtTpDqdQlvdIIIiU4Q6xyJlGse3iDBg851jFDKdQ//RKKvsMMSCUIeGSPEHWJssCjhPXaIdYyFTsnf8PBGX+AeIRLEOtEpFpTbNLk6xQr32CDVGWVtECIu4aNeIdQxdnqFVG+QZLLadqbgX2FLapfULe7shhUpPu8cY0dLMsopee6x0Xl2ZpBx3JNDp4iR4FbKAbl2iHRsxlAe/96W8Bkhdjrn0aGHNzqjITrOhI8OKSG2GfWc1LGpTzNCKT8vPE0bTRPeLuVL3vto9xqrvtYpHs1PlbKHt0h1kllS+q6E9zrGIyLcI8UdVkiwlkrFO3LakibplDGVSs395lznrsWSv9Upfokt7hEjlUoj4011pmNsTHpYwlcsmCBSaZKdseKB0Dmg0sovf6nj7GqptA8F5TGMpXKS0bPIJFLpemB2xFS1hLdmwR9IsUWKHUKs+INUfF5sDjKPPiAitWfC7IIZEl4tlVNy8nxs3gml8szDr9hm7sYDaSV8sItCfe0S95biBh7ekopHxC6MlaCwDQtmFPmGe9uybK6c55Qp/aQzUnn6wsPPiHRmGTvWVzrJpF7mXuAtj1Ite9SIZ/CAhV5LdV+WTJYPOjcR16Vv8MESnyl7j823Uq3xbJp96pwZ8oEh0IkFLZRqgwyW6PQUQn3xNMHfYY0dUmxov1VlKNXmE8mZt0M8WsWvsJVqy8N7K78oP2ZlutEp5fKnSF+4lEtZNgygVDvH9pnTNlLtevjdnH8rVZ/Xh64gGfVEqj3SLCulesr/80zJYl0dePigc7smLOE3rGimVEfGqjdECameefhkCDWho5CWTF+GZ4pb800q1fPsCNM3pC+RSnVfwXpd0qm+QlLC7zzhDJvh3mCRAJlI9YLa3LgMvZbqpYcvBFh9adrW6h5+cFmdg1ftxMMXS8BdkWIrqXU8vCsAruZ7+IX3YWcVSpAKpXZKHvoizmlI/ldsa4Y5rOLIVXG96uEnnVgekMYUmuu11Ose3uvEijJTsW7YvcK9zvTKgUy95eGdKbtHTH2JBb0n9QFFjHWGP/idCQnsOodLRiGyIsYf9L9eGVej7eGrBemgeqVxQurKQDKWxnEqfdX5HuSk4Xv4yCRnCAiVrgIbp8eHGAWdW3k3BowKcZzFGUqzzG96c6dX1hSbleMmmGbARclbRFRfmjUPP+iY/QULPCCUpgv2K+s7Jrixv8h57QfLodjBR7NJhg2WOkZitjZbGcX93uZXjDvz6patVcfS7GZXp4iPvNb087PMrJiUHinZjUTPUJr9nBLiweAp1Ru9wlqaz56kx6HC7jgDbY5zNg8LcTpFhB0WVEmaI9I3Dil30jz3jiVlI0Cc1Z3OpHmxZ8hsb3WIFA6cSnucIuS8w6dSDhN6La0eGwCTh8YwIVsGE3RRXg8tJp/13VtpjTz8aqW873HtmoefzJWHWdSu0ynjIze3u6RNLCS32ErbLzLNQIP2lPCRJ6Tdy6WaNLYu5gzBy5WwDVRsCS+wLjle5kqqNyX8GxtExkpIbVuExlhgI+1B/n8i7azSjpUckjYtppWTeoY6zDTm2YmffRN9F6ZIKCenVDbVMR6Kmj3pkxbqFfHOKJ06cXOJhAOddKyFcBy8Pxj+Oi2H7Kwnq+K1dNo5Z2wTDMfHWDrWA1LsbPxkdXZ6Hj6zrBjhWKd6XUyNv2DJbk6mPj1pRxBn7Z56M0U7A2LnXKdPfsgBH3ccJHQqnYsjjKBVcYbU0rkkc9YHN4Vd3TLb/YKBlm6F/6/cWGqUKvHNSr+En+0mDlihdNtkjfWl1UjixHGK6nY8fCYW8wDzfoNQugNjt46bJWc3ICXSiXkzle6Q30RSXpLHqjvy3LV/WineuXK4RyrdM/7GDp6DfPfC3aI3WItfJoyGhpEbpOJX+U1e2j8XnzOmTW0PbjIIxa+TFtokyUnVOriBo984GvrINLY6TNyIc4CKOmfN7HBLz38rftOEWgqyOiesER1TxdZRtD6SzPkHW/E71vD1e6yZN4RMuiHbI7LCuPs/gxAVWDtFreIeci/ptfhdKkRYi8V3zYYe3/H+0jd4i/iwv38rPodWpIYuqSs//9RzwhdI/wLB/P6RbfvUjgr/OWBkifjP8mAxyRM3A/mDfThihzB2d+BCaYOJ7W3iD51R5BN/tD9JWfTetfhnRrWQM83t5nO72fCfHo7Ff05KhD/pX52Lf5FzhAQEukR8TsNIHdwweTZYS69MmwlAq6Jh9WwqXroBHlusHSBTTK/OH7lOri1219JrFG6jn1j2Y31habqRXpM/xo7dBLQzyob5iAXjw/KRXvfJAiE9mx+IHXdYu37esyhFBC9spUegQbjfgKQ3IoXeu0EkvcvClh+xPhJ+6vNCIm8i/bKHT2412Nlq0K+T4mZi6T8dIj7RQp0d9Kl+k0dCt02Hpfzio54r/RbHKg4NsV7ZAJbYQNRn+Vh27iG83/XcPTnw9/0CmD9ZZo7367xpFmFr01N/YEd1xrmGiJPP6an0A/5UZIfL3xjhf3VC6Q/JmGbncV/0sf55LoAoPJP+hX0zH1w4F1jLs2zdcvPSoO7hN0T6mleZhoNTUuia/Vw+CMw8C7h9jzz8Vix0g/P8xMI9VgRl8lv4Xcu+3T+VUEBQOarpz/kmo98Xm3tQpYxI/6EvsbQIMxxBjdRMLkMR1PPvtcufoEkKU3uTB90gMmgddCBuZhxe1xK0yc416q54MQlOSLtjJlv1sSISnRYhJYaGBFMWM5F8SU06e0EMmenSzXThZBGyjUV0MSIJ/KdNIG8BEuRd3t45qMRaglPSuN/k0Q4GznI3j3KUDIJ9f09Lx44akt0Q9gs9jWVhDtfBlXv2uqJyZzbI8nzo3pbsBUyC53/Tug6B4mn7Ci7Y0GcOcILLPHL5kwN7/APWMixzU8z3ygUdzaRwA0vWR0MZHj8SfGHRYXMwEX1wwxvxelijyInbi4ZNfs0tRVIZtjjuhfr6UAVbRDkfU7XD2h92+EualbQrtGGXNI5ucx1/h5+xNKiIZGiTZP6elD3JHYjqUY1DqBqekjJ3b1WhDAeH541j6HxjXqBbFkepSdWYsovcuDMnwbLSNhoZnucGM8UTGXIzp2usCWUVPypna4Ctza4sRs18NdgUlT4KjrdB68mGjZwiEWFlKDq6sKPusWoPRqNLo+PR6iw68vSZvf6Fbqtm5OZyVj3KwLcWnKV7xkwPgp+vn9zkcig/s/c+UiM8mgrElVDOmk9XjyVlfXIPcoVhRb7JWfuvn0v/9lyPOrBDTF3Nno1I4XlqxMmf2H3eyBzD6o8P7PrqXkXYRrkJngfewfvs8w6xnQ9NE52x2dtr6ANHPrmoe/iXzb40Zy0XQy/HL07Oclk+2g3eFA9lt3Lpe/jxYHW+POe3W1Ww0yukOkMk/xkAxyuIpk8XAAA=",
+	"en": "H4sIAAAAAAAA/2RWO5LjONK2M09Bq2PGmLkDSVGUxIfYBCV1yUuJaApdIKABgapRWb/zH2LtNTbGWG9vUDfZk2wkpa7ujXWqIDCfH758xCnE01maSVkTrSdNpsd4AbHprXOEcQY7o7zso9jRKcpG5cjLCeMlxF+HCxk1eTIY5xAbr4ZA0acoIXcKPWG8htgMQWlNGJcQ6xMZRRhXELtRzsctS1j+3kLsBmm8MoSxgHiUTp3JRIJGSxh3EIfJO9bZ3Y+k+ccBYhdOhPEXeP9/Dv6Rw4TxEeI36U6kvpHBJIbETkZxfCvp3uRgX9hVkgCHS72dMFlAQmbQ1MvpgkkGidSDCiMmS0iCe1aGoiVNFpMckqAH4nCSFSR0caQMJmsWC6ZXmGwgkYbvShD+d4bEX97/0nK8YVJBIt3ICCU1JC4YqTDZQmK1emGLnyElp04nSSaqpb9Ix4lNmLSQOHpTGhPBTmmkCZMOkkvgN0gOkFg/vRKn9cTBkwsTJkc+qjeJaQwpGeoJ0xRSe7ZT9EshpVZm+PUDtnQBqTWDjX6LCmWmC02E6RJSaRjyKP56f5ZWXsNJqzOm+Yc8B/dGL0priekKxKvyb/fYMV1D+v4vL6P+3//3t/WLVU5iWkBq7fMPzyWkF8W6FaQ0SmetwbTmS0OYbiG12o4nRZi2kNrJU9SqM2G6g5QpkO4hpauM9tL1EtMDpMHR+z/IYvoF0otTkx9perjD9AnS25UBSo+QvsnzRREuMsilG8nccLGBxTd1ssErXBSwkGYk94yLChZ2VIYdL7Yf558AWRwh1oNkbmQpZOdAvXWYZZBN3jLpsxyy4Xb1mK3gICcvHbP8Qo4wayFzyjtJmAkQV2ZV1kHmL8peFeFyDUtlZkSXG1iqbwqXBSxJP/8X9ZcVVOrsrJETK21hSc7KH59bWDoyZ4l5DDmdrME8+V7mhTJDb0fMF5A7ObMlZ1SsGxRhvoSlk+Z8ifKgmGh5DnmQzkzyhvkK8st8uYZcnRxpTw7zkg3Je9R5BTnNb5jXkAdlJGHeQB6ol9qGq8T8M2R/BPLWKdLRd5F2tsERdyzs5UiaMN/xjxHzw8PWb4maJgqYP0EebhzKqoCVNUNU8B8Rt9GdTauar/vgaMJVC6mz5BXhqoMVKa9wtYNVMAO5G64XsDb9A8t1Bmsn51TWJawnR1LjuoL1pGVkv0YVGVzXrMDCW0ic8mq6RPOFibZnrulOOqe8ZdufYe3oD1y3/N/gWsD6/DDfwdqTvuEmg410jO+mgg2NxNTbbGFjXU8GNw1s6EoGiwwKaW6ERQ7FzQ23t7kzFysuppPtFWGxhkI5dSKvsKggtaN1dsKinntUobyfok9RLV/UhEUDtXX+EhWW6Vi0IGz48fMARXgl5bF4gpRuI30fHhMWRyjojZ4vs/8yhpLshGUCpTyRsQbLdPZXhrMiLNdQKnm+eGkmL5XBsgDhVFSSeSYsWyjVaS6mUkApJ+svFssOSuUvYR4n5Q7K8KccTza4Acs9lOS5jZZPrHojrGKorLPns8Uqhcoa4tMCKqt7+0JYZXzppZGDs1gt5+Aqcl4ZrHKoqKeBpjM5rFZQkZsupPVHtlUBFZ1lP5d2VUJFWmFVQXUj7hjRL0lwI/2KVc1eBqtZbMs6FH4iZPWAm5tBxYPF0A8Xn9mtV0b9ESRWLVQUnPJz9pVgs36SzpHHqmP/nrDaPYRUmLDa822vXuSE1YHP9Kqw+gKV/FMxFk/z3Y2bRXWEyr5xibKvOoaaRsX1WqdQy9coJf3Itc6gVoN0WC859K9Wf2/lWOf3Tyy1hlqdydEQCOsSfp5n9ZYVX+mGdQO1vJLGuoWaggtY76BWHMFxdnuUxDq4rWA7ksEmhoYMjYRNBo10AZuPztRYfbtXa5NDQ9dAEZt4tJJmBc1FaXW9KiMnbApo6Pm+xDQlNHZ201QzCRolnZPRp6hi5LU12LTQBOm85dFjsRHQkJYTv81Pha3YcAeNdT4MpLE5sBgFbJ6guWNxw88xfCZukG0G7ftfwShrsN1Ca8f5ZVsBQjqGvt1BGyZOqD1A+0qmJxQxCAq9mtcyRSgSEFbb8WOJm1CkIOTtfJFaywnFAkTghiEyEK+ylwZFDkKZga7WSRSrOeWV1NIQijUIbV/mJU1sQLyQPpHro0/RhpigN1YvZhl6ZpkSBINFUSmtkSgqELOgU8aiqEFweZFGsQVhx3l3Ey2I4JShUaIQj/byiLED8f53G3V2fP8r+hQ17v2f5qyuEsUeMh0J0i/zWBVfOAUfVUTOc0xPIG7cLcQRxCvvSvycXQwdj38yUU9RGsyFsEuhC+6ZG15Kineh77h1C0gv1GOXQ2cHi90Kugs9LG2go2/qQZiugM4+S00BuxI6NVr3W8kTHbtqts5b7l2yhi4YxW/YbaGzZiDs2llG3rDrOD6jemKEO3sidruHLryQDtgdoCP1ymaO0JF5m/mxi2H3zHunxF0Ou2GmxU58n+PCz3v67gl2LsyE2x1h93aSj9j3MezJz6tLqvwN9/emvFfmLI2PPkX36T8XyT6DvTTyLUhNuM8/ptpeuUH9INx+Dbvfxe//c13DXklvaMT9DvZkAvmAhyUcSGvFD7AMPhjCg4D7tv+lgMJO9sXiUwZPcpQGnzqo6Ga9l3iMH1y576J4rODIDYvweICjGk90epX4nwEAaGFJCNgMAAA=",
+	"es": "H4sIAAAAAAAA/2RXPXbjOBKOq06BcCaYuQNJUdQPQbFJSm4rK5EYGmMIcIOEZ+RoN9hwD7Bhhw4c7OuNOuVN9iT7ipJ7/N4mehQI1O/3fShGCawHQ6JTwpCIhlbZQU/fLEYLiGznvCeMUkjP2tPoBjH93dNJDWJvdecGjJYQ/daT1cM4fbUYZRDZUfeBxEXE5E+hI4zWENk+aEMY5RCZE1lNGEmI/FnNjzve4Ph9BZHvlR21JYxqqOnsSERn5XVLvNRAFIbR8/n99ZEM/7mDyIcTYfR5TmcQ0z8M2Q6jI0Qvyp9IXzi+OILYDVZzeCvlX1TvntlVHANHS5xSvICYbG+om14HjFOIp1fT65YwXkIc/KO2JJY0OIwziIPpicOJV2xhetUW4zVvC7bTGG8gVnZ6sxjnUJPlmozOuPP0irGEWPlz6GjAuIDYBzu9aox3EDujn9nmJ0jI65MSVinP+cwRVRB7GrTBuIaYHujMBhqIw9yC+A5iNw6ByxXfQ6yVcd6Hge0dIVZGtwqTCBKy1E1fMUluFUtc6wZMFlCpp+n7yeiWxEKdXeunryP/6ZQRibO9w2T5cVOi7Ogd/XbrUZJ9fPnh0ArqoF8IkzUk039GJbr//u1f62envcJk+yMK94hJDsmDNgoTCQmdlZ++W0wKXmQHO0i4hidNmFSQuGEkUXGDkj0kjILkAAmdnDgo3ylM7iAJnl7IYfL5B9oLetYddZjcs9knjuEIyYP6EjThIoXIqPMM1MUG7vUpjBoXW1hoS2fyLeFCwsKdtWW/i93HlN+XLeHiOOOZIZomkLaBOucxTSEdRsfG0wzSXj+NDtMV1NPXB/Ikdm2rO2VHMphWkHo9ekWY1pAOTzT9mzBtIB21e5reCJdrWGrL4NCEyw0s9UXj8r2ckgwDfMClBKlb76xiJCx3t/dL5Z3CZQVLT7bVhFkEGZ1YALIYKqWtu1IdswVknjFDmKWQKed73r6ELNCFmBJsQQ2EWQZZUN4O6oLZCrIHhmK2hkyfPJmRPGY5ZN6p96gzCRnN7cwKyIK2ijAr2XBHJjwpzD7dlgWXcHRek8GsgsyrOeaG947qTIYw2/OfM2Z3tzO/xHqYvgbM7iELHCmutlBFKYNg5Wwvtvzz04ytn3FVwMrZLngacFVB4h2xi1UDK9Lj9IarPayC7T3Xfr2Ate1uNV2nsJ5JSrjOYT14UgbX8gfgJFlcF3yAN++gUd5rzsWJ2Gvmrm7dzJddO72SdWL6p+1063D9CdaeHnFdwdozx9f1bPVqqYH1OIvgJoWN8lz1jYQNnYmhudnBxvluRvKmhA09cW+3KWxn6d1msNW+Dzf93q6Ybyd3Idyu+Y0+0ahxKyFxZ8c12RazjiWeT3w7kREXUWj1rAbclpA4rzhZIwrnR4Xb6sNSHTxu72Ab/iA94vb+BsGE9JmT2h5hSy/0+y2UPIKc3IB5DPn0diLrME/Y90giDy2XP19DrlX7MCo7jEpbzLdQey1yso+EeQW5PikW57yGXA1udJg3kOsxzOXI95CHP9X5FHzvMD9Arq6kzO/5pCaUEUjyPihWRpmAnL5Zah3KBUhnOmKRlilIZ0dlVe8dyiWHKCT5kVVfZiCpo56GljzK1S1lSX54IGNQbkFSq7rZrcxBktEoJcgL2TN58VOs/axCP6Ms2E/vuNNy9w5gSS25H+CV5V8ONFkaPrRCfuJcxqtgyQokBa9Hto2yZtPjoLynEWXDYYyEcn/d1GqH8sCLnX6mAeUdP1PQKD+DnF7/ZIzK+3mRxUUeQboXJvSXoLCIoKDzXM4igSKoZxIJmVvORQrF9NYrj8Vyjp2j/c2ZRywyKHQ/t69YQ6Fb8tQHwiKHkqa3QQ0ipt/dgMUOCueD6gmLEgr1RAaLCgoKPmCxh0JzFMeb66O6UnQnYTfDroygJEvn6SuWKZR812C5hNIZPfP6L10rMyjpafpO4mrqplTlCpba6KdZZsstlPR4g3CZs5k5z1LOuChV5524CKm/BGWYiWUFZVB+dHyDOSzrD7owiJKMGkZt3YBlA6XzY+jJYHkHJRlmR3kP5bUwF/zElzrLa5VCpYKdx7hqB1U4k2W+VDXUynMnqj1U80hQ3UEV5oLUEUSeTppETaGb3rCOb2CqiQeWbxbrBGp1aR+UMWrAegF16LiEdQp1mJW4zqDWtqen4LFe3diaGmUJ6zWkg3HPs+zUG6ifyZzId+IiNjNjLspivb1uovkWrnOoNYNS5MqxCflOLm0d1gXUzDoyWO+gdudZBOsK6uD5lsa6vgX4Q33qhg2MTjQ8gYmLKP30Zlv9pLA+QGpETeZ5vqLrz5zIKCSRHzmwe6g1Y7E+Qh3oRd/Ut4mgmaXwq2U6Rm3g+7l5n6ma4FsaxEUkpFlDmgUkD9Rhk0HjeofNChr6YWsDDV30O3qaLTTuURkK2OTQ6LPzv+RqGBU2EprgH3l4vm0toJm+W/WCzQ4aZ3vCpuI9XwL3vWk4SMvjjriIxp2IXR+gCc9kAjZ3HMQfs6EjNGRfZlXYR7Bv/fUxg30/o2TPQ8jIc/L7F8D+HvY+zADcH2H/clLvCRwiSHRgp1z/A/EQaR0eZhUXB90qOypxEVyo62gxU+iQwkFZ9RKUITxkt0oepjf+MFDDX5cl713/3+tOiTT9Vez3v+KhgINWI2PhsIcD2UBjwLsl3JExmtuyDGOwhHe3Lw38vIWtG9yzw/sU7tVZWbxvQNLFjaPCY3QF1Dzp4lHCkUWO8HgHR30+0Sko/N8A7vnUM1ANAAA=",
+	"fa": "H4sIAAAAAAAA/5SYvXbiWBLH41tPQbgbzEPwKTAIaAS47aeZ9hGgQMEGG3TcQcvmuJeV8Zw504Gfoy5k8yR7fnUlGTxngg1m2vej6lb9q+pfJdpdp8/6osU517Kl3/Tot3o85z7zW2n3nH7zWz34TEstpN13WviNFqx03/Ib3esPPfid/3dL/9BSH8+5tAfc+qI/tUCX7vlX2lHQtT/nJ/QVLZ+19NF0PfpMD+gf2R3Oz7lP2Zmwk3LPb9EdOyT85lLzrJEKMgun37Q8feVU9+ecO4nTI7b7TL+d8xZ/annOT08ILFG6Z62v0l6xOuoeJ316zrlxayp9hiHS/nyNGa+CkrTvnT77jd+BF6980//inqH7zCXptJ25e8QdIPA7LfWlcnkrnY6rQdHCgD9Kp4cMDqbs6at0+uykp694IJ0Bq0zL0xM6DPxCjz6TTsRJSii0bADrDHnjByayGlXShFwP51w6N+xsw+nEkRJ1pPY+9RuuxKgoDU8i15mGNSq+cz5DRQZ8OCadT5eQgTro6vdzro8tv/MpT0tnYVr0hehLJ2FV+J0Fq5DO0nTWTtzWqyPZhNvSuWMPkMoA3L2tz7m+SLfteJZ7lmvdq8Q/PfmM//Qo3Z47PRnaWeuXVgXpq6VEId3BhwiDNTg+oIiCKE9P2C/d6FKNuVXoC2D4VLpDUM3M/6N0R5ac+sOnVFGhz9Id/9U66U6cviKP+ji4swmgS3fqTiFi3RkPp35DMeLowm6G6Dc5311xK+Rzd+3Mzbc/f83JXD1I9zYcEyRM++4z6Ya0By8r+NMT2vToN6B25/yDeXmU7ocyOG1PT9LrU6WYRRn0bpw+E3yfUaHSGzui4reck8jSix3pT7ZRKmZ0b/YB/asLqL2HetJ36KTfdVqYK6GaSun3q/oGt3Mu/cj5jf7G3aHT3/QHkaJ4f1Z81l8ggKt7PJd+wvro37DXIO4HAiHX/ds5l8HI+S9+2/DC4Mb5L1AAZ1eRpVJ9avGiAAaxw2GQxTrAlsHsowCHMlg4Us9eOPqdRG13OmhB4UpkJBIsrm2MepyXTf5HfVvrc0ML0cCR9Hjkt61r5VEtjEXR0OlPFEk0wrZHn/75K1Kp/g5S/kGiiQkQu8q1KGanaNIymrrTwc53Es3tZSzzKRhK9Kk5pbVU8QID3l84g3qLoRIta+E9yQPoEq2qPb+R6PZKFa/XGR3dXXgsw7HzOwr/z18tm04HzVu4qT/OuWU84Jy+2p/6g6SiAVqC44gMp6agbpd6lKHVHmRU0FRluHTQmdU9aT9ckXvPV+w86pHD1nQrvEdkLNGvoBxNSLdjzaA+lVF8XZr0RxnV5sho5vwDzE3mtD6mRksPtP7CP4TQw4KtWvKTs75e+AcZLSozuCQjqgAsa6OogUptaJo3fYwqzYUbM5Dom8lWzTcz3Cj14LdyM3fWr9/8VsZ9uMdMk3EErZRm2ksD0XhYkx8d4FnGIyMwyAZCIyYyhiCJEMHwmYynUO5W90bpusfHrIW3OCHjOSoDevqKnUaz4yqAtv3MbRtxxoEecVfGd5f1eXry/zrnhv/4HttftPAPjeWTtiNB9Tsgy6TD6pEc46xbW0gRkC9ayGTkMET/o3u/tSrTV3RZwk3GNI7ynEPcRjbAIpNFkAlxLmSS8Az9Zu8zmSzDqdUT5CCTleNFGlcDank6yGSNXM2Tk7tK6zmXuE3eljCrvkrcdcCMG6AicS+sU4gGlCTu1zf2foexW9iBq4PGZ1KjrMa12DiZWaeANPSIX1pKPLziwiDySqwkHvPEQygb6lXiiWviGAdife8wrX9U88vunxJPufjTLK4DFc/Ywz+jiv+TCeL5X+0MrxO5ywSLP7lqoN7XfUziBa5kl1UqcdIgSBaE4t9LvKx81L3Eq3exo8Tr+gTyyyS+rddVSD47vyHkVKPEd/Up1RrfB01VOtiNadthvaEIhxYy7VIbyFSYB9+emSH1INO+IxBQgEwHH/iJQir9FyBHd9RctchNR67u+fgJR+t39ieumRWnM+wpfXb6KtO581vrx6lMF+yHoNEnpyvTVWu4r1c2YxpzzWKnf1RzybztTM+2mjnnfdbomQ+cf6MALH3x5bpFziNuFv6NsmpddBxIQ+ZDmwCQZ0LgpbHdPz01KTefUIa793VstdFCgM6ftQA/zAoyX2AOAxp1bFMdz9hnTqkv3DS+oN2YqZbbv7N7zmW+5PFS93wZ+FTmt6yLmppkfmfrK/A/taGz37WURd9pCcDo4v+ymDnKOYB4zmWRMEnx5VM7s1jZDVjN72RxG1aAxzCetEObae639Kh/8G1xziXpXBYTDQeC5SVJoEy+2nwqSQ+47EOSk341YetBkoi/oZwQnlKSYUM7lk+SjOhFR2iworvkJuxgZFp9/RwIgvUxg3ZT0XAyboRhQIT5aqpaZRqQkoRwXohW01EmyfTdvFSSGausIYhkYetwm6yUJLlw9LIrJUtXjzY23W78DoOJtAmD/Zska+YLsqHA3eors5Tk80cqxui799d3ktyHVXFRPcu2qz6dm9AdKAA4kRFxef0Dg2UrSY9l0EfIXD3KsudOFO5BlpHDfjJPlkNXtbvquRtbM0pflM5yHCSemgxeTpwxKjRW/mLJv/c7WcaIl6enq18QllMTZ0eWs+pv4iHLRXXf0na5rHwlQ8C/ygicegylIst1kLe8YX1bO8DOVpb3tma0a2bzVZuQZEwaVUqtorBzaEpkVc1bhO76txdZ3ZF/UGFdqyu+g/RFHy8wWredRZsfYKDtrazfBw4+S7fNovX+pcC+rPvOovli6hmw19FlVGkoWhITv/3LeCnr0d/f/RuP1lNnOvdVyq9XZjvlggc+k9tB2GEiCZPclyrrSbrby5975PPYpjW+vn0md31XDWh3oXnCYbqX+7b78DV/UVr3sXvvhlrI/a2zGtjooxb66DO/k/8NAPOAm/BLEwAA",
+	"fi": "H4sIAAAAAAAA/2xXz5LjuM0/A0+h4+6h9x0kWZZl/WmNJbunfYNbXBsjifRHkZ7Pc8o75AlS6arUHnLNMRfXvkieJAXZPTu1lZNFEgRA4Icf4DCGcHpTemKjnyYiyxguINSdsZYwTCC0dGA1MlvuvdbkMFxC+OuRNE+ONIYphNrx0VPwhYKI7MF3hGEGoT56HgbCsIBwOJBmwrCE0I5q/nwWCSPnGwjtUWnHrAnDBsJRWe5JBw2NhjBsIXO39wsNjjDcQugnZ2kQHS8QWn8gDD9DeLooTXokwnAP4TdlD8Td7/8mjVEIkZk0zx6ulHWTOpqLGIsiEI+pMxNGC4hIHwfq1HTCKIFIDUcmjJYQeduzpmBJk8EohcgPR7JytoKITpZYY5SJmNcdY7SGSGnZK6Ah1u4pIutOt98GNV4xKiFSdpQoRRVE1mvFGD1DZAa+iM5PkJPlA5MOwoF0b0Yih9EGIksTy7ujRszSSBi1EJ285CF6gci46SvJs15hR0NvnnZK396/3N4x2stz+JvCOIScNHWEcQy5Mb2ZJO3KBT/lSg2sj/flzxgvIDf6aHTQqdH0lsg51koHjia6ZyNeQq6mnp/CX+8Z++Eo/bj9w94KmotiNzHGGVTGTl4P3lvSmvveYJxDbEzP+u4CxgXEJx4UxiXkNCrrNcYV5DNQ4mfIzeDHAxPGG4jN5CjY8BthvIXcCyziHeR0DnbKdgrjF4i9pds/yGD8GdbGD17sMMavkF/P1kwY76H9/W+q7xkXCTTUT4SLNSy+8MF4x7jIoSU99YSLEhZmZC32Fs+P755I/ylCiz2Ew1EJWJIYkjdPnbGYJLBjazBJITlez44xWUFxe9cTPzV0IkuYbCCx7KwiTBpIpjPpL4RJC4ljc2bCZQaNNyPjcg1LQTrjMoclDf1Auvsew2UJJffWaDUJogZm54ykgw0un2F5e7cfkhvY3B+XhpDSwWhMI8gm8xRZdpIjwnQBqVUzfNIEUmWslEi6fNzUQerpKhBMU0i9snpSV0xXkJ7mzQxSPlgJjMW0gNTe/qUH0o4xLSGlOZdpBalnrQjTGlJPnRqMPytMP0F9e+fL7V0LoujLbO0uuIHcKu57wrSVO06NNBCmW1mMmL48VD5FPE3kMX2F1M9+rnJYGX3sjT4G//nLX4MZWzpQv5x+oV9wVclp5y1NuBIjhhwTrlpYETvG1Ra2uhcMZQvIdHePMmYJZPb+rqyAbLKkBsxKKGnOCmNWQaZFU/YMElzHw+19Rs68rQNJkABe6YAGrzD7BJmlHrON/GrMGsimhwWhx5kO1wmslZWIr0tY00jcE66fYW1sN9PvuoY1nUkz5gnkMw3nKeRsjzzJ90qq7GCENTHP5IAP5BjzEnIzGssO8+pOaUHOzk1CqJW68IR5DbU5fTE8PeVGQJtvIHFquL1/rF8g919JVLxCTNeRPhCa7yGnb9TP7aQIoSAzYRFBwQfSRmMRP0wW/o0JiwwKVm8np/TkFGsscmgsBwXpnrDYyL253ooGCjUZdzJYtHLHOU9YbKHw/6/Gg7dHLHZQkBPWLV7l3pWwDKEka4SOyhhKo+nNYLmA0gyduRCWiWw6pdXRGiyXD4YvyTrWWKZQUkdHmnqyWK5E13SiYfhej2UOJfWqMxL9soCSBsayhPIqzcsGP0XejvQzlpXYORpJbPkMJb2R+R8ILf+IeyktiTQ7LD+JXcea/88rLDdQkp9LWHQ1otdNylpyWLbigCMstw8h9hOWO9lVHfNFtL3Iir4ylp+hVP3EEptX2ZzRXu6hNJMUb49VCBWN0rywimHrJ37KRdP82iqBio/KYrUU8v/VDA+qZ6zS+5FIZVDxG1k6esKqgB96YPUs974QVjVU6kwDVhuoyFuP1RYq9gqr/d1qo9S9PJ5LeB5JYx1CTVp6Zp1ArazH+g/Wqs1wvZdunUJNZ09Ps5YHv9QrWPLA5zOzhLfOoab+Pv7UBdReppi6fCChZmWtksooJfyD0VhvoPbKOiP9yWDdQC2xmweegXiSElcO6xZqY50/CiTqFxEij/Ur1PdoXPFTCJ9IyHOTwOb2m9dsNG6eYWPGucA3DTTKSvQ3W/je/DcvsPGkO8ImhIZ8x0/zVEfYRNDQYEbzgc4mhkZd305qGNhhs4DGd6SxSWDjjfTtJoWG9ZHOxipsVo/KXKlBacImg2Ywl5lYmjWsPJ/P/uKNVU7isSYdlHRVGpt8FqReBAtoJGQUFMpohY0EUgQta4NNBY3UGg3YPENjxpnpmg003rKmUWHTfBDNw9cWmtvfTdCa8fabmK3t7Z/6jc8Kmx0kQ9DQcJm7cPNZ3uKCksg68eoVmutVqKPZQ/OVvrFMk20IrZ2THXQUxF6fCNsYWm/76Un0x8RvH0MUtguZIKjDNoXWHA22K2hPdNe0hpakUT+w0+bQml4N5LEt5hn3qeXRWGzLWb0MynfBClqvZ45un6E1+kjYbmaZnrFtxUHNHXXiTmsOJHZ30PoLDR7bF2iJv4qe/Ty+zFDZhrDtZXIl3KawPc742Dbweuqu04WGgRxuX2Fr/Yy87R623w7q4fkuhB25+7yDuw9+3rF+U3pO9X1IuJfLLhEoqm9eDYS79IeGNykXVDIPXt0jfLvsuwOOWP/5tIIdK6dpxN0WdqQ9OY8vS3gRhp270dI7rwlfGrj/eficQ24mczH4Kt1xVBpfWyjpapxTuA8/sDMPsD3hXtA3zyH7F9jzeKDDV4X/HQA1ssH7Jg0AAA==",
+	"fr": "H4sIAAAAAAAA/2xXPZrjuK6NgVUonAlq9iDJtvwjyWpLdnU5gy20zC6JdJNi9biiSV7QyctnBdP+XlDJ24F2Miu5H2RXT9/73ahUJEiABwcHcBjD8L8tBzUH7d9//Bm6I2unjMZwAqGujbWM4RSGb52y1LuALB3YBV4rh+EMwk/NibRyPWkMEwh1rxpPD9w/RGQPviYMFxDqxqu2JQxTCNsDacUYZhDabriO32sxMWKwgdA2rHulGcMSSuoMBdQNV6uOpDQ7DCsIfW/V8cQYbiH0rrfUyo2PEFp/IAw/jk9ywfA/Lekawz2Er2wPNLzVn0ljFEJknFb8MGf7Olwb8yLuoggkZqoZowlEpJuWanYnjKYQcduoL54xmkHk7bPSFMzIGYwSiHzbkFWM0RwiOlke3jRGC7HzulYYLSGSd2qMUihJ6RGb/jRcW+4uGGUQse18zQ6jHCLr9XBVwYSsd45a6jBaQ2Ra9SIuPkBBF/cQkQuOZNVhuLJ2GG0gssPVqRajUoKgjhxGFUQn4yU10SNEpndfSRNGTxCp4doa652TO/fyPPXKGIcQk6aaMI7vEMbmaBzGE4iNbszDSml3IkcYz2AzXM/+0AoswZF1b+nTPUkYJ3f7yNLrK72otmWM51B65RxjvIB4+P+eg/rvP/5cvBhlGePVD4/mGeMU4pNqFcYZxNSxNV5jnMuiZozXEJvWdAfFGG8gNq6nYKOOhPEWYqFAvIOYzg87tj3GjxB7S8NfZDC+USOIT1a5XkCKnyA+Xc4SwR6q42m4fvGKcTKFsG25o0YzTpYw+awOxvcKJyuYkOaO7DNOMpiYTmmBACfrf0OkHjducEz2ELaNcJhxGsPw7Yunnr3F6RSmrjdSA9MEhm/N5dwzTudQ0oksBeZ4VDXrnlqcbmD4Zi/9yQ5XxmkJU3ceg5tWMHzrT8qcFeNsATOlhfWMsyXMVP1Z4ewd2oxa48cimmVyiHoXfBqu9XAV8ogEZOpojRYmMc7W92Oz4WrNcMXZBmaW9JExCSGhg9GYRLAxF/IdP2y1wmQCiWUtJZRMIRmuxjaKMZlB4i+kOfhkSQ9/kXKMSQKJZ6vZ8QWTOSQnIWeygEQdLLU9WUxSSKzh8T2YZJDQmPIkh8QrLTgkBSSeam6NPzMmH943Akkj9cYqahmTDSR2+C6BV2Lfc0ctYbKVfzpMHt+PPUTKOfKYPN0CJpyvYPNb+Fv5W3A8KW2UG5VybnQTrIxucJ7D3OjaW3I430BsDfWKcV7BnIa3XuF8KwaNpH4xgYWu3+FdTGFh5WWMixQWztLwfy0ush9ynJHGRS5HGBdrqNha1UutBAeretIj7d512xyHK+lgoWvFGhcfYGHpGRcb+atxUcLC3X1VsOhHvVxOYclW0F9msKSOhjfh8XINS2PrUaSXBSzpbDSuprBifSFcJbBStjmp15vmr+ZSnwdTN4yrheypA/UKVxnEpjOWHa7yu+rdis6cTyz9IVfD9WX47nBVQGyE1UHtg9zYGlebn1ZKX+PqEVbmKw9vPa6e7qyMaXjrSDtc7WFFr/R8GgNKQ0jJOEwjSNVBFuKbe35I/VExpgtIFR9PPWvXs9KYrqC0KkhJPxOmGzkmpUqYlpCyM/3JYFpBqno/gpJuIfW/c3cw3jaY7iDl/lbD6ZMcvjBmIWRkzRGzGDKj6Wgwm0Bm2ppExbOprPbDVQ/XxhrMZneEMrK90pglkFFNDbkjWczm9xdnZN2J2hazFWR0HK61EXXJUsioVZhlkF1Id2SDXyJlOwn2V8xy8dUYyXi2/m9kzuhIBrPiHy+KtGb3Ix3ZB3lNf9e5bAMZ+ZGBcmUp1/eOraUes0pC6Rmz7c3oyJjtZK1WL+wwe5Rv+qow+wgZ/3678GlcVFIU2R4y8yplLjt5CDl1Smo+jyE3/oXblh9iauXtgng+hVw1bDGfjeELfz6Z9hnzRDZuecwXkKsjWWo8YZ7+6KCYryE39mX43jDmBeTD9Uwt5hvIyVuP+RZyJWHs//G9H663OlpnsO5IYxFCQZo6wmIKhSilx2IGhWkvt0L/WfOKBAo6G09O8cOPO+/ig8UcCml65/Oo0cUKCnq+lVmRyo1G9L7I7mQpFFs7llImOLZGY7GBwtjeSDM0WJQ/iYYLztSykyzKxFBUo6VvqMXiEQpqpWiKJyhuOF3wQwgfSFR4M4WUgs1w9Vrmws0aNsaP7MJNCSVbyc9mC5vbOLF5hM1X0jVhGUJo6aA4cGR8rXrGMrqzrKTWdEZjGUPJl+NJgHBYTqA0viaN5RRKP3yvGcsESqUbgc1iOX8v5vlwbYfvmrFcQNmaF5mwGMsllC/UHsjWAffBknSQ0YU1lqvRisbmXqZQCngUpGzkindIM7JKY5lDeatNarFcQ2m6UTDLDZTeKk0dY1neI32XqLKCkkxQmW64Sk4Kq/RRnRnLHZTUvlBtLJYf/3HUKx38cpa/HEjvGXuBdMZfsXyC8iIdo9xD+ZVelWxhFUIlAko6qCmIvT4RVu9zWuXtF89OPIswyjdWExloqMYqgco0Bqs5VCca3uQ6xmoJFdWf1Z1i1Qoq8yz0Nh6rFCrVGRsYq27zR5VB5e3zOLHf7HOo5FeAYqzWUBndEFYbMRoxriqJVqv+BkdlDiQR7KDyL9R6rB6houHtq9y0h4r068iobQjbZztOTdsE1r4RUHBb3scVmTIcbp9ga/3I0u0e1v71MFzvr9iFN0sRtpaCWPxLinbUq6Ps37vBw05pGVoltpbdw21sGetuN4Uda3713BLukjvAO8W2Yfdz73W4W/zHbs0u+DnSXQ47xb2mDndb2JH21Ht8nMEjta0a0zXzvdeEj/cfO/hxBSvjzIvBpyk8DdeONT6Jrl5M3zPuQwg/WXH/zrx9BntRTMb9I+xVd6DDV8Z/DQDsIUED2A0AAA==",
+	"hr": "H4sIAAAAAAAA/2xXu5LjRq+OgadgaAd+CJKSKIkXcUVKs6MMEns14KVbp8lm1So/dRI7OG/gjf7yS0yyo/f6C+TseF3laHqaaFw/fID8EHaDaTy/vyjds9HoL8DXlbGE/hIOtapY10qz51u69Q17y44tDYz+CvwvV9LcD6TRj8DXA19H8tgLyJ5dRehvwNfXkVtCPwG/PZPmmtBPwbedms87ETEisQffXpUeWBP6Bfidsvz2R0NeQZ0h9EvwXT/Y6dVhPlM7/fcEvnVnQv8zfP/flnQlfprBXBj9E/h3Zc9UV49X0hj4EJhei5drZS/qakaxFwQgTlNlegwWEJC+tlSpxzcMlhCo9ip2ghUEzjasyVtRbzCIIHBXsn1DGKwhoBerWGOwETGnK8ZgC4HScpdAQawHSc3w8v2vVnVfMUghULZzIphBYJ1WNQY7CEzL42TwE8Rk+fYRjpfx3dxV1zcKgz0Elu7cYlCIbeoYgxICJ+UIniAwl5EktGcIatUa6/pJ50ni4bvC0IeYNFWEYQixaUxvRvZ+iZVqWUtefn3PYbiA2Oir8X7zYtb9C/WE4QoKqypdG/ryXqa9urlzyw1hGH08EBfvNHLbKgzX8Phz5MucsXADuzO15E3eNaYfGMMYQmMa8WM3m07g7XeWtynE1CnrNIYZxFKzcAexaV13lrDCPcSmH8hO9g8QCx7CI5xUq7SxQ/9PB58gdJa+/4cMhp8hMI9Xfvs/PYGmwfAZQr6RxfAEb7+rx7eGcLGErFYdSaS42MLi8cpnNzAuYliQloAWKSxMx1o8WOw+zvqfphcn8NvHK1tchrBsRqqMxeUSlv1gpo5YRrC88o0GXK7hRDeqtLTAC1nC5R6WlgerRK6Ax5830rVpxfqyhOXA5iYqVhtYsdjF1RZWXD1eGVcxrKht/tkdqxRSbqzR6j6928GK7E+f97CypC9OVEU+RHQ2GqPgb1owXmyprdXYD6PBaAGRVROkoiVE1k1ao9XfWryIawFlFEHklNW9+orRGqLpbgMRny21A1mMkkmVuItRChHNZY4yiEbWEn+UQ+SootbdFEafplwORqDlfYjsIbJTxaISopEG1VFLGB3kZYfR0w9lXsA9OYyeRWpycB1Dfsi9tdFXT7AsyCdcZ7A2unKWelzvYW1HEmThuoQ18cC4PkBKb/8vSSTcLGCjqx/J3SxhM18nsLlbUi1u0pl8U9K4yUR4EtxBYHkQULE33fWNMRc1XwzK8mAs17j5BBtLDW728lfjpoBNPyVsU8JmmLlxu4StspLmbQpb6qhuCLc72BpbkcZtDlu6kcZ4CfHMyHEEMdvrTOvxWtrubIQ8Md7IFz4L+8cpxKYzljHOoBjVwF5suR/MF2M99jI1co9xDkWtRmU1ebGZUBvvYeserz9dPEHsRqoHjJ8hprqbw5wBGJ8gpju9TL4kPiRkekwCSPhM2mhMwsk2eYm7iO/JBhJ+GZR+fBuo1pjE8PjTspeQbgiTvTxU0wRJCkhUbwaDSQkJDyNhcoDENb3qzs5eMTlCQsNEw8mzvJNT6kNK1jQG0xBSo0lOC0hNW9Ekmi4hlGgjmZ/p6p30U7IDa0wjSKmiK/UNWUzXouvxjdq/6S6NIaVGVTMTpAmk1DKmKaQ16Y6s90vgbEe/YpqJ+auZapzuJqym1JCZcZp+JN50JPH+lNP0k9gdWPP/OIXpHlJyVg3zaE4L0Tv0yloaMC3FgYEwPUxSfOHa9Zge5brikTF9kiPJ6TOkqulZMvIslzPq0xOk5i7d22DmQ0bdnMkshMyM5MXU/gg3W0LGV2UxW81tkRn7xbQNZtH8YRLaQMYNWbqOhFkCHwORMNtBZuw483WWQ6Zu1GK2h4ycdZgdIGOnMDuJZfZOauqVXQq7jjTmPuSkqSPMl5Ar6zD/mbdy0/J7I+cR5HRz5E0B/OCafA0rbvnGmjGPIadm7qA8gdy0tTR+nr53Sq4Gki5JuVGt0ZjvITd2MFaylxeQU6v6QRjceLmprHv7o1aYl5OUu1KL+ZMIkcP8GfI5GzV+8iEmIc/9Evbf/3Ja1rn9Dvauc3ryYF9AYaf87w+wnxeC/RPsHemKsPChIDfxDcm6NwkWARTUms5MEJphWoRQqPrxTbWMxQIKJ1RSLGW6q0rsFBEUrK90cxaL9XuPrmUUExYbKFozzmRTbKEYqT2TrTz2tqS9lL4qjUU8Cc3jtkig4FpZ8hJltMIihWKStKwNFhkUSivJSrGDwnQ0NUWxh8JZ1tRhUcycw967p+V7HUrTySKYW9YXvmFxlFDniVx8lggGLyWygzj0DAVLJ2FxEpfvjKUPpVAeaa8iL3T6hbCc1ukLe6WzTe+xFxJfTI/lAt5+pwrLCEpzNViuoaR6AmC5hZJkRL8DpoyhNI1qyWGZQMmdsb8lqh8UlimIWtmcZ8kMSqe5x3IHpdFXwnIvElKBshTnNFckiS3NmcTqEUo3UuuwfBL7oyg5QUn6Prf/wYdDY6kWDjlEcLhOuDgUwibzwFfej71ceQv7eKVR4eEZDtZNEDyc4HA/q/dQjj4caZh3IPYiSxUew/fcH1lflB48uZeVQfrmuISj0uruVEt4jH4ag4tajfz2x888dtx8/ET49+8ZHLlWgyDgeIAjaUeDw6cVPFHbshRn5QanCZ8KmH9ifI5liTSjwWcZm53S+FxCSl/NMCg8+TOM/m3jPaVwel9RTk9w4u5M51HhfwcAAmNwTF4NAAA=",
+	"hu": "H4sIAAAAAAAA/3RXu5LruBGNu79C4W6gjyApidLwIV2R0txR1hJxKQxBYAwC2hUzBw4cOHDiYMuJyoFLwURTdYOtm6H8Xy5II824tpwRr+6Dg9MPBhEE3Y7Jjis57HpeM4PBCAJZKa0JgzGM6yPr3A9hBoGm7WDculfduXPNGgwmEHyrSfKuN+4kMYghkIbXlgbu3A1C0ltbEQYzCGRtuRCEQQqB2LqT5IRBBu4funVneVS6692pxmAOgayV37iEQNdMGvcqCYMCgpZp3hAfFD21ijAoIbBdb7S3tHr/difhh48QaLslDL6C+4sgWb1fzWPeQNAzvaXnqu886DCAUHW95DScMr1jtTpwSRiG4PFTpToMRxCSrAVVrMNwDCETNbcthhMIrW64pMGEOoVhDKEVtTt5TOEUQtprxiWGM7/Pyopj+AAhk34uhYK4NMOQtNm7s2DtEcMMQqZbT1qYQ6itZBzDOYRKuNeDN/oFpkr4Gw0S0nw7NO58eQ0MlxBq6t2rJyAsvG9q6ePeYQnh3l6eKXyEUJnuF/LXfPK3IW27HsON/+Y9wyiAhCRVhFEEiXtrbNcPfkoYE1zWP38YjUaQKFm7t8FwkHDZ7akjjCaQuO+9O78M6dv1yfzYuJPuqHOnGqP445gH3dOBC8EwmkJxcKfnHUYzGAv2zZ2k2XVKmhfSBqMEIqWaT+5TiPbcH8wgoZZpKzHKIblIJppDooRtt5wwWkKkOkODJd8RRitIvDqiNWzcd1ENv6nmjxgfIbKa3L9JYfQVEtLu5KEc391j9AQRf9G2w2gDUcf2Nw2PxpC7c8vMfeIBRn3Ht9ZwHCUwuqp/lMFItVzyhnA0v3//AchoA4Go3dlrahzBeGepUhrHY3B/7fq7k3HsI5W/GNXieAr50dZkhkVPe9KE4yWMNTeaEY4LKF5IHpW4Hy1hbLh7e+GEkxlMuJS3pckDTHjVdxwnCUxINP8bTJMMMt5oJd2594fnMGHafWf605YlTDTJHaebzTiAmLZKYhx+Si4J1+4kjpcrxyOINbvoLx5DrN3v3no8uVkaxPboxRvHEFumZceOGE8h3ruTn51BzLeahCcR49Qb+C49cIwziOmiiDiH2HLJCOMFxJYqJpR9YRh/uWCSwr2a//ydD2+blhC779p9r++3KP0xw1oShPHKD1qMHyHkXUf2fu4J3rFOE5gqWTdK1oMkKXGa+3FlNXU4XcJU6YM73V9zWsKUuOE4XUFG9ZH0bWU2gpmsbozPxuD+9rGWwqzXxATOMsjontBnuT/DCWdzCDU3g8uID93bjnkxDkqm3Q/hd36BmaYGZ0uYaZ8rZgXM+gt3sxLmgrr+5uxhDA9Me+ofMniglnxoPczhQenKGyV8WMADvXgryRgSJo+ESQwJ1zXvbyUjmfrI3aqq7wiTmV/lWzIckwwi1SrNPqSU5NeUOUi4Md2lxOTswDtMFpdYoGaYKC/xZAkjdxa30SMk9kDcYPIECT237nRjhjWYbCChnvbveNIAUlJdj2kIKd+SVBLT6N1vanecMJ1Bytlub5jsjE/vaQKFdq+DlGRDmC79wWu8pgWkrFNmrzAtIeXmcGUmXUFqf2Xt1uoa0zWkzNyfPn2C1L16jWYBZKRV07g3zCLIlKSdwmwEmRKVOhBmYz9pmGS19nsm7zgz0oZLzGLIqKKaur7xoZBNvb1uT0J83D9LIKMdq9ybB5alkJHgmGWQcZIt6cFPodUt/YxZ7p3V7s0XmGwOGTXk3i5azm7880FGmpP8VHeyL96p4ZL/yTLMlpCR1dxz7c0U3qbpmNZkMCshcydhCLPV+zZuO8zWHlPlXg+frD76OfqFY/YVMvYrv3D0dJl89oGRbSBTvY/1BvMAcmqvnOYRuN+ehwmJ25XzMeS8ZhrzCeRKf1PiVmEwj/3S9SnzGeR8R5pqS5intzLMCfO5P3dw59oPFpCzF3cSmC8hJ6st5ivIuWWYby6uN+7sD+I8g7mXIi4CWJCklnAxhgXTFhcfmW6hBH+P9UUMC3d68c3Vb8+3BLOYwsT9EO77ywc5iwQW1NwibJFCymR9ZPd8v8jeW48FZ1qzSyBl/nmEkrhYwsIybZSvlQoXBSxIsK43XH6kiUUJC6WNra/91uLRbyKLiydYXCk64hffQxjSuBzD0p2t5Ericg5L5W/NCZcFFL4T858rmGv1kVuWj7C0JCvCIoCiJ/d7NQy0O/kXLEIoSFB771dZg0UEBTvu9kyIT/miGEHRW5+MsBj7zuJc3RwUMRQ9lzW9uN81FlOPRJrBTChJWMyg6IU6uLPXR/EAxYHElnR1YeqB5CCjI5NYJO/7To3fl0Lh+aRBypRkWHiW/VbNpcIiv7hgnjEs5lD0ngZPXrGEwmouqWVYFNfEdYddQuH+pQalat354n2h3avc8ReGxdrzcLg0A8VXKK6BT9p4ZE9Q9O7V67bYQNEf3Knn33yvg2UApeadITmoaBBZuScsIyitbrrhxUVEfKe6DxrLEUSdO1VYxlCqWmE5hXJPN3sPULpT1Xf8rrgygVI1TJDFMoWECWaGJW+VxjKD0v3QTcvu/wxlDqV9V3g5h1LJmrBcQnkpt83twcrSw5a8ousrlGpLHsoaSnsgYbF8hJKeDySx3EBJsr+KbBXAqtH0LAlXMazqi6hWxafGw/1ZeDU1uHqClbYX7a424P7Zb1l9Q7kOYE2GN5fvWzlYc7lj0lzw0ODasXD5SYDrMayZZL1lgnAdX2vv2te/T9pdzz5+bf6wlsOaMyOpxfUK1iQtGYuPE3gkIfi1BE6ssZLw0UfT5cfoawKJj6WDe8MnX6RbJvGphIyOyhiGm+Aqsv/TnG8y2Pi8Sbh5hA1vt7T9heF/BwAuwD/lKA4AAA==",
+	"hy": "H4sIAAAAAAAA/5RY3XbiyBG+7noKLncv9iFAyAKDgEHCjHka1nHsZLLrucjunJPLttGcEDHLjMfIWnswL6HSA+RJcr7qbiG89jmbK+jq6ur6+eqn1fQUz1nzHV+zLuf8he9Yc9bgNd/yR06p2VbFF854wXk5L+esqemr4gv+4QivDfs5p6zLH3nDmleN4p7vOC3nnJdnvOSN/GaccVLOqXmE8xd8i5O85R1+qRmYe3ac8o3w60Z52ShuRa1roSxwe9fwGZ6UV6D1QVuJPhkUoWZozII61Q3D6qQ5NYYec77hxN6bgRqB6w6acsprMShr8M/ioxwMMRieoLiwaGpO6hTNK0OdGvFQ8xrrt+BaQRwveHPgZeeZGVgWYm/Cj2ChVlMVt5zz1lgGn/Ac3OWPnMBT/GQ1b7VU5S2NePGWWm2hidW4esEJ31PLBzXhFd9AJrWOsM7Ls3LOayOtwR/EdTm1ArvJK4TM2tzqgKr5cznnhDdQtLsXAm5AJqXWMahwcAqevuKfLc+1i+1CYnvLKd9xYrQO5VA5h8sl7sY/rYEqbivxCW+oNQRnLi6HHzS13qhiZ9W8dm6+4FRM/1b53wocG4EaULdxa0XWMom4tqFpxc4LS0ig1lTWvOQtP4GCk6fOrZq/iZJbas0cLeWP5DVFOXALmD0P65zXMNsq+x3/YnXJ+Ob75xDx2uYEApo3fmgUyJeM7yVYmrwjbMPdgCLYXIYi5Q4hDbMzyeMHTnh3mKvkBc8ucn7SArgVeR3Fl/yE6Jc/VrjwuubYrsGL/87/VTzykwjU5Zy8nuyVZwDZHwzrK/47BHNKXghGbbPQ6jMw+1kto70h+BB98KCEAQHe2FCFq8E/ic2avIm7HdnonWCl+ZrzBl8BDAAIeVOQNxaiuElzTt7bA9g+cfZCDpN3irMpP4jnt+TNoHHCn8QxbV8VXy2mXZVqHyv+h+AUai05pXZPFbX9EKsctVROrCFn+Jz2p8LZltKy4v+gLJPvqeKe14JcpN+cfB8UuCy3l/uBKjZSIB6EuiW/IxWtvOQ73rlrURg/S/g1+WNV3AsQllIWNPmRKh55yw+VTX4MsUupWw9COeoq/iAWuuQU6rGhPnJKRz38z3lVnvOKkxecb0F0FCrpRGsL/gSBgbAhBEBJBODlo2PFH2CF9CRoEDQRMYEIZxS01PMm9ztrvpF8yMv5M38HbVV8xX1Vrge+4isLKiAvo+Do2ZUuVb9KioGsKQgcboSJgg7Wdq9r8WPbzhJxoKC/v7ru0SA0J12eBANlb8okVsHIrE2XMDn1APob00u3kG6V/E16a7pX1ooYgxUH62kaxE4wShPgjfYbTEAFr+Y7CqYHyvxQoB9I9pVnFJxiEzfCGk2dnlzDmRROVKgU5eyKL6kzcFuLfRJTZ6yKLXAuiF/CudSJ3YiQSup1Jnvdb6p61m0DwCKthqeuD+q+m0Betw/aVly04YRX1BU0JjWsUVfUw5F1zUFddDG50DUHO1W9ty7/rTxv1M7Z3V/5CZUUC+Qn7OU76r5RxSNOlefUHdv/nFHXpuKBzjFou2pmOfYBKIANUDsOFf/FdsENwk7HQ6gPPy6sohkdjwzXQ1U5er7iX2zP19QLFCMOcIAzuNdxBR7J9Ygz0jdS27aBlJR60gZQ6vLXsrY3QGXGVcuG9M0db2VEuigvASDqjaCxFHSkD2/3wEUzQJZo6glsjaufXmSYwiLIkH7HS+pJsQfr3Yt6zRSf2Xb5qTK731TFN2Cat9Rv4X9qZ1Y4pe/tbSm+1XTW1O8a5k+SQWj3Ozt19XuKL+G1hkhGRmjqj53sxMK4H4GSACQo5tSPsU55h4Sg/kS5C8tz3kqSXtv0uaH+CXYREqSnpv6pk45V2ATI4TuMMWvOKfRAcbNHTmHbrFeSRLgv9E1n/CbAxSRvtAyPrAcaViSszCgMIABlydTbLYws5xR23NUYf1YvIyTsCROvObFJLDf1DXXFKYWSpnIYUG98h9pj+vT3FA6cMW7M1BQO/zCKYJ45t5mZu1oUvoo9qzZKfvaK2m+cbbta8w/HQuWnWrEQjSKnJYInwaIwdiYuwTGpn0TVwKAdnjge1IMnd/fUUZEOKYVvsU4EGlaP0z3HxtbEcAZazh+rFrOmQVPxhawdXAYeKHk5R/5BQD0qAx+beP9BjcGRZS0vxPXrykc0CGqMcvugayhrWzHtE5IGffXq9D8Y2gtkisWko2kwAi2RgWVFgzFW2qZCXp7RYAJKCgonNJhZCY0i4+SgtA5Dxb8CQ5zRqKn4nc3zO9Y08rG2sy2NXhkD+J1F3L7vjAIclFqLvtlwl9d78KiDWSeVkxiw3LN71DNnEXtXkEZ9pHLCn2vNaBRWSfiOU8BJCuo5p+W52JiDaQxh4gSBaO7m7JxGEbY049Wztei1EN/t25lTKgYzQoBqfCbpDcU1jabYAULE/TQ6lbUNrlRpemOfUyKXxr7inzixCQdEQdPxEFRQ3MSsaRyJiXiEyl3jiePZ1hwxnjoqIrdgTVET57R9k27cuwoqGXRHLTDkUiswpL+S3JGt9Bu+F49aatu+MqTQZRT57oFlEBUF2IcNNw4CkNWpv03waYBvLRb2byyKuuBaSf11jTk6Bg2eXNmPPwuJ9F/dFx3kNupv1NsfNvjRFMlLXuDBGr0HL/eME4oEPk6C1KmMc4qqRo2PFppXFA1Bye08mFI03hsC/TEVRtFLjfnQS3F133vxeiJG/NNKQc9/gANODNuq9uaJ3jqH7g4bTnQqG7a0RDPnJyShfX9Q3FT8XjgsXhpQqGGfmBJ4ij1V/M7/LuconVDLjgyYWrfPQRG30VU0LygOlNhywznFHYiA5ba4UHyMXc2PeOdUYI17oKIFJ1XKxH2cxWcVKRKIEQaHlOIQG+Lq8hwuq32Hiwd2T1CypXhoBAvqKB7XTwIIcez8AH4g16AIZzDbiBEnIkPGJ4RAlJs6w+DZjOIZeGDix6qtTZqK/4bPBZL1uEHTJDC0myorJ9Hzl5nU7M/2s5fx7uRUjtl3misgk5lQ+aN8cNt786Sp+EoKC3wslNpwxlcWWkDCEtYePPX2JfcETz4Lejw/ziTbNZ0Er8z7VzIBP9Zr5iFITuTr5zlf/BneAW5Hiu5sOp1MQIFboAvMQySmR8YJubQMMz5/EG0dljVNI1X7BPq2576woCTkdOrjTS84olM7dmwwadKseZDA/98nqFmoiqyaJjTNplinstZ8zU+c0P8GADuxaDjAFgAA",
+	"id": "H4sIAAAAAAAA/2RXTZbquA4eS6vIsHvQvYckQID8kBsHuDATFXfQxbHrOXHVoTb0Tq+jN/aOAre67nkjHFu25E+fPos4hToYClE8vmg7srMYLyC2nfOeMF7C3nK0HNjTFMWeLhivIP6rJ8vjRBbjDGI7cR8o6shGCflL6AjjDcS2D2wMYVxAbC5kmTAuIfaDnoc7sXCy3kDse20ntoSxAkWDoygetOcbYdxCHMbJy5b9Y0hGPo4Q+3AhjL9Drl/lCmSj2JDtMD5D/KH9hfgHWUxiSNxo+RHhWvsP3bs3cZYkIAFT50ZMFpCQ7Q11erxisoREm54JkxUkwd/YUrSi0WGSQRJMTxJQsoaErp7YYrIRs2A7xmQLibYyV4AitpOgMl3/+dvo4Y5JCYn2g4CUVJD4YDVjsoPEGX6TM7+JZ7IdRTl5vshUA4mnkQ0mShzSQJi0kFyDZCA5QuKm8Z3kQqd5sw8jJmcZ8ofGNIacLHWEafoFqtS9uDH6LdfasO1/x3QBubO9i/6IcrbjlUbCdAWNfg0Xw7co/kvyEbXa9nTFNPu0Tjx9fNAbG6MxXYN653HEdAM12Yk4yqhj22Oa/+Lb3TAtIGXZU0JOg/bBYlpBy872N1neQe6MGwSBtIHcjRNFjVAi3UMumU8P0JL9EWwfHbTvNKZHSIOnf/5LDtPvT16nV8/jNNCI6QkUvwo46RlSfXO4WMJW+4EsLraw5UuYGBc5LLQdyN9wUcLCDWzF6WL3LxT/Tp4hNj/og9jjMoXlLVDnPC6XsBwnJzRfZlDqUZbXoOhKnoQNNOGygaXnyWvCpQL1SvbuDC5bWE7sXplwtYEVW+GzfGxhxT8YV19RLMkIjUdclVDyzTurR7HdfbFZkXcaVw3UnuwLj5jFkNHFWcwS2Ni+9zxGDd0JswVkXs88yZaQaed7JsxWkIU7WYo+D8ggC9rbUd8xW0N2FeJlG8j44slM5DErIPOfoWclZDRnMasgC2w1YVZDFqjTxoVXjdm353wkAE7OMxnMGjgFS5Yxa8V40gMZwmwvHwNmx+eePxIeRwqYnZ6B4jqHtbN9JPyMVNxEn5RaV7LSBU8jrhvIvSMBbN3CmnhiXO9hHeyjtjcL2NjuCelmCRv/vM+mgM3oSRvclE+KlWRxU4m9rO/gyIbudI1+4ttxpGgInadozQ+jb7DxdMNNI78WNwo2408HLWymWeO2Mz0F6G0JWxpIiLjdwcn5btbTbQ1b/Uq2x3wJubZ3wjyDnH3/UOd8LaV1cT8I843M84UmxryE3A3OO8yrp0TlPE3jLJCVfuMR8xpy5zVF+4k8Yd48P5U2NJ98hDy8E0+Yn77QLaW7VFN+hpw+6DYHUcRQkBuxSKDQF7LOYpE+3RbhhQmLDRSsX66TtuOk2WKRg/IcFWRvhEUDBV+0JKVQUOjRTVeHRQsFT2GGodhDEW6jHi7B91gcoKBJtLQ4yU4mLGMoybubwzKF0lmS0QJKZzr3RlguZXLSVvfeYbl6BleSn9himUFJHfU03shjuf5y3ZL8eCVjsMyhpJvu5povCyjJMJYllHcSKYl+S4If6HcsK/HUO8luuZM9FH7laFn/ej5L7T2SUH6TW0yiR1g2UFLwPM0AlEqOnUbtPU1YtuJ/Iiz3TyMOI5YHmaVOvxOWx3n8zlh+h1LfRhZATvPkXUqiPEPpPqRsb1jFUNEw41ilkJN5XFN0LGC1hIp77bFafYm7cv4vZ25YZY9V2bqBim/kqQ+EVfHzkcNqB5Xz71rEpqqh0q9ksGqgouADVnuoOGisziDMk/p4+N2VsBOm1bG8M/Ii1kuotQ9Yr6B2hufC/RStOoOaXgNFVejZMtZrWLHhV+kC6hxquj0Kpi5k86MO6/LJg5q193oujpL/E7RxFusG6qD95ORJclirz6Kvyehx7mXqFmrnp9CTwfoINRkKWJ+gfqBwx28xfCNRzGYJzT9/ByvdV7ODJgxzWhsFSnsBvtlDEyQxzRGa9xk4FYP0Y5Gi0DGq5Av8yhk3OIsqBaXvL1dtjB5RLUCFjiyqJah3LVdUGSi2Pb0GT6jWz/uutdGWUG1AGfc2d2tq+/X4NzIX8t2MyFZQobu2qPLZnm5iX4AS1CgqtLMalWAphp6tQ1WBkmIjg2oHyg2z2qkGVPBsadCo1CPWT71RLShyUeuGRyJqz/aFXzWqAyxNpMi8zU+v+i43mqKSyE8S1Gk+lK6ozqDe6YOFRdjG0EpbQDbqKEqDvRK2X7ujNvjbQw9T4hc3YruAlDpsM2hd77BdQ3ul52FbaOkHPynU5tC6m5ZctwW0PDgfFXqcNLYlyLEsDfDDtII2WJa8tjtone0J2+ZhhG0rIVru6AF06y4kjg/QhjcyAdsjtMTvcs5ZGqGPmTP7GPY3aUkJ9xns+5krewXPfjpS88+E+xPsfZhpuD/D/uOin/EfYjjQxDcZ/pTpA9sXbac5jkeTIPWFhyUctNUfQRvCQ/YFvoM8QfbnA4iHzf+vxX+qP/FQwYH1ZGnAwx4OZANNAY9fteRIxvAjFaswBUt4fP5HwO+5tIXuzeFpCScSPTiJ9t3dNGk8x/DsWX+S6FzCWTSN8HyEMw8Xurxr/N8Atj3grwINAAA=",
+	"it": "H4sIAAAAAAAA/1xXPZLrOJK2M08Bs9t4hyApilLxp/hESvVKXorEozKKBNQgoBclq529w7a3GxuxE23MAcaum/RJJpJSdXeMVRSQyJ8vP3zIihLYznYkFc2dNjNbozFaQWR66xxhlEI6sSPPKnJ0YrU37BmjNUTfhzMZnj0ZjDKIjOchkNIqJncKPWG0hcgMgceRMCogGk9kmDAqIXKTXj6fxcLK/g4iN2jj2RBGDTQ0WVI0accdSUYtRGH2Tg7t7580yo8XiFw4EUbfljK0+vivkUyP0RGim3Yn4oHJYBxBbGfDpHSvUnfTg71KqDgGSZd6O2O8gpjMMFKv5zPGKcR6HNhivIY4uDc2pNY0W4wziMM4kCQTbyCms9NsMN6KWTA9Y/wEsTayVkBDbPyXmJw/f/w+6ukd4xJi7SaBKK4gdsFoxvgZYjvyVXx+hYQc8YmVlVr0zBjvIHY086gxbiQkTTRj3EJ8DtKA+AVi6+cfJDW9Qsx6tC7Ms7g7SiF805hEkJChnjBJHmgltrOz+inXemQz/IzJChJrBqu+qJzNfKaZMFnDTl/C6TRyRyrRxjv6vvSFMMnu9l9iR7cbXXkcNSYbaK58u2lHmGwhsbMn1f/x62/R1Tq2mOR/RrdvmBSQSF1JCQlN2gWDSQWJdCd5hsSOdjoxYbJ7ONpxR5jsIZG2JwdI6GLVQbteY/ICSXD08Q+ymHx78Do5O569wJW8QsIXZzE5QqK7MxOuUsi0mxZirp4g41PwjKscVmR4ItcRrkpY2YmNhF09/x2Kz2VDuDpCNA5aKJEmkHaBeuswTSGdvRXnaQbpwN5bTDfQ0JkcKdt13GvjadSY7iB17J0mTBtoLjQYwrSF1LO9MOF6C2s2Qgf58QRrHhjXn0CuaXyTPVyXUHLnrNHS+vXz5/7H/zv18d/a4XoHa0emY8IsgoxO1mAWw04Pxi5322K2gszphSeZwGPdINZryMI7GVLf5byeNWYZZEE7M+t3zDaQnYV92VZQ5NFrkY+sgMxZ/Zl4VkJGSzuzCrLARhNmNWSBehrDhTD7+lhW6S+BvHUs4GQ7yWjJuRVjrycaCbO9/Jgwe3kc+hLzPFPA7PWRK25y2EWN6lltrBlUbs2Amwo21vTB0YybHSTO0o0JNy1sSLRts4e9Gc5LM7cr2Jr+Aec2ha2TSgi3BWxnR3rUuC0fROtZlWRwW8kRMX+GVjvHUoVVJ8eejOHOql6P4x+//vbcaTJWLcbG4vYrbB39gtud/DW4bWA7P6K1sPWL3j2l8KSdAP5UQsY0kdDy6Rkytq5faPxUy8blIkKep5Br806YZ5CzG858uwt2vpHLdrLS2Xwre3wiz5iXkNjJOo15dZculbP3s9Kq0leeMa8hsU6TFKEq63rMd39baUKP+Qvk4Qexx/z1wb+E3icymB8hpxu93XMoIijIzljEUPCJjMUiecQsgjS72ELBujt7bWYvElvk0DhWBZk3wmInx5YuFQ0Uerb+bLFooWAfFiSKPRRhnvV0Cm6wWByg0P5+HYtXOcyEZQQlOdt1FssESmtIvlZQ2rEnUeMylVWvjR6cxXL9yLAk59lgmUFJPQ00d+Sw3DzqLcnNZxpHLPO/C8bCkE73SwplASWNjGUJ5TuZiZz6Kea7Fv2MZSVhBytNL58/SVxSRxbL+q8wTMZoNWvvRZfZmsXlVynK3zWr3EFJYWGf+GrEr5/ldnosW8nBE5b7hxGHGcuDrPZ81Vi+yCf9YCy/QannmQWe12XxXSSmPEJpb3KnO4tVBBVNC65VAlWwV1IJjY+CqxQqHrTDav24MZV13+34hlV23xGjLVTckaMhEFYF1KRnVjHNM2P1DJV1Vy2krWqo9IVGrHZQUXABqz1UHDRWx0fko77fnucSnoV9dQQ1GZo+/hfrFGrtPv6F9RpqO/Jyv/8StjqDmi6B1N3RQ6jqDax55MuFjcY6h/qTyHUhTpYa6/Lx4NesndNKq5J/CXq0Busd1NZ56wTCuvlLG9SFRj37JQms27vVQONosX6BmkYKWL9CfQflHb9G8JU8OdylsONglpFt9ww7u3AHdw002kkTdnvY3YeA3QvswoJGE8EyyJFqKPTsCZv4QaeGRjuJsyaBRr93Zz2OesZmBU3oyWCTQnPVopVNBg2bgS6iFI08aMb/8etv6agNYbOFZrTXZcJrnqC50ngi1yutnsiokt61wSZfbKhbXuGmgEbwIlXoJb6gKKaOjcWmgkauH43YPENjp0UJmx00wbGhSWPTSIbqkWULzcf/WdXa6eN3pVXtPv5pOr5obA6Qjqqh8bq8zs03KcKrksh5yekVGhY5aY7Q/KAbC32wjaCVAYKM6kklwZwJ28/xqQ3uTbQxIe7sjO0KEqYe2wxaO1hsN9CeiR+PX/sELQ38IE2bQ2vftDS3LaDlyTqVzh7bEsSrDMh3uwraYFia2D5Da81A2O7EZoGubSU9wz0JwK09kcQ9QBuuNAZsX6Al/iF+jtCSuS0M2Uew7xzJiLXPYD8sxNg30HiZ82UKYNy/wt6FhXD7I+xvJ/1I/BBBwt5//M/yBBzIyyRo8fCp3Qc2nTZeaXWfIuS2HFI4aKNvQY+Eh+yB3kG7gQ2r+PNpPGs8bP9jM/rzf4BDBQfW3tCEhz0cyATyAV/W8ELjyNKGdfDBEL48/n/AbznkdrZXi68pvOpJG3xtoaR3673GYySsuY+yeCzhKCpGeHyBI08nOv3Q+O8BAEbOFTwdDQAA",
+	"iw": "H4sIAAAAAAAA/5RYS3bi2g5tH42CZlWjBmEbMATbUNhAwaj4nJPgVQ6fe3mxoZvBaDpvbVkHSN37Gq+RLEs+H2lr62OCyLDlNZcdXvOZT3zlIwVdw2s+8Y4dN2wp6EEu+Y0d7zqy/sAlN+y4ZMc3qP7ihjetSEEf6y+85ROXfOY9zozbM/dc8pYdr9l22PFGtjne4ZphuwSvcXIFXQJdxRs5ylKQQm744OXxY4+sn0Ju5GpcdYIuN3zmg7+0Nb/hkms55EZBgU1OLIXeUjB71lStbgEdnN5A+gWpbIHD3SfeUbCC8lWhgKOffKQwMOIj4C1hgWPLDX8IDhs1MgyNggHUzxR2IcOxind8pbAHueKt2BL2ISE6dbu/wxcJoKMwbl9haetMOIDmTaQS9gz9ZidBLil8MYrwkcIEaJ143xF7bnKUk4Cv2VGYYikC0AYtzFoZR5VcUjj215cCgqXwp1KMS34H/LVYsnkorAewVVE4xRkNv4IEFOZ3pDcaPEthgSXYCHKFC0iOP4Q54IOlcAldpSE7U7hq5ZJfKQqMBB8ORNH9+Jqd/J073wRXLD7x9jtFXayHi1t2nR8dRf0qkFuK+vCw4YvEE7tqRMQKzX7z6w/JBjhdy1U3iuI/DmzdBZPgcjQwfNUsKPk/FA2NJN/fOPQK93hD0eir4RQlhj/4UzMnSnEFLHB8pChDVBHfaAy9DyqiYCmatjrQ3XY0NyxFs1YNvkdzw7/5b5C3kcBHi/ZlIyCAGlGbEh1+4y0MBUUq3lG0xMqLHKs2rFpLf8vt3Z7h7VNOd18gww/EdM8ldUeGd3wSPGvqppBgPcIDUC11x/8jBF8X4v+NupKmlV4Csyz1hAZwCLx23FBPqh4gcWpYLzZ84A9x5J16A0CKSzXWvgTeqKdVqOSb/F+zpZ5UIaCwo54UnJuUy4tc3x8aeTppJem/tLKYSP1HqOFg7RelsAd+IcgnyRdL/fE9X1ATGnbUnwJvoHOjOAC6QPZIcQjUDnzgin97N0DCN97xjeIuljaaKLGECW8brUJxHxrUhVO7We8QkOPYb37lkuJBuxmZGQ/xjOiitO65oTgxWq28/3EKjednnEHCWyAZT4z2iDXvhMcXin8+rYApB00gRO5G8dQI2OJ0YbQD7cVxS/HMa94pXjwd9EPuP6MVULx8cpYGIyPV5wSqayZ3vknxd9x0hMuWKwQBERJEO3zlqtOm4XcaZP4EoRufaSBZ2Khp8HtQ+NKJLBjM7ncqaYfSqEs9wxNgqO26USyHCZy/SqZWNEz1TFi25iMN1ZAdOxpKGqHn4UqYJQ3LSqygKtHtNwBEaiCuRr3UIr7jkoY/Df8lt6+5puHU2yJXaSk/e8skD3AuMtbSi1DsU2phSS/CgU+hOEhu6WUMT1B/jvQywfOFjzTqGU1tSyMprKVS9FWHj9EA2oNOGrJu6NeBh4I3jaRiolzAcUej7N4JsXQPP4EymFfymUYTXwFLz7oP6QEw5Uaj6T9e73Cu+HOj0QIFtT3rRqPlPWlxV8kHmL3CCa/8pm4kgZGGhvkgCY2OReB0Et1NRT6AYpaSIZaU/Jv30qz22v+TERY34CbCAGCTqdGODWcsJTnks5RfR0kBCQisKZnhGTXorIA2vKVkDu1e0zVZQnK8oTQw2oJqdpRGkAChSF3jW4T2mLTn3++V5I7SPow9gqyNgJBKEd7xls/IFkoHd+Sw5MoVpSMsqTUp4E+aQINBsqRUqyYS+cBN55t6cWD7ndLMm7DVIcZSOoYOrdv9fymeTr6Y9qVQep6U/E7pT6PuaZ+idGq0EiMee+9F7o1D+Na8p7SABshbSmfPez7EpDOl8z+mLyzfPeYvShfY1tLKcUnpL8i1+CBhWkKutLakK0iOX7U215QFRoD0414WgbTVA3wQH/3kypayHhYjMT+5oax/xwc0Ru9wXFMWt4v8/JoNjbbvRsu0pSwxj7GRsrHRE+ADMt9SNoEOHbCibIrnteZ1NoMEeBxMWqnUERfbA8cpipjTCjkJ0IzhpKVJD884ZtLHk9Mp8ZXLf/bASYwlF//l8aVBeUgmA3hy0XHzohR4p8kIe+v7B9QkeVx3pEl6T3hsKUFIp8MAUu9Ik6nRm0Es56c6R5McF155z2+eExdJdk8/ub1od8tefITQZAGN8IQmSzw39y5c0k+ZqPeYN2jaM0JPpygfaTo2WvmgsTSVWahRxkxn7VswztJ00UprHTvyAKFoeANDzxIV0PdGeXhnz5UrCU7+mOVxGJpeRXkXl6HwHynv+cEaZ1jKY7yD29vWXcoHd2CR3Oj1+RAqoOq/QvMXaDb6Zdog71HG122dWmudzUePfXW7T76t8FaGxkpBspSnT1UO5jjKtfsA+3yMZ+BXCX75tJUbJdQ75bnR7vLeeXhbYBnKlkTxgDudDuKoIaCbpXwO0KRqVfr52VD+6w7DQSl0pHz5uNZSvmol95Q3RWD0G1pI28Hg6OcjS8UjPFhUtw211vaOrlZ0Mah+8pp3VMRGyeeoGBi+8fpxzwveyXD8lCLFCFqcKMhSkUBGL3Xc/FCOWypSo8NNrYzU7RkucaqBi8XY6Pi/hTR97JO3hXcWO3YtC7Bgg8SgYm5UAk0cFQvIpRYe1JViBQ1qBw6wNAuMTlaNjnWWZvK7CVBo02EmIxR+PdAfXsA/pMNMJghY557yciafOgjR5gmoeYASgDX4BqmheQwQTtmhgtUpfqd+vtO8Z6R5wQS4Zmke3wOLUREgVn/OjbJz+O/rpGn+q1fzzOhX8F6ZPp8ZncIwtTlaPJqI0/IotLpoMADi4vkXIPo18l+7CI6jZQ9xB02OtJSGCvAd72kVPGXV4xve0io19xZoabWAhAM2vGHHa7b03wEA9mqyL1kTAAA=",
+	"ja": "H4sIAAAAAAAA/2xY7VYayRb93ecp7s/JY0CrDUIjoVuNPo1W5SaIMeokaj4UR1QGaAh6WyMteH2YCoy+xax9qqrBWbNWlitdXVXnY++zz2kyrqNEQ4mhkrESd0r+qWQ8jn9SZo5fyFjJLSXblJnXz20lD5+bh88Hd+PvD88b58+iSZkF/e5Aia6S20okSjwqGVPGSy95p8S5Elf8OFRyT8mRkof8d4MyebtP/GBjRf0c8cZtJRqU8dOlM7u0lJ664VMVu0WMsCo32YfA+es6fv7jixK3Sjb4rtBRogPbIlGyqmSHV5f/sdq2L1ZT03uUeWN3yTYb2XqKBpywdd4F09ioxIUSAyXP4UM24yh5jLyw50oOlfzC+94q8acSN0p+UrJG2ayDoybuLSUSys7ppRjJg8n3DNMlZecdJb/iEuRsRNkFhxMaKdFTssZonCnxQFkvfdE1EWVzjoWgy39jyubtLhBhQNlFfX2N/Qcet3ACnmsH3/HfUzbt6+tOlbw0iGZL6XU7SlxQdokzIDuIFB681mnUOdxgeCJ4Jw8pW9Fn25zAiLKBvn5XyTplQ8fwRlMsu6ovfqtkn1O4pv1us+2REneUXddLGvF7cjOOEhGHtkEuCiDmf8lvnLoR70S+XxloXdRCDE/FzeRq/9dgOP7v9fj3D+PvD/qILp1zJW5fkbvg/Br0xhc/TEkg41G6n1xvetfMLSben0iPjF6Rm3NAF5AoIRflEcN7WeWcHHOwYBq5BUeJvpJSib51t+gw9zvkomh0xUQGZ7fE7n1/IBflEyvJ/mtUXFRQbOsXjpOLquhZYPfIXdFXjjjrzD/5ntzVdFdbiQeQwkWh9JFL0L6uRIIqcdf0xiPYFQm5wGaTiyCmOVB6C5Uj39LcosOxHiq5SXMFB8yHo3XYFn2aA+m2mHTbyPD3B5pbermUJn1uPVWGAWzBqQbNA/oWnARSW8jmPFSuZQUAtUrzAKyFg/JIySrN55yny0cuh12ozjxy1kKcqZLMBwye/MahxDQPvWlxnB0lP+PWBZTbATRRxqmS0MKiXYW5ES0g7AMl2jrmdKMBegEpOEUAAHEHJBQNo8kLyMUBBwtO2xMorQNzj0jIQyl0GcqYvKwDhyEnAI080P5Kya4pFQ/JGXCLGOE/okHegvNruMvVq/leIw/5MqqCXXJEXi5dqpGXT3HlGkUZR+RBYa5Mjc6mxAOFu1OKeiWHPWRsvDKfsvCx0SPyXjtPtxfPm59mNgIkHdedkufkhenBd0ypNnnL6dIf5K1OraAkxa0STfJA3i7nqIFQcgXU0tNu/9f9/eRqPy3n5+bXyaD/11Yyrl4+nX2YfH4cf7inHCTxu83KJUIUCeXgGQMIy5twNgeJ24UZuUm5ZX7AKVZvOaI8cAGxOGoDO+WBToNfRNP05ZFXiAiWRAupzoM1ddPm86XpXZRfcp62rxlNszS52R4fX41PTykPwb7gW/qUr9gHGVM+sIZFMmM41IYfTU0sGvKcG/LIES366VKd90a0CNbCSyU3oFaLZWdydDk57lIB568NnAVwrGd6n0ioAIJx50KXZWYWQLMejKMXJ1SAsRgDgOxRAVHzyCOrMyJVNU0TNBqioPCfGxQpbJSd8YejyfHJ848fVKg4z6efoOcFMKWvRJNLbQSFKIAm14gIzIpN6RWgQZESP9mInZCKmAzaaIUioSLaf5d73Ud+6c64iWBR31SEdHSU/J25q1vPJa6DwZiKaAgJ72AsRERFVH3H9EDcgIbaZZ2uUhFsY/ECATm9RXAu4rC0dT1W9Km4wt5C6LhfFNecaUv3EQrSy80oJt/VzzUlYvIxxrACg7N75EPq7WQod1B5skf+wuycUbfyEJOPGaYOUoguokMHisjHGKP7Aapaycjk2odu1gECrHFMPppiHU3RB/9Peb9pJ//5DfHAt/or8kvWM4xlEflL/17kOCoiJTr/rHOfiYLXnG0la9ar144JSk/Dctu0Mh/4NMzkgYC1x0GaoirToA1y+aG9RDySvzx7kHMgEvJX0ly/ZzuH5GNKgswxUS/If+NgKhA9ZA7wAMi6mQax1CAfnbnBfGXtxYs+lQByjfPHDCgB5G0zHgCV7jTlJWC8bVsuTyslDKkf+WHa1jAXlABwzQzNaYcugepo5ew5xJlKxReDI5WgGB+RVFuCVCo7XLz71ijSW0PkeEDKtvEgWlRan/V+MDvV0xIEo2P4JWMqI/J9XCTrVEZo32BVjqicNkF5AsdtJ6YygtrHsCMaM3ZsUyrn0hmAxwIYKbAR0ZsqRBnEPXnhWtlPy+QzDxYIcxz/5KpJ5wHW9zJiP+JNkR1QYiqDWvsASyRoOrL29L4z+d8Q9C2DYCdmO77nIiqDPftIjehQeS19ACAY7l9jitClCk+ogux0lWxyoBimqQKYkC1OKPO7gr4x5FWmUgXI9EzqKjAZ8ZjLMAcwgSbMdGrAF606AYaWBxyUjanYBkY5Mf8zKyIK0DUT9oBbS4CGkswIN8ZLCtBZ7my7PTEBBblZIf7CwdUoQItJ2PRXE1OAoTWx31b6M86IEoNzYTQHPG8ZiAIj2D3sBvINCkDyO97ShjF8Ke1QMIWdtUV+pMA0sh2eDiIKMNM/pNpDQSVtBjXMNUHgjHeOXuYhTG+tQhPg5pH9BLpT8hsFGPmZQOJ2+m0qIwre2GSBWUNbK6xvuBl98M56sq496QPAlMkhiqpq+i/4o78wN/AXNB4hr/KcQuD5aKZgeD9kwl2gpYvEgB6ix2xCBHGzp28eKXFDIfBDg6QQAD3Ch9kaC1F2Ve4XbQx6YdGZnFxbmW4YFoRoHFXTGeXZi186QnSNTdbBbTOBhKB8FSGJLoWV9GxMYWijRnQbfP0WYkIa99hhSPhbPMiIwlVnvHs1Sf5PIZIIeyzLzLhlFEbTfBxgAqjRMjjctFPzBi2j0BqMLT7pxnvVp7N3mF2WARBUcVrKy7DQVOIe08JsilaA1B5ihBrH44HADSsvJpRP7Jl5HO/W1GY88wGhe9FoWqQr5heMHZhjrtOKZydQeWMmMTGYOZG3v+X8+2uA8JVdYbavQFH2lPzA4b+lVYwXTe47mLc44wec5xqtBs70B6I3KMmYK+mY1iAUul7PkIk104FbmHNkldYzuqamX/u07jvTxikatI4hEY4quafkoRIt+nsAygVLcHkTAAA=",
+	"ko": "H4sIAAAAAAAA/3RX31Ii6ZO9znwKLncv5iEAEREKaQq19WkQS6MacNT4QYvd4GJYAnbUbldL9fyKWYx5HzK/d9g4X5WtsxETwQX1/cnKP+eczMoXST/H6q31bJRTL+T8FunQl0tPJmvOl0iHntxd6M2VfE9kFppuwvltrJqBt4na0vO1G5hTj/Nl0mFgetEmauckGsgfbekFnK+QDn9u/u1bezXSYR+bPV+HHucdWJLHWJ5+Le2RDm83y4U938z2zc3C9CLpjDjv2qWngcxCXSU57YTybWFvtkhHAbzpwlO7tP9uaerrZP26cWitPCwkGnD+I+kolLu2/Guc0+lYLj3OH+MAHh5jiQY6ifVuwIU8yTLWbpA6mzM3gTzG+hxvllNZefCvUCDE9zzVSSyXnnYDLmyRRE+bpC2TtVxE2htzoUTyvNgkkd5ccWGbkKzH2HQC6YxMv6/nfS6USf7wkeLU5cKONYwoEi5U7JWHUAYDLuySPE/l9BMXaqSnExx7jM3ZQKa+fE+44JA8e/I/AepRqJMkfXlYSGekk5gLeyRLpEVWnn3NBxIvkItxmg+ZrnK6irGf9LnQtJcna537XHDhkBmOBA8tOAQcFA5tjpKp3rZtQo4QKkJ/WNhsHNvnWagzn4t50j+vpDOCa8Ui6csAv27wWofiFunL02Y5/c10FnoaaCfk4jZpcK3D21wKQ9R0Fec2y5/mdrD5+d9cLL9eyry9u5JVn4s7pN1Ax7YoxQpeZrqJDABVoOEx5mKV9K/7Xy+vka6mMvW56BCy8DSQh5CLdbzfvmiP9GUs96/JKzZhFHg7tWVbxVzcJ/1rCpgVD6yNZYxiPcZyEXHxkEw7lskaBS9+JHMS4lo3kDloBT4Wj8h0AjPw5H5s/T4mfY71ZcBbJZLLM52seWuXdN5G/nsRb1VJLmKZ++Yk5C2HQOXvCQC7inlr72/P73K2BcD3Efgs5FKR9OZKXyIcfoy5VLLP3cCcTzOelsoEUsyvoQilHVJvrJ0QaJisudTE+VQuMviWXCTf/D4CfEst7JtepKPADNZI3XaFzLCdgo63dwnL8zZvV8lchuYklrvxO3puOyTfE6TrfixeoL2xtbFH5veR3L8daxIyd3eNzJXzBDbFn7hcIB2doIDlLdr8OwFPUgiWS6T3kc7bMFfefrsNHoCuQ0vycpk2P2Kdt7m8Y41irWKLkPTlPjBexOWaNT2LspjKDm2iqwwo5Tptkkh6PpcbtFmu5V+BPCzMwOPyB9LpiVx6udcDzdROaGNo4bQ568v8CgJZ3qdN3OfyYWYO5juhfom4fESbqI0SpS7vVMncXujL03+k2M2Z7kqWnvn8SafDzc/wP3mnTjpayDUM4107TYvHe6QWpRp6vNOyqjiJTS/inX0yNxNEPwu5skU6SQCXX9Wo2OYBzbWl40rNIqYbwP7NiCsOyXxhm06lnt3myl5WGiQ8XdPP1zntTeS7BUTlA6zIZA14V5r24a7NFTfzTLuZknOlhU1z6mcI3C2RTtuwseuQ3l3Jk5X1Vcy7e6RfoJnSW/Bug+DzMuFqiXTdltMrrpZBQnmMAYFukHW86g7pn54sYxkMgJdqxZ6ahWgYvTFXHaiBfFvI/ZirdVIv0UliuonpBJpMc9DZlRWjaoPkjxMzHHO1SdJvm+FYvq8B0Ooh5EO/LhBKN+HqEXxC0EhdKlPVYwiL3l2ZyVXmWi1PyLLtfVwrEBAeDeRszbXimx/ysMhqVasQ2Hrnm7Mr7frmtA2a1qrgLLT/7lpXMdea1urEKlia1JoL23reN+dTrrWsFR87mUzU9kkenvTMzzrcSci1A1iB8qZcqB3RW/tx8pQmDArnFO1DZ2Qftki+WUGKBuyUSL6F4IEXgB33Y3a2be+b+xgXTn+wUyaZ+9ILQINuAOl9jNnZwaqe/ZIIp2oX1m1YTn12agR6zUJ2rMzo5zZanFMn+fayWS7Y2bN3VrGOgn+kk2NrChdsptBuszd+wG142QPBgWOnaQOdheYUExU7rg2vm6iXpLlip4XwzWmbnf3ssJ6hU7JzgB20saTPzqH1fbLWscfOR5KnIUqM/B3ZHcwPcVZ15xiW9A6zC9yo5wmt+PtrZepFkk+xrtaAz6/01EuEf3Y04vo2yVliLl5A43oZ92F//jq11Cv28ApNbrNcc732t/GC63u4jyHKQpzrDeyb/oDrTWvsSyQPC67vWzNf7LxUP7Zuzf1MWPYcTHky73MjT6bfRwxznxsl2w0eFtz4f0JuLu1A80upGmVcM9cJ6vQpzoS3sUNm6AHQwzY3qvZI55X7jRrBShpEwwH00LFuruQxztnWFMv9D240CWbtMnrnLASUGy6ZPqYzawxUy+m8vfkZcqOFdofDfriJfG4c2pOTNSS9cQQf0kQiVx/y4D0g8xhzswQW6dj259GCm3uEIQ8MsFVruuA9NC6tbXOf5L/CLAHNQ0IJbj30QDdPaQ9JZQ2TW3rFLZCeD+R+LN/CV/q4mZzEepawu0XqjyCibgnKoV8XchGzWybt/dhE7TQ0dnfeSeFNCHB1RuxWCNp9P4a4pER0d2FFonE60aav/C2lo34NATm3+norGkBWhx67NUJYN+hjSMlowa5D2onAulkocNSKsXgBcuzukZ7bhppC1m3aKGahdBbsuiSdxWtULdLTE+OH8jTIAVEzDPPm9xG7B6Q3I+3gWyGbmdyPpL0pZC4luxezewTPMma4xwhOb9sWyBZHrTxlAxNwgWJgdOyMuFUk40XmJLSTsdV//d/g3Zjc2iKNFtZGmcz5dLOccmuHzOkYTaS1S1D0efsNv60qjunah6B/ibhVI7m8xTfWtwV43XIIcVrFzj7Osot1Ml2IFBppa4/M+c9N1OZW03rYCbjVyoLAONILMLQB+dHA+nRgzUZjkLp1iF4H3raOyZx66Mlp4ffzpF8Qb9ZuOiPeL2NpEyErvO9ClxHa/hGW5WGRcWL/GM868+V5+hbtQd5+qvQi+8XYs0k5eN8IV756kNm3YXAw4gN8pE0xXvojvbGD7UH53Xgiz57Ovb/NOAeVzLF/2q/DJN7YWfDBPrwSHyaMH/LhNuktIKrd4DdznSBTndFriQ9devvW/VjFaAHYLmM+KpGOfHka8VELrUW/DDCUH0PNF+8/kfgYg880o/PxIen8SqKBJH39uuD/GwBF8JmeCxAAAA==",
+	"nl": "H4sIAAAAAAAA/2xXPXLjSLK2M09B8z1DhwBAEgTxQzYBUi16SSIbTBGo0hSqpJGs57xD7Ak2JtoYby+gm+xJNhJkd09MrAWgKit/vvryB1EC0XhmM4o1GM0hMq11jjBawIEdG+lankWOTjKeLzxbDOLIs8FoCdG37kJGRk8GoxQi46ULNGMzi8mdQksYZRCZLkjfE0YFRP2JjHx+x6iEyA18e9+ojFWJHUSuY+PltlFDNLCTK5EZH2oaLGHUwMaOno2T5ytGe4jC6B31k/wjRC6cCKOv8Pn/PZkWoyNEH+xOLO3HMxmMI4jtqNrVyxW7D+7sqxjCOAZ1mlo7YjyHmEzXU8vjBeMFxNx3aiFeQhzcVQzNljRajFOIQ9+Rk2fGeAUxXRyLwThTuWBawXgNMRtdK6AmMf4hJucvn3/0PLxjXELMblCo4gpiFwwLxhuIbS+vQhh/gYScTNjPKm7ZTWHFO4gdfcgUdlyrXRro3//3jxHjBuJL0BuJHyG2fnwjje5JYyAXRoyP+iofjEkECRlqCZMEEnu2I4uqZ4PJHBJrOvuQixkvNBImS0jYeEfUP0Tf7pfCsx2/hFMvfMUkvR9Rzz7oVfqeMVnB8U38ePc7ySB7tdZdw+gxySGx9vrLZgHJRXrBpIScBnZWFytdNITJBhLb2+EkhMkOEjt6mu3kTJjsIdFbTw6QE728smsVluQRkuDo859kMfkKycXJ6Ae6x4jJEyTvLwpIcoRmfObzRU/NFzAP4kf1CedrmD/LyQYvOM9hzoYHclc2OC9hbgcxan+++fn+N0jmR4j6jid2LBJYnAO11uFiAYvRTxYWKSy69xfPuFjBI4+ee3m+8qymCznCxQ4WTrxjwkUN9QsZ1dTAwl/Evqi/ywyWYiZdyzUs5VlwmcOS+quu/cR2WUIpZ2cNj0K43MCS2H3+ix0ud7B0ZK5TPqURpHSyBtP4Z/LPcmvkx/4cUscTZdIFpGzdlBbpctIxPqThXdmWppAGdmbkd0xXkF6mxQxSOTnqPTlMC0j1ftU9TEtIabrYtII0iGHGdAtpoJZ7G14Y0y+w+C2Qt06Uf5MMYbqD1Alff6hp9IjngXrCdK8fA6aPd40PsYwjBUyf4O7lKoeVNd3Vmm5WR7vZK5nZjWurSnfa4GjE1Q5yZ8lrnKsGVvT5pxdc7VXinvjZHDLTTth+fsdsAdmd7lkB2ejo83uPWQnZ2PPMfpuVZDCr9IgQZhuInfhxpp9Tgd2cmch4dk403jBg9gUyR1fMdvo0mNWQrW8MzRrI/K36rRewZqeQr0tY00DKzfUG1ta1t6K73sKaXshgvoCcjRDmKeTiOvnQ7XwFCQ0n2z4T5pluyIm8YF5CYgfr2GBe3WrYLBfvRy2hFb/KiPkWKmtd+5Bb5Wq+g2OQn1+PkFt+Y/GYP2mKykDmJzPzI+T0QefL1EWKCAqyIxYxFHIiYw0Wyd1mEc5CWGRQCJ8vns3otdYWOdROZgWZK2Gx03PsVLCGgkfrLxaLBgrxNryxwWIPRfidh1NwHRYHKPiWicWTnlQcyghKcvZ6tVgmUFpDZ4vlHErbt/Q6SSx02bPhzlksl/fCXpLzYrBMoaSWOhqv5LBcqbbxQn3/M+Yyh5LO3NrpWsoCSuoFyxLKdzIDudn/xOIG+l8sKzXU2emCy42eovA3spZ37O+VoyQnZNTIFzWsrfS3wFjuoKTgxN+oUNaq2I/sHHksG/XAE5b7u5SEEcuDrrbyqsoe9Z3eBMuvUPLvopA86RrLOCk8Qmk/NIvVWhVBRcMNziqBSji8PSTU/wi5WkAlHTusllBZ9832V6zS25oQVhlUciZHXSCsCvjV+KrNFOwbd2yw2kLFL9RjtYOKggtY7aEStX+82zwyK+a4KWEzkMFtBFsyNBBuF7BlF3D7o3Ztbf9+T+FtClt6sUwPNy33erNdwVJ6eZFnxXebw5aut+FnW8DW9rpY3rmwFXaONUNK+S1wbw1ud7AN7LzVtmVxW8OWeh69PGvX6Pgk3KqKBrbW+dBRj9tHlaGA2yfY3vB4xy8RfCEtorsF7D7/CEYHt90GdpbvE9WuhprdRNXdHnbhVit2j7B7I9MS1hHUZLmVh2mu+/yOdQw19Xaw5tcMUCdQ8/v5wr1GVs+httySwXoBx7fJ1TqFWkxHL9Yx1iv98A8r7tkQ1hnUvX29OVSvoX4RP55YxztFZU1mVtK7KslV8I2u2iXrAmpFjmYFW8NYK54q6cRYrCuoNemox3oDtR1ula/eQR2cGBoY6/pWen742miks8YOn3+o2a0Tc5YXxvoAi35WU/86teT66835ksjpcFs/Qf3uJuVHqN901FIImwgaHSPIzFqaJcFcCJsEmuCu44PqT0j+OkY1cx0tiFpsUmhsZ7FZQXOhu7Y1NNR+PMudRU0Ojb1yTwGbYhpzHxoZrMOmnEzo7d4EK2jCnavNBhrtRNjsJiFFsWnUTSMttepUY0+kpg/QhFfqAzaP0JC8qaYjNGQ+SJvBPoINXx19/mkY9ylsuJvYsq//8iNQ+2n43z/B3oWJjvsjbPjjxPcgDhEcyN9mIU8tHn6U74OYMxuvDrU8u40RYnjEg/5oGP4I3BMe0ls/5FlJ1LX8q1Ecsr/8DfyX7QoOwt7QgIc9HMgE8gEfl/BIfS9Tr1oGHwzhYw23f4mvOeR2tK8Wn7R3DmzwSSvhu/We8RjdmHQbdvFYwlHLG+HxEY4ynOj0xvifAQB622q7PQ0AAA==",
+	"pl": "H4sIAAAAAAAA/1xXTZajyBFeR5yC5cxiDgFIQhIkogUqdWkXEjkoBWSWE7J5aGXX8xyiZw7g53V72btqXcQn8QukmqnnVaHIyPj94ssoP4T92L2Qt9dKHs3Q9T9+e1FSK0J/Br4ujSX053C4yFKb09Vo6c1bZakfPd/SsauVRH8B/q8VadX1pNGPwNe9qhx5ygvIHl1J6K/A15VTTUPoJ+A3R5p8CPBte3e3YQ3D51vwbSV1P2pCP4ecWkOe30o71nT7591lAb7ressXd/dPavjHHnzrjoT+5ymx0fMb0uV1unMA/yrtUV7K23fSGPgQmNsfWnGgS2lPsjKD0oRBABw3labDYAYB6aqhUnZXDOYQyKZShMECAmdrpclbUGcwiCBwt9eKOKRgCQGdLV00BivWc7pUGKwhkFppDBLISen+l4Bsf377dyPbEQMBgbStK0cMUgis01JhsIHANGpgm58gVaW0nM7oxWRJ3asfbCGwdB05/SBnx8TmCgjOjvsR7CEwfTcQZ/YMgaLbq7Hu9gcGB85GXSWGPsSkqSQMw0fdYlObzgwSwxnMZGtq7vnpqsnbyhd3bFRNXmx0RRgu4C/R7XdrytoMhn59bxdhGAGrGgyXkF8HupymMoUrCN/+00uv/O/fv66+GGUlhvHDf2hMTRgmEJ5VIzEUEFMrrdMYpizTI4YbiE3j2iPb2kJsup7YKYY7iBkE4dOHyA5KNkbLyniZvY7Nj99K1txD6Cy9/YsMhp8fsxCY23fWS8ma8jqhM3yGcHyxGB4gvMrTecTZHFIl29OIszXMbt/V0fUKZzHMJmTPBMxMq7SqCWebP7814ewAflMpyRWYhzCvByqNxfkc5l1v+O48gnmlXnqcLyGnM1nyDnQ6m3I63MLcjr2VhPMclqq7vkwO5wXMe2VeFOFiBQulGSj8Yw0LVd6+K1zEsKCmZvmICwFC1dZoeb0QLjaPqm+G01XiYgsLS/p0IYx8iOhoNEYB7JVsavICO/aTy2gGkZUTbKI5RNaxqWgBkbsQzwWbcNz/KILISas7OWK0hOjMWIxWEKmjpaYni1EymXrEHAmIaGprlEI0KC0JowyigUrZuBfC6NND7G3fvg1a1WYgjLZsYwq6YN1ettQQRjuIHLUY7d/vBKrryGH0/IgUlzHkW99bGl3VRlfeTxO+fsZlCkujS2epw+UWwrOxA7GDZQFLUr3C5Q72P75WdsTVDFa6fNRzNYeVfSSzSmB1tSQbXIkHwARpXKWsrySuNsAVHS88zV4h7dgbq1zrbU6StPNWuryfVQZXn2BlqcbVlv9qXOWw6t79FLC/vRrG5noOa2m52msBa2rpUhOuN7A2tpw6t85gTS8T2OI5xBPE4whiZas7icdLHrejYabEeMUn6ki9wlhAbFpjR4zTO4t5ser7zlNeKr+oDuMMYmMlednbt9urNidNGG/fZeb26kqtuF3xHmI3yEuP8TPEdGlJjxgfIKYrnc5TFIkPCZkOkwASdWRB+PCZuJMiTFaQKHk691J3vVQakxhyq7yEdE2YbPnWNGdJDonsTH82mBSQqH4gTHaQuLqT7dHZCpMnuP3DTPJnvqYIhQ+CrKkNihCE0cRfMxDm9loSU7KYQ3glq0319s0SisWD1gXZXmkUEQgqqaKuJoti+RgxQbY7U9MQihgEnWQ59UEkIKhRKASIC+mWvJ8CZVv6GUXK3ivDBC82E1IF1eT+hKnIOFBFevyr6BLFJ5b240RBYguCnJX3wRU5W+w7aS31KAr23BOK3aSkeuU6FE8slaUaRhR7/qZBofgMQtbdWKN4ZtmEdnEAYa48sDWmPqTUTvVLQ0jNQF7MZqYcU+bMSlpMF5Aa+6tpakyju4yPV5CqmixVjjBNYGke0E43rD5IfnjTDFL5Qg2mW0jJWYfpDlLlJKaHu7+DfFzbCNi0pDHzISNNLWE2h0xah9kCMtOoaVg/8FQWQUYvjn6Z7DyIJ1vCQjXqRekRsxgyqu8jkiVsg+ktE4/GZ0paKz3lCfU3x68NZlvIjO2NHWuDWQ7v401eRo3s+vGxz2TFpOeqaYvJ9pBRQw6zZ8i4HgNd8BO/0cyV2zlspdPKaNxuYOtax7Xd5pBLy3Xf7mBrugvhdg/bgXRJmPvA25oiLyc30QlhHjwAmVNjWqMJ8xBy2V1lIzGfQe5K0pjP+cWWTHp5BLnSFb04i/nyQWW33wf142svL95SNlKPmK8gv72aYeKUfA35F2qOZEtPeWvSnqBRaszju9JEpnkCOReOvEQaLTHncrKmVdpgnkIutayowXwDuWmnCuVbyJ1VmlrM83uoH8hlxLx4JPceX2W8wrTUXXnZizv147fb9x9fT4T5E+TU3N/g/DNn2HuCyPYc5jPkI/NHfoDc0VVh4UNhp/57JXmh02fCIoTC2brzlBeSOpkOixkTQ4lFBIWpDBZLKOjyQGWxhoLK2/fxgaMihsLUkttdJFCo1lhv392feyzEZJtX5LtyCoWbYIvFBopp/yq2rMOVLAoo7KhVSVzuwhyJnT9B4b5Q47DYcxgDmzlAQfo6kcHOh11tiRffXQS7agLMLoe8Z0L5sPjj7hl21k1g3B1gdz3KRwpPPuypH2v+fGfoJ6VPUvee8vhhp3LUIz7NYS+1vDrZED5FH9+9e7dmVyUHxSvI0wr+T+RxQG/fhg8hjaczPqW8l/SMhKcdPJF21DvcL2BPTaO4KQvXO024f/wngZ9jXhXNYPCZ38lWanwuQNDF9D3hwf+wM/4FKXnxfN5oFR4EHJjrCA97OKj2SMdB4v8GAEe9iIRJDQAA",
+	"pt": "H4sIAAAAAAAA/1xXQXLbuBJdd5+Cy5mqn0OQFEVRIimapORYu5aIUEhIwAEIz8S7OcA/hJPFlKfKq8zfzBYX+9WU7KRmB0GN7kb3ew/NMIZsOFPQiSC0J6Gs/6YxXECoOm0MYZhAMkpDnbaB/8PQUdhgp2SnLYZLCD+InpS003wqhVBN/qV3FIggInN0HWGYQah6J4eBMMwhHI7+q5KEYQGhGf1f83rLNpotaghNL9QkFWHYQEOjpiAchZEn4q0W/B/OToZP7SDkpX8a+NcthMYdCcP3841sEA6kOgwPED4KcyT5kZOMQoj8d6sk57gS5lH0+oGDRRFwynxRjBYQkeoH6oQ9Y5RA5J+HXp4IoyVEznx2UlGwJKsxSiFyQ++fOKNoBRGdjZAKo4wNneokRmuIhOK9HBr/TXNpJj3oUTiMCoiEGV1HFqMSIuOUkBhtIdKDf3lgnzdQkX+xwgYRyd+1DWIy8ijUmROtITJk5YBRw6FpZD8tRG7uSHQLkZ6s48pFdxBJMWhj/D/WsuMDRGKQjwLjEGJS1PknjONr8WJ90jb4ZSPEIFX/K8YLiLXqdfAu2Ehlz2QJ4yXU4t7/cxzkiYJYqMnod+GHa6vi9O1EZOjxkR7kMAiMV9A4/+L/JIwziLWdKOh0UJD5IEeMN2/x9SeMc4jPkg8VENNIxv9PWIxL3uUIW4j14P8ej5Iwrq/Oau5TvIOYwRDvIaajDvbCdALjW4idIf8naYwvMAnis5F24rrFd+z33giMD9Cezv75s5OEiwTCQYykzoSLNSw+yqObJC42sJCKkzoRLgpY6FEqDr3Y/lyV121FuDgwtv0zozWJIfnsqNMGkwQSO/m/mQhJCkkvJ43JChoiQ8H2JDuhJhowqSExcjKC7RpI7P2cUtJCMkn//V4SLjNYSjX4r6rjX2tYyo8Sl681LWhgqFtcFlDIk9HKPzMSlturwZKMFrisYWlIcYfSEFI6MpTSCGohlb6QH9MFpIYxQ5gmkAr/3fSSMF1C6iQxOQypk7CEaQqpE0ZZ8QXTFaSMxTSDVB4NDRMZTHNIjRZvaacFpP7r3NO0ZHfKP2NaQeqoo8HdC0xvrtsB13DSRtKAaQ2p8c8nPtay8SRGGgjTHf8YMb29HnoXSWvJYXp3zRVXG1hp1Qcbrfr/BHWYBB0FF4itSv6rc4YsrmqIjfZPHGLVworkJHG1g5VTPbM/W0CmuteiZglkhhWIMMshs4bEgFnxprYFKcxK8P+dL51toRXGyMl/N1IHEa++KnnSzI3tSZDSwWx60pjdQGbosxOY1ZAZ/w2zBjL7Wr+shWy6aOI6gbUwXPl1AWsaifG53sJam+6iwusK1nTPDd4kcOMucrxJ4cZJ07ursG9WzL6j/ki4yea/5JEmiZsCYj1qIyxuyou0xUwm//2B1yIo/fODtLipINaMW75Mqc0kcFP/tNW4ATe3sHG/kZxwc3dFY0xfRlK4OUBMj/T5NZs8hJy0xTyC3L8cSWnMY2hITRTk/h/uTp5BLsXpPAllJ1bjfAONkUFO6hNhXkMuj/6Ze5Y3kAurJ415C7mc3KUs+Q5y97sYj870GvM95OLK0PxujioJixAKMoZ1EosYCv+3opPGYgGFHjr/xOJdJFBoNQkleqOxWF6KVJCZpDprLFIoqKOe7IkMFqvrxQsy9kzDgMUGCjqJ7hK6yKGgQWLB7CU1kgl+iaQZ55R/xaLkWL3/zp0vtnyS3L/gXFQ/QjBN7Y+OFDd8nemiYUUNBbkrCAmLhl1PVhhDExYt5zERFrvZyr+cpMZiz7udfCCLxS2vyUks3kPhn3+XXJg73vRPTI7iAIX2f9J4lIzjMoSSxktZyxhK/UBBTMPrxcsESv/SC4Plcs4/KLX5oIdPWKZQyv7SyjKDUp7I+KfeEZY5rPSFfuUWSm2c6AnLCkpxTwOWNZTkjMNyB6XkFA6XsAfxyqNtAdvRf8MqhIoUjf4JqwQqYRxWS6j0IC9M/yF1VQoV3Tt6N3u6Kle1gqUc5P0svNUGqh9IrnJ2c7ljVVywUYnOMHUKLswwW9VQaTPpoOYqVs3PQmGDexqEnaTSFqt2NnQ9DVjdQsUdwOoOKjLUO5J4w+88S26dQC2ckuy+3kKtr3NY3UDjnw0jt95BfR0U6luo3VzJJoTQ+KejpKAh18mJsImuiGpo0CM7bGJohDydxSAsNgtoXDdvJ/zuPzM9mxQaqXq6d4awWV25uxKDUIRNBokd9MMlo2YNzQMNRzJdIII1qaCgL0Jhs7laPc1vdJNDw+AMcqEJm4JdzhhXGpsSGiYgDdhsodHjRRubGhpn+AUX2DTXLF/VqGkv3Wj16J8DEVTGv6iTvBfY7CEZgoaGh/nxbt7zVaagIDIT53UHjX9hODYHaBw9ylc4tSG0szh+0/Pz4vjxbl/HrdaZTzYQQUyS9aRdQHymTmCbQqt7je0KWvrhaw0tdR/lG5LaDbT6k+CGtzm0ctTmXS7sJLAtoHXmpEfxOqa3JbRO+RfmYbuFVquesK3ZbK5l23KiSnbEJW/1kTj+Hlr3QIPD9pYz+Y0UtgdoST3O8oO7EHYnc12nsOtnwOx4Tpl4pn79aNjdwc64GY67A+wej+LtEvsQYo4quAt7mniM1LiPL63Yy5NQkwhEcBk8Zj7tE9gLJR6dGAj36bWYe8mfEPbHK8qW2b/+fPumsLgvYS/FpPw33O9gT8rR5PB2Cbc0DJLbsnSTU4S31w8SfL+Bjbb6QeNdApn/axQK71gWv+hpEngIwf8xz8GvgDoUcLgONYdbOMjx6J+OTuD/BwCT/wZ5fw0AAA==",
+	"ro": "H4sIAAAAAAAA/2RXTXbbSq4eA6vg8N5B7h5IiqJk/ogRKTnWDBIRCnGxyq9YpVx79M7JeYu4L7NM+tx578AZZB29kj5F2Yn79MSmiijUhw8fUGCcwlpPXlEUTyfWkxiN8QJi3RtrCeMMslEsOVYcxZaOHO20OMZ4CfHHgbRMjjTGOcTayeAp+vGXRAnZo+8J4zXEevCiFGFcQqyOpIUwriC2I8+Pm2BhwvstxHZg7UQTxi20NBqK4pGtnEh//4JxB7GfnA27dtdHUuHHLcTWHwnjD9dQAtTn/1Oke4wPED+xPZL0n0hjEkNiJi1XlCu2P77yYC7hxCSBgJp6M2GygIT0oKjn6YxJBgmrQQiTJSTe3oumaEmTwSSHxKuBAqRkBQmdLYnGZB3MvO4FkxtIWIe1EloS7d4lZN35+W/F4yMmFSRsx8BUUkNivWbBZAOJUXIJPt//CiglS3LkaBPi4ifGZAuJpScJFCRtOJxGmjDpIDn7kJPkFhLjps8UorsLMZD1EyaH8ChPjGkMKWnqCdMXEcwHmZOZot8KZiV6+B3TBaRGDyZ6FxWipzNNhOkStvzgj0pOFKWsnaWPr2lK85/2Ad8TXUQpxnQFmbrwj69CmK4hff6n46j/1//+tb4YsYxp8RaCuce0hPQsYWcFKY1svca0DmuaMN1AapQfj8HbFlIzOYq2ciJMd5AGMaR7SOnBq2jPtmdMbyH1lp7/QQbTF51QlJ6tTC7Qlt5BKg/WY3qAlM9CuMggZzvOgl3cwOKTHI13gosCFqR5JHsiXFSwMKPocPJi84aU19XAyOIAsRo4qCRLITt56o3FLINscia4z3LIBnlwmK2gpTNZijank/SsHanvXzDbQmbFWWbCrIX2YQaVdZA5MQ9CuFzDUnRQBuHyBpbySXD5htAlqfvwFpcVVHKyRvNT2LZ5Y8LWMC63sLSkf3wlzGPI6Wg05glseSDn1Vz6mC8gtzzrJg8cGTsIYb6E3D9SKA1L+sRP379gnkPu2eqJHzFfQX4OUszXkMvRknJkMS8ht4ZfoOcV5DTnNK8h96JDwHkDuaeelX8gzN+/rkeBR2eszAzl24DpFHZ2wdzxSIow34UfI+a3r9veJTJN5DG/e4GLqwK2f8R/tH9EK6OHqAh/gjIkFOOqhpXRvbc04WoLqTU0K3jVwYrECa52sNPXDrBewFr3L9SuM1jba1TrEtaTJVa4rl6FV5HGdR3sg+0GOrYSgvEqSqw40nKKetHR5sSkvYpmQ43r97C2dI/rbfivcd3Ceno5pYO1m/vhTQY3bAPnNxXc0EhBnDcbWBvbz8K5aeCGHmblFRkUrB8JixyK5292eP72NDf0YhWK7mj6kNtiDYVYOZITLCpIzWgsY1FfW1pUiHPT3FFrvsiERQOpCWKNeo5qY3sstm9WWt9jcQuF/8zisLj7pcGUHsdw9gEKeqLzDKSMoSQzYZlAKcewkEL78fmbdhSVPmS8XEMpfDo71pNj0VgW0FqJStL3hOU27JuLr2yh5Mm4s8Gyg1Kcn+kod1D6P3k8ejtguYeSr1VZ3oWdQljFUJE1J6xSqIymk8Fq8abWK6N6cyGsMqi8dqx5sB6r5QtOr6KKrBONVQ4V9TTQdCKL1epX5BXZ6UxKYVW8dUwn7mcsVQkVKcGqguqR9Eg2+i2Ra3f6Has64BpMyH61eZVzRScyv6RcNf9xnJDmn/mp3ocI3bWPVVuoyF9VSFi1wbeb2FpyWHUBhyOsdi9G4ies9mG1lwtjdRse6bNg9QEq/lNOWN3NS49TQHeAyjyFGj9hHUNN48xwnUJtPEUpqTlgxjqDWga2WC9fa6Y29qNR91jn11dh3xpqOZGlwRPWJfz4/+9frKg5sBszYb2B2tgLBxHXDdT8QArrLdTkrcd6B7V4xvpwPf3AHGrp+xfcVLAJSmxiaEjTSNhk0LD12CyhMUrmIn/T6ZocGnrw9G52dO012KxgKUoeRDM2BTR0fx2WmjL4mPPaVC9jQSNsLc9FVMn/eFZGY7OFxrN1JtxsBpv2Z5sIITakeAop4+C+g8ZY54e5AzS30JAij80dNFd2HvF9DO8p9NxtBtvnv70Ok952A1szPn8LWLYttGxDOrY72PqQru0tbD/P7aWNIUx/QlFLvhf3/Qu2yS9BtUaZ0WhsU2j58XRmpXjCdgGt70ljm0HruRfCNodW9EAPoYO0q5+lnCnWhO0aWmUu82TY3kB7IXUk28+03JCOKnpkjW0xW1Eo/raENlBHUclGM7aB0GBoRRtsa2hDOZLCdgOtGWd62i203oqmkbFtrxC9em1NbQctmagz4zUdjRV9kgfGdg+ZilpSl/n+bj+ESNy7isi6gOoOWgltpj1A+zkMZuHC7WLowoxBOuopSr0+E3Zvpq3O2/tr70xJTmbCbgGpUI9dDp0ZDHYr6M40OyPsbqCj/pO8CKkroDP3HDLdldDJaOw1jmxy2FUQnIcx+2pcQ+e1hLR2G+iMHgi7bbAJPHZdwKmlpyvbnTlSOH0Pnb+Q8tjdQkfyOTg6QEf6KbQe3MWwO4Whl3CXw26YUe5aaN31iyEMDBxR+HaYB3kR3N3BzvpZkrsD7J6O/BLNPp63eRWl7L5/+fFVJNqTC2OlV15wn77cN3vRJ9ZuxnmdRESzYtxnsGfNT54V4T7/RfJe7CCaf16tjPv1f799/dRg3NewF3aaRtzvYE/ak/N4u4RbUkqu6Vp65zXh7cuHCn4ooDCTuRi8y+COR9Z4F5rlo3GO8RBDPE/Iryo7VHAIbZDwcAsHGY90/Mz47wEAirMsCo0NAAA=",
+	"ru": "H4sIAAAAAAAA/5RYXXLbRhJ+Rp+Cj3HVau/AP5EUCYomSMnSaUjC9qZKiuU4TiVx7NhyvLt+8WZMEhb4A+gK31whJ9n6egYgZXsrtU/SNHp6erq//rqH1XqA/ADzCp4hxw0yJHaKBBlSeyXVRoCnyLBEbid2AiPVZoDf8BQfpHoY4Kl9iAWMak/tjP9JtaV77AwpFjaGqSCt4HsYO8FHG2NJKx3VwQIpNvaSJ/Uo2eCjt3Yl1ZD2J9juvDkuduXY0MrQaSyooedllEbU2iKxE6RY056dYo0cSQXXMNgip9qIanM7tTMq0vx4X2KwcdJTPcTGdE2qD6izoVEs1WxKs4wgv57z640ezYussMQn6kqtGuB75HbqrqIh+YF69jESvdDcu1+rBUWwYDTwU6k1VKZX59lLJPZbqTUpTRhABtJeSe0wwPc2thP1K4M5wDsYO0UutRaVGbeFZoJXq7UpM/aRnSDBim52vAEb84JIpXZEHc0Av/cCXDPaB7pxYmdIsEGCLVVDVbUTbG38lejU+jTuTCdYHeC5mojt1E413AZbqR0XfqaYu0vdd5LMfoeVnfyNSUyZ7wN8YPZgmHob26lGlRlmpmpDPQ0GNwSZMxXRlFHIbu2F1EbUiT1wa6f8mtuZndJfl40zH+PS1UupnReyFDdSrwZ44bQV2vU61zkBx8Bjbi/2YlBvuK/MZF45qOAFE2W/1SwZqR8G+BfjW0DQXiJjfT61Dz+DsyGK3mi13io46c6aR7Q+O6KIwg0MQ0qjUm8HeI85024fl4Cod3QrQ7v8c/ILfsacBWwnUu8G+M1XBrFqKnhhY7oh9V6Af9MsUqmHNMASI7RjZFLvU5JqiFdSP+Yqx8bG2OKjO3SoMhonYN8U1xgHegRzWT+hCvOaH+AZywZLJFI/pcoTXo/RQy71BwWbvUGOT9RSu3MaOaORFLe8zjl9Tuwj9aDRDFwxYluyT+MowHN8QkrOIsCk0aWk/B5ylRP4lKjLjeMvZH+VrIYyxoYH2Yk06wE+YM34auVPpNkMPMpzf3CzFeBH1jtukdiZNNsBfoLBre7J3FHXMPYREwcjzSFNkOKIqcReSTMK8DNxU96mOaLOQ6TIcauSw06Ad3q3jb1yXEDpkZOS1lI57HLFWl0rCXyNEg9D6iSaCeaJLD1H5oriPVNuZ/aigpeMoYIrQ6Ilm8rhMffyFkTTF5aHAd7xhsjsY/WuVWUeFSbIpFULiBUf6hwf7cTj0AWy1QjwA22XpdsiDhS3N07jcO8ESne5/EEriaaMtFoFfJjsVFptrv23Dv8niGhmo/edSKu3O9r3EndgSG1TlkarH/iT2JavpDVwa2aavB8zhdK6X6KGOOE1S+rYc9abGPqji5iNvEmlcuKfnbU1plZMmpTW6R0nyPwpy83G0jrjJ/Y53sFIu8s1kcp2m2FR+QbXeIo396TdLz4ttUEZO5X2MMA/1eG5J/Eraas/RjOVSnvscphh4Smq0wjwM63skEJpk4ie3Almp0fNG57lSa8TFuzw0n6HTDr9whZP7rDz7DBS5pqB0eEnLaLLmM4rxVas/HixrSjtJ9yNRDr3nU8Ga+kMi/8z6fjqu+PriLJZOXQcNckknzzuUzkKA/yh7LSiV3J0TM/pzLLE89GAOrclUXSb5LtidupqV0h1Wkp91Lrtgq1ZMaxpI91OoZdy4NEsdJXVyWucAi+k2/dTABuFkrvvva+QEGp2Kt0Bt7zCc7yR7jD4Gvex9+WekbrK5LFvSDPpKlcTWLxz5tpoWfndc/f1hixXzp29aoBf2QXsVHo1/k+mYcR79T1/f7VPGFJ7Jb2OKtlHzDCbsP7lGNTrBnjPEByoRcLZSG+o6vjIrDgDESUk9ZzQkN6I69T3m944wK/2CdY6T2+1j0ywkN4JtYxqqZWzwleuwmqAlyQJxdIauYR1SphVQ5hJ2HDrjdYATwqbrptNOCtwsPD+hYf+3gfOpN4vk7BFA+QQnYQ80CcStv3RHEewwaYI+53GL2FX1RTpyxJtYc9JN0glDAO85FSPjJitfKOUwUne3JOwX1yHU6+De3hcmDTId5wRDpz/c0+u2jPUQ2W47IuWEN4vbjDba8vhUKWY74rbnRp5T3TiY6lpg5Jw5K9iL6kt4XhvvzahlYQnpY5ywNxeSHjqZW7OkvAB14kCwHtyttNY+SIMzynj08vzPtbSrwZ4RfLl+KFa/TolmgCyD9G/uRP/fpMKKRaMlfQPC6Z7RTTYhxrqtfRbe1rOcMdJ1hpW415s0u85qevanqnshfSPKWfVslLdo6M/oCxhK8JG+kOuDLFuY+mPuUptbL+T/vmdK/yE5A4FHod8WGq5y6Aa4LWfqbcwMmhyzQzFMvjfTfm1fzfs+sKgxY1GicdU/pw8r+y7sN8XB23OGjrF4pYzEPEmg67bv9573w56lHGGvWShyCAsq+y1vWTAlAt1oNE75tw0DPCaYdAqzIsxN5dBRGuaTT0h9Z2HsP6y8yCVwYgbmNSZjbEoW8bgtDBkbCyDM11pnBZEDVZy371U/AwybDpWfoLMXrKMZXgc4A0Hc3vhQTWM9Gr6mmU4h2PuyfXBputTt8Nl0UhUpb7h60+5o8wMX+nGM1xUoxIzpR0F2ddpJvKkvbLfMor2clftnyk2AlzrkYRO1PQPGz/fRC2aYZYZqlviUqJ2UR3XmP+9gh8Zfc131KE2PZuXnTM6okVOxY899+vvDZrkPzy3YkWRRN3ddgeaK4n4ZHa4YBDYURKt2kQiRY4p+ZmgQy5R0V61xAw2Eh1TwnAxv6lEQ9qM/Q6DrURRgP/gEy+BVWU/HqPyjLecURi/tILXbi/zSLBLdOLUyGe710f0wAfPzu62kOhMP3gOic65mpdPbUZuKaNqgLd2squcA7p0oH0+Y3eQkf/tibPAW8tn6toPEi/8pJPbqYwa7G4GSxm1Ar3EArmM2vxfJzva5tcjJ+Eck96p2FGXX3JfjiyPUY9zJYHMke4fRdjeIiUk7URGIZ1nhNflD1Club5+czIZHTvjCjAZDf0+D7/RyAeB2rw/lnpB7uAvRHqVE+pw+GH8Yxmd0iIDQElmL2V07iSZdgmeeyXjaoDf9clk/C9H4xYli7Iax1q9ubYJajAtnz+8ZHwW4HcSa0kT43OauSHW70TxpMqYcW7xL1s52Z+rnnk86Zild9x/XPHbhZw0i3E+sVOyIZMnJy1Ki9H0i67O8WHXuYuv9+Sk81fbrvEeT+/JST/AM63Ama+WE31WMLaxhiGW00NemqTEicSB8B0f/gQrjJxGwd5vhw+6/icLJYpczpoBftEhj8Vx5ueHlf6WxAnivOqr8//8Kec8dG/74j14fsp1qmuDj5gjkf8OAF1eo2PBFQAA",
+	"sq": "H4sIAAAAAAAA/2xXSXbqStIeR6xCw/8fsAiJRmA1yJLANrPgKh+EmkxuKpNzD6OavTXUCt7A09oBO6mV1AmB/e6pUyOjzMhovviicTiHzXj2fc9BOCo9ntloDBcQ6sZYe//EcAnLgS055QIVRDSeO0+WgtDSUWG4gvCPE2keHWkMYwi145OnSdQefUMYbiDUJ8+9KEuhOv/ky/3TMoYZhHZQmjHcioiZJEoI7alV2rGWzwoqGgwF4aAsd6QVhjWEfnSiYPf4RT1j+Aah9Ud58j5F1Log7Ek3cnKA8KbskdpfZ9IYhRCZUbf3z9la2R/qZK6TrSiCiOyRGjNitICI9KmnRo1njJYQqf7UcidiK4i87VjTbEWjwSiGyPf9iSxjtIaIzla1GqONiHndMEYvECnNGiMJ//6pBRtnejMoj1EGkbKDF0ejHCLrtWKMthCZnq+M0SskZImPygVnIxGpm8KohMjSjXuMKjFJA40Y1RB5yUP0BpFx45WmsD4galXfG+tHxuggofDt/onzEBLS1BDO51+QJaYzI84XkBh9MrOE9XimkXC+glJd/LHnTnIb/mEFizF4Vbqxxiqcx88n4hZdp2zP13C48o/7p8X5Bub3fzkVNP/+xz83V8PyJPm26jucp5BwzzjPIKFBWa9xnkMyZWa+hcT0fjgyzktIzOhoVooDON9BMmV9vodEXTjgIG5Vf5xsvkHiLd3/IoPz92+ac5BYHs+uZ920asT5B7zyxRqcH+D+p+oYF0uIW2UH0oyLF3g/89E7xkUCC9I8kBXLiwwWZmDN08f2N3iex8LVxQHC/tQqy7icw7Lz1BiLyyUsR2c04zKG5anli8PlGio6k6WWgkLZ+6dueBBglyUsLTv5VUF1IeEtLmtYOjYXxtUGVqyfRF+9wIp/nRlX39CuqO/kFlcZZNxZo9WNcbX9+94ahasSVpb0j/snxiHEdDQa4wiyo1VOapV+K32MFxBbpUkYG09QGXtqGeMVxJ5a0hRMyoSncQyxsnpULcZriCdCxhuIWz5a6p1oSydtzwjiDGIajkwY5xB71grjQtQ21PuLwvj1cdpSIGg6Y5l6hXEpSn4yxrUIOzVQL9p28jVg/PZ4NYt4JI/xx9PR+yeuEyiLMOBgbfRpJhT2Dtc5rI1uvKUR1yUk1tAPxnUNa2LHuN7B2uup4DcL2OjmAepmCRv7jGOTwuZmSfW4yX5jXkaaHW5yecS42UKtrGWJIogsO9LcBRxsfyqRC0SING5eYWOpw00pfzVuKtiMX3Zq2DjqGV+W8H5WVoB+yeD9TAO1wsyXLbwY2wiVXwp4oYvQLllCojQTJjEkbE+P7p2spfKO5tdZbjZyw0dyjEkGiZnImOSPBjZL2I1Bc1ZBrq48YlJAYqxqhSd7Zdk7TMq/j178SU6kHq/UOkw+vuiXUDuQxuQACd2oeziShpCSGTGNIOUjaUznT7Opv//FhOkGUj47GVeOWo1pApXlWUpaQk5LeSY1l1aQqtE4g2kNKTsvMKQ7SH03quHo7QnTPaRqqsX0Q54xZiFkZE2H2Rwyo6kzmC0gM31DV8ZsCRn1AQcHxmz1dCsj61hjFkNGDZ1o7Mhitv4KMiM7nqnvMUsgo5+qEXNZKooYM6lL0gPZ4P8ibwf6f8xyMXwyPWO2fdIzo04gyYovpSrIyLIU1DhBLunJXsV192xKWQkZ+QetMKtEpxuVJYdZLbadyOyeMuxHzPZy3PBVYfYmP6eA3yFT3fjQ+DGdtjfG7ACZuUmpdpiHkNMg2OVzSKifAhQqlArzJeR8Uhbz1Xcd5Mb+YfoO8/hxx5hvIOeOLJ08YZ7C2jz5nW8hN/aqpL/kBeTqQj3mJeTkrcd8Bzl7hfkBDurx4mF1m8FWeFWEUJCmgbBYQqGsx2IFhelZCva3NlXE321F3s8KunjCYg0r7vkiXahIoPjiZ5GKDklikT0HesHKTvWQcad6o7EooTDWGZlTBovqu9KVCwrq1ejEB4VFPcn5k3CheIOCevJYfEDxQIPxVca0dMpyCaXyWha0cguln+ZTWUGl7JGx3EEpA758g9JPUGAVgmxpTEFFvmGnsIq+6FOZ3gxGYzWHSrXjWfW9wmoBlW9IY7WEyquGsYqhYn2ii7FYrZ90X6teSSOvNlD1vbnKCle9QHWl/ki2mXB4IT3LqFUaq+QhRR1jlULFytIsVUYrrDKoJjnL2mCVQ6W0OlGP1RYqMwgkVQmVt6xpYKyqh3sywx8tpaqhIhPUZlCT1cKy/sEXhdUeKuqv07it3iUGJ2ac+PMBFVvG6iAe3/jJtDqE2k75nTU0S7wmrL/Xotrb7tHvEmLZkOoF3P+kBusYanMyWK+hpvZL1QvU9OvMT7rUCdSmU5LXOoVaxvosVaNTWGcgmmUHfkjmUHvNN8Z6C7XRJ8K6FJGfjHUt/mluqAlUUJsjidk91P5Kvcf6TRy4ipID1KRvQo5dCLvO0rRD7WLYnR7+7Sqozk7990bv7p/PNfv+OeLuA3bWTwzcHWB3O6pnOPsQ9uRkF8f9V1/es/6htJsQkmlOjdTMfgl7pdXNKxnG+/gLTRXs2Z7a+6f9nnpyv/kf9+JTtY7C2SgSOexZOU0D7newJ+3JeXxbwc70PY+BClbeSeLenv814Hsi26K53j/xYwkvalAaP6T5tUa63yGEaZP9e0YdMjhIUyM8vMGBhyMdrwr/MwCc3IpfJQ0AAA==",
+	"sr": "H4sIAAAAAAAA/4xYXXrayNK+7lqFLscXWQQILDAIEyRMzGoEmkl89+X3+YiTjJNMMjOXMqBYj0HyFqq3cFZynre6G4TDmXPu6KKqun7fqlbDV/y7num5TnjJlccv9YxzLvWMC664pEZL8Usuec2VTjijRlvxH3rBOa+50Nfg5QJiCWf8oGd8j+M73nIBfj3nghqn0LHkFWfgxnX4RY0A9BI8vOIlZx5kX3GmE77VKa9xX1d45P+CN6D0QdnwrdW2AC1UfMW5TnhrLDLUcydbGcmREkNX4JFbS1AjcG1FutAv+B52fOGMt1zh7xhCqY2RVTyu0zLeuAsnoCc6hXHUeKb4d1yM8ElkMq8W7IwaU9x8Jzffcq4X+gbM1GwofsWVnnFpY/IdPPo55+LL0lrebCoXLc4kQzNqtoQmXuPqNef6ipptUHPe8Mqa2jxV/EqnOkHCoM3jb5zpGVfUDOQvpEsnxm5qdqAg07/phHMIULNrFegU/nFBzTPwSPjxf1/xF15yrue7rOq5ZKJClvSCmqEIIGs2280BlCKApeE4BwdkCuTfWP5U8TVM48IWXObxd1GMglg7tpGo4ozvuOANNSPrgWS2oGaM/1Nbis0J/q30cyQG4aDmpQtZpROdoiHM9VNHL/iOc/IbYg5kxAXfx7nie6RDkpV5v/A1bEDcbAKzk8NS8FtGCmmrvCeeCJT6SlKSkX+q+E9TtbbgShSn/rVes5855wepPdh2D1uCR1pdQDJEkzfkdxT/DXP0Qj+vpdvvonTRYRto/iJJw5VgfcG5eFTpucfrfyXveQHsgPgJ+T3F1zqF+7x8XPB+X/FfuBhxC03cUAqScPIHoCBGGfnn+F3xRqe85Vsbe38kVLSQLQDxcmyuRM/5F4r/X7KDOkTm5sc6z5+ICKKB+HJF/jOkteIfXOgPEK0LVORf4uaCH3Av+VP4kesraKZWW/EnznkrsRHKmeL/4wIghuqnVk/xW9SVDW8rxBltAG9NrlrnP9Ec/7HUtgQ7NmJvQm1f8Tu+R8QFBxJqtxW/k0BVOzxsB+BaWTfm1O4gWBlOvEbYkWnpEASG2iOoQIEhCrlkoB2hYB5q0NuOwQUwrfjB0k67ir9JARt/T8/krG+4oNMefqM//gEZT0PFH5FenUjh5Whio/oc4rtKfSQ2UvwNtnOpn0vP4vagofgNZ3wLVRQ0fx5glcfXENPvOecl7EBBU9BS/Aau75o7aAtFpzt7gtMjV3r8Bm6AAaIUBLAAMF9CkIIOzva/Ln6jVqBkYyqbgv7+ahsnCkJwZrV+CAagoJVLm59gaCgC/NI9DxQ83RUHysFNMQGRzHusYCQX20IOYqtOz02BA2QpGIMnBZBSMHlkwhN+hREvTRVcqkeR6PQwFV7yZwPZACYgRckrC5IlZyfUGSj799o1KXVGir+b8nA9TZ0YfBkqlAvqjFE0mX5dA7JuS/ECeh6VUbeteLHn6oPrDjcBO6gbPtqKPiIF1B04ZVYJppOEc15rVoTKEnex9vZycmXFK096ILdx6T4Vezjje+qO3O+SuhF+z3Y10I1xntd2jrM2sAa1hco6k0UINYKYo/rPzkGBJWvooLMhzqbpS+q1gWv7laknEwOr26q2qPU6DqtvucKSQr2u4yuw60j8e4LoQLDqeHv2Bki+TDEZcMijrDdAT4z3GfWGhmVpuyUDY7WDn95I8ZVO+ceRvwyk22E2p54gNqKwrSPpgT1TYeE7WWycs/2G4g8oXz2jfhO/xUUuqe/vV5rM4w861c9t3PpdYdS/Iftc6iso0wsI9RT/jTrwRGsJM6g/cnpzVyLUj0DLsTSgdKgf44zSXtbgtj9WuJjvZU/fyohJeEX9C3DnB3jfv3S3mHPYMA0CTL3nikIfZ/BnqEkKW+a8Adbt9q2wrfhPl4w39hkQnh5sdx/tdldw6f3yMx6eUBhANVApk71SqkMnFHasSVh1eHMsS2FPWKRZ1jXvwr6hb7ig0MwLCzMo/sT7xe63W7l/4FzF/uV6JzzfoRE0YeRWdRwKf65GMdbddNTcp86juZvkFI4MLd2DhbMgsnYhnQibnlMYW8dQQxRaVDOyUm8IK4UXzv21WY4pnDiKrHcUPsM5l1LB0lBReOk49kgYTkGr0ARmsvA9DRqKP8l5XzwDHzS71F1bJfV8DGQNKngFR2hw+ghCP6Fy9K8oPRoENVYn3jU0qQupEkRz0FdHdvvBOXhRicvdFjYYmi0MuLahwQgnibhOaTDGqdAp5zSYOj8Kz66KoprOAfoATi5p2FB8Y5f6LWc0bOMsiyoNj0/8G/tMqU+ZYQAxvItTsOzCdzhyhx2sNNiKsZiBXtCwZyTvayg87INW6fd2ag1DC6ce3+BFrhMBU9mbxC+sO8OREUrcOJJCGEagSg5FvVvV8mPTK6dhvFOiU17Vps9w4hRlOqXhpZz2+dMLemreR/BBJzRqK1lmxUsUD43OFX/Glo83gNU6ihR/kUexPY+FZ/cGG03kbGsio6iBSGQ6rY3YzH2UcEqiJpjc+7M63r2RQDye4lcSwoKiFmxJ7fSM2th/l4Aim4UogAT8QVweUHEUdQ4GxdXuQZJR1FX2ObWsjd3oDFTgvHzXwLCWXAIUSwM62FhLinp7cffaiOSlDUXIHAYT59KXOUVSIjsdSH/JFUVuEMsHBfRLJEhY2RXPGjUSz4E7wD7eUhTtx2/h1cMSQxzoyV9FSy7m3xhZTEl+4JyiC8O2qT1Uomf/MEl+av0Tii7BX+xwI5q60Mk7X7xaU9xQ/FUn++7xYCnW/FRfc0bxwXevzOOvSBygUgwHvqGLKj2juKX4L5hLcaDEvRVXFHfwG2lx+BGfGcoNFwdtG/dAr2xPok3iPiiFWZSeIF3CjFf1Qn5V+gV6wdtznVAcKmfk7iPX7oqB/GdoFJ9DPQpgBU9HVs7WaxzbyIAbQbGlBgmsOeLcBXhSRAZvCIonzllQSoqncpbXzH6WjRuK/5AnGzhRaRmNA9BWu04dS2cfvr5yj9/qhH/IzpHT+BIPNHwScBAynkLJHd9yfhDZi4bi1wIt9rlMF74tbHyaeG1foPZYeAePOdhX0EUbKsQKgLk0akYXwbHFnt/aNfXFf5r9F90jnxL/u9QANiCG6AHTaRdj0CCQwkGd0uTUUNCdM8nYN3xdENTMaBKp2jfLZz37nURwoqLLNnZ+POCAIZdmv9ALLJo0bex7+n/4pDQNzTeD/SN0OgGlEErGt7zknP49AAms8ExXFgAA",
+	"sv": "H4sIAAAAAAAA/2RWTbbquBEeV62CYTJ4G8jMNsaALeOHDPddZgXWM7qWJSJL9zbMs4VsIO+cPj3I9G2AjeVIcDvpzgghV9VX9dWPKskgmU5CT9JoTOaQ6M5YS5jksLj/tEJTJ2aJpaMYpSUnNCYLSL73Z9JycqQxKSDRTvaeZuZ0nqVkj74jTFaQ6N5LpQiTChJ1JC2DNoPEjuJx3gQZEyS2kNheaCc1YcIhGYWVA+lpoBmn0RAmLdz/OTlhrRwEJjtI/OQsqWjnBRLrj4TJN7j/Q5HuMDlAchP2SG/d7Y00pgmkZgqo0culsCfRm/cAl6YQnKbOTJjOISXdK+rEdMY0h1SoPkCkC0i9HaSm2YImg2kBqVc92fhxCSmdLUmN6SrIed1JTNeQCh3uKuB/c4EZd77/psR4xZRBKuwYiEprSK3XQmK6gdQo+S4J069QkpVHGQioRSesuv/QnbDB3y2kliYZQ095gKaRJkxbSM8+pCR9gdS46YOC9CvspbPXaYq8pIcQkrwJzBIoSVNHmGVQmsFM958U7GdzKI3uzZdS6ulME2G2gEzowDZ9/z0tVlz8UclBaMyKp0Zq6Xajd6mUwGwJ/HT+EPKG2Qpy9V3oo9DT4KdQRFkJmTHDJ2QF2VkGJQYljcJ6jVkNZUhPtoHMKDMeJWG2hcxMjmZbeSLMdlCGrGd7KOky2wvbCcxeIPOW7r+SwewbrL26/9SYvUJ2vQirMTtA+yZOQyBvnkN7nYZIzHwN8zd5NN5JnJcwJz2SHXDOYG5GqQPefPM8/z8D8wMkqhdWCod5BvnJU2cs5jnkk4v28wLy/npxQmO+hP39x+QmOpMlzLeQW+msIMw58MujT/IWcifNJZwXK1hIHc0s1rCQbxIXJSxIRdc/87ZgwORgjRZTVNrA4v7Dfn7cwsKSHmL3FAkUdDQaixS4M/ZopSMdYYs5FLHpCYscCmFsLP9iEdVDNRb+GuqqKKDwwupJXLFYQnGOlyso5NGScmSxqKCw958PvwsGBcUcFjUUXmpBWDRQeOqEMv4isPgK+fBOzlhJqn9KbIM3MUos2iDtxEiKsNiFPyMWL09jX1I5TeSxeIWng8sSlkb3g9E9Lutw7rylCZdbKK0hF8JatrAk6SQud7DTfaiP1RxWuvskcZXDykb4VQWryZJQuGKwmpSYme8zRhpXdVCIwhtIrXQuNm2cVCEY4WZyFiTCrTkJ0kH0K6wsDbjahl+NKx5sRpwWVu4x1tY5rIUNBK8ZrGmkUITrDayN7R5Fsm5gTRfSWOZQCn0lLAsope3l7TGcy2Xop6Pp3gjLVfgkj+QklgxKMxobB0pZx/lUSuemOB1r8S4nLBuoje0GEyqz3AK/fp5foPQfJB2Wr5DRdST9rLLyACXdaIjYVQIVmQmrFCp5JG00VllEqvxJElYrqKQ4nZ3QkxNSY1UCt3JWkR4Iq23QEjYIcqjEZNzZYNVCJR15obHaQeV/EePR2x6rPVTCPTqteg2aV6GRJcDImtNgkGXAjKaTQTYHZlRH74FAlodrJ7TorUG2AE5Suy+MrJMaWQGMOuppGsgiWwZr05mUeobLSmA0iM7EZLAKGCmJjAG7xvEx+0vq7Uh/RVYHmN6oMB/YBhidyCB7EDySlaRjJtjXAOGkln/3AtkWGHkr3CPZjAcjbhLWkkPWBjRHyHZRSjrpJ2T7cNvJ94e5l/CPPiSyb8DELzIQ8RrvrpMkZAdg5v5r6MuAVydQ0yhDk9YZ1FealaQ+o6tzqGUvLNaL4PV3o4YwWOvicR10VlDLE1nqPWFdwZ+erXoT9HqBdQO1uJDCegs1eeux3kEtgwOHiHoQIiZyw2AzksYmgYY0jYRNDo2wHpv/jqPGqOuzWZsCGrp4mgUjzxnTLGEhlbxcpI5eNCU0NDyao6mgMSoosliWjRTWitgBLCRAGY3NFhovrDPhyTHYcGhIiclJ/ccmj7ZbaIx1vieFzUsQJI/NKzQPTq74NYGvFEbjNoft/Tevw8613cDWj/cfMcdbDlzYYzzuYPv5am9fYPtBuiPkCXDynSRLUYqnwEmZ0Xy2IM+Ai+vpLJSKAfM5cN+RRp4DfxdW9gJ5AVzqni7GCuTLGPxSKBHEV8CVeX/sZ3wN/J3UkWwXWVmTnjEKncXLKEbxGeUV8EAdzSphtEDOgEdJK7VBXgMP/UUK+Qa4GUlJQr4F7q3UNCLnYbRMDydb4Pd/mVlrxvtvEbOx93/rk7wI5HvI1YyTeo9vK/8WgnAzRmTDq8pfgV/jOsYPwD/oJmMRtQm0NuZ71tEs8/pM2GbQejtMXyJCRvL0+/LTzqE9nanDtoDW9AbbJbRnetpaQ0vd7U0+C6gtoTWDUOSxreJ66uRoLLYs2g9b7kOuhtZrGYu03UBrdE/YbqNQmAhtG3zUsqMHz605UoDeQ+vfSXlsX6Al+RFMHaAlfSMtCXcJ7IawdRLuCtj1sUB2HHY8wd0r7KyPVbc7wO52FE+f9wnsycUlzsV1fv+YyXupT0K7iP/YAJ4ds89hL7S4eaEI98X/PHFrr/vv1j+Z26/+sLX/6WMNeylcSPd+B3vSnpzHlwW8kFLymYiFd17TU+OFw2Px/1ZCaSbzbvA1vIej0PjaAqOrcU7gIQnF81hL8cDgEIYZ4eEFDnI80vFD4H8GAAEp9oriDAAA",
+	"th": "H4sIAAAAAAAA/6RZ0W7jyg19Fr/iPvY+7EfYSqI4thyvpSSbfM3KNZDceIsWuHGzitGb2oLgrRYOdgUHHf2NPqUgh6RGthO06IthWzMczuHhIWfU8b26+lybpDZlbX7UVVKbTW0K+nNWm7Q297X5WZsUOkcePcMftXmg71ldfalNVpsSOscezjPf6ffP2vyNRpTOP09kdVObZW229D2rzRxnVV+gc6Lmn8WfFMeh0b+Teyl0Ak9cTGnqlofinzluwDzRUOvYU20qcrWETk9n3tKcNdnOaVoJnYE+zskz62tam9farKATerIbsozPlu0R5479akJLWMvj/al3jvspDYpoED5bEn52XxbqGU1e0s81dGIa2kRpThOsExeHnuGgXEZcOd4gOE8EziePwrREvKq7NiMmjAp780DBuhEzzBPhQjUlk3ccr27Ho9/qEMPVxAvnvzjzyRzjt6bBBFC367VCyyGaUHQL6CI3nwihW4WeFnyszTfoHns8g6O7YjfMErrIO0sUCq1JNCy1ecZP9GYG3UCXyMn9RBiMlqB7qg5+FwczTJtuzz4w9NtI8rxC90ydsjxPoTvwnMSbC4fdTX+VcC7JRqg2BEGbXGbNvO8O7fIZ7hCjk0IXqTphNDgR17KNjx7HCT+/OoGxAWMCEABTYeqT+IBI0ApjXbTEeOKgHLqRQvQilC5rU0AXKf1PdND8VYhzpcQhgcD9lMKFa910zuAYQ3Zu3AevGDW/44mvyCMGxfff5/uUP03xy5/wF2KTE6y3v4KPXJu6mf7Lh18c2tzLpkvwkVsF/f4qnv7e6CPrxbOT8WXDXnMLfnBwKRdZKw4sZuAjCwv5PRcyHQ4i+KiJuAEcishY3qDM2Ang998GCnExtUnAH3hUJTDISEo/FMxZzzYiNin4SMc7Ck4KPhNxShM3IqlMJPDHuvuCPCxdoMBHsZuK08gr8C8tAaa1WQitt7x38wA+kmrKqd5oVwn+p3YVbKhdSBoKV30k35RwX0gkC/CZefd1hfoDRyw4K9n8kqjxCkdndv9b0Zw5InbUt8MfaNSSd4pOJ3CEaFqdWwrFBIKj8/f5dXAayjgc3Tj1Dh16banZMbcFG9Hh0q34cMzbs7I+EaZJLTxG2m5EDrYYDBzxBY6RnjNHATJSgDlhvpZ6N8dtH491iUy6hUfHQdQSW/8WuJ/jWId/peETqacLnnGCOvzMUOwkwsmZPrurzRZO3qP9M6NG4dlNqROM1hQxZ2JnDAtzzbpy/rZ5tPdMmGZwggnwD45ndUci8Lk235BxAVZWy1crk7cQdN0OrKSctB3Y7/R5RwAntckgOFLCZxI6UsYAu7g7jbR0K+R2cGInPcuu7mVEotJPNgI1rsmX6u4RpeBUnUdVhgBjs5KsyISWkvI4Y2BnZNwS7MAesOQkuxoSDD1Hml8hGHkNpTnl7GJVbRYQfLTM3YqMrGvzKExi7tFo1+TYmsy45ARYzRKWUW7CbEpjtYLgQj1YQnDVcu8DOb4VZmOmQHCtWK0kngTaKYsGpR+mmJZkqkzmD9nGt9r8CxOuunPa31vC6zuKurl9T0TQckYJei+kSoUTKZwivi/SkD+Itv5Eip6OVd4zTk78vOfQnHLKvpD/WzhFYF6cHs7q2Sv0+OBhM3ciMtnOqR5K0tQhbpsgvYHaKDg9WC5y6IXeXgqiXkNv6C5syUKu9861v9lKH39/cKi43Nq6jHmU7CCFs0TjLSsPGc0EenyO2HKCV9Jj9yLd+ezQzvm8sJV8wqMAnLGCO6nuJugZnnfuBKUll5uz8x1xSHANk8IZZtVfcDp6tcC+AL+k0OdlbPu1Qtr2uad5lWWteMxa57w+C8RPzGdkckXgldDvadGX3hNJWUA/lGYB4bbym9GD4X5PPRU4CgewHyKEtnkqoD9yefGdm0r85zuN+3dtNtAfHxz0ZzRf/QZ9bTm4HZlD//od9bdYrcQtomEfD1tTafh+0ncHqwFWgpwqwRoGXU8OCE8iFRs0MvD3YcjFL0miAaKbc6PA0pViYJBf9L36AgMsjt9E73I3YWGAaExl+XZPMYhsT5FTbGb0+QiDWJd8dDRTWokBikLOhYtdVYXX6pLA4FLHzVsHmsG12HfLQojHggkftrFlo0YHPUog9PVZKse/BEIUoSUXCxYhLCIQHuuDlDHjA9SEi214sg+92+PxPQCEmBlLPaVIAhRCDxwN4enb7OHJmpv3fL+B0/pqeyb90UML6XCgI5DCELIqLhvN4sdoH89Ff+B4zPPyVwiHigKfVISG1vj5/1SrlqI8SV3dy/XO/12rwtE70KWOrNlW0+1d1CHtAZAaEH70DoaSBiUQjhUU7WHbnXIY6QihBjNFnOG9zSGMdSi3RhBe7NvXElNAiDmx5BsLLkjPfNsWXrnRLm2+QMjnoKU9xjhcSSC8lhmcxI5uhDeaMjMRbZufCQxRnlKSp+VuGg7xHJ7yEY6P6flheg65vqc7ZQuGJ96bkWz36jAMxMb+iWfYU1e09dAUXMNw8F/di8Dw3Gt5wFKEgg5Dricp8RQrMQzHCs5GeqcKhhhXS5GNSPQGhjcOWBb6ncXP+ai4kdCmMEL0F9o02gcw4rK84AVHhzv7CU3NG04zNykgo0AtL1i4G++c9nh0qmerXA6DC3lMXBz11ZLtBZziNkLcyY+dvY6445+RJCwEjVU7LD9Yv5ggxAVzCyMu2gsnUBO+LVXCjzA3H9hTaXXYUda2dmEcxeIrojqnLsiuW8DoymtPLWF0rdtWrtkz8Ao+Nqc75xA05rBlwhZODjz8jfkuJXOSVHJnHHm7suJm4fjC06a9ldZjdDpzTuZYliDq6CF+g3tkZdFL5R8t41H3bdFt+oBcdCyFSBsVlrOcaRJhBZ7pjT6N5XcOa4kxhggiZGZBgbuVu7wFQxid7tVi/PkiYcEkgQi1oBDH1LjAGeFtQcGXw02ntcO7FScd3wZ8lu31d2z/EEgI8qi5AX5t2MwEwbosCZ5CFGocnNLM9E0h0r6XfbfdRA4RM2XmMEXqdDRWjDPJUT4DQBTt4t+0uRGf5WZC2UrqiOKxcEzORAheIeIrO5uaM6lWe1dOEb6imImBvSaKa3YKEWbVbFfdoxslinZAbT2JkdWP4iNd+zmkl2tDhAPi926PP0sTkOCCLUa8M2WqF50QI82p6TEPEGPFImXCsCcQI32n5OgKYuTho1ztbEV1HfWM+1Y957wgh7qEGJs923dK/1B9+SDP+d0RxNwCzt1WmwNr0r3FsAWcC0xb4QPhH2NZnEu3h8IGMVJtLu9FkP8Qx14jwmzE6u5DA2OznycW6vhSF15LnlQQX3msztVvFC5WMYj56vNRqNgWywskwkoYzDd4cBHIvyZxiQEX0aHXnoyRc1t9gbzcOK+BHLW/4LdpRrL16UAsL7mPmooklxIYudO9PHDEWzOUO/83aMolILcHD/LKDbkLl1xw9Ew2k6K2YSJdBm/nwv4NCXdDzDehbQqXvXesaCz0JeJBE0PPPfdJtca3THB54TkvkCqpVUgZuDrRZ7l0KIWTtM+444bWJVxF3u4r2U+cZlPnZLuGawZvpRkD19zD613eHG463uH3QKqtN02n077bvLlSSVzKq8mnuvpcmzX8ZwBcXC8Z2B8AAA==",
+	"tr": "H4sIAAAAAAAA/2RXsZLbOBKNu7+C4W7gjyApipJIUDRJSZay1hCrgQkCOpBQFZVf7R9sdHUTXODa4Ko8yUbOJP/XFUiNPVuXEUID3f369UPLD8HvnrjqhFaeX1N3f0V/Br6qtTGEfgSBMJJ/fxGN5xs6e1ErjBSN5EagPwf/txMp0fWk0I/BV704WfIu3AvIHG1N6C/BVycrpCT0U/CNoovtpW3QZxCZlr+dXjs77awK8M1nUr1Q6Jfgt9yIhpRXUqun+CrwL7brrRkI/c20MCTdage+sUdC/xPc/ilJ1S4pScYdO4B/5eZIwxMpDHwIdKfow4KbjjcYBOBCplp3GMwgIHWSVPPvLxhEEHB5+yIawmAOgTWNUOTNqdMYxBBYeSIzQRAsIKBnwweFwdJZWlULDFYQcCUUBimUJFTvwOmfueTtgAGDgJvWQRVkEBiruMBgDYGW4jIQBh8hIUODOHsLLV1GIwZBAYHhV+GSDkrnlVqSZDCoILBjLDsIdN9dSBEGe5cCGdthcHCf4sox9CEhRTVhGEKon3Tn/ZJwLoU6/foTtXAGiVYn7X3wEqG+v1BHGM5hbXry/N9cZbzQts/WiIH3AsP4h3lg6EoXITFcwP1rdxG3L4ZjuIS5kLX4/iK8kp6FFBgmEGrdvPOZwvd/jzsMEmq5sQrDDG6/C4XhGhItdXsUA2FYQKK7nrzCFSfcQHL7diQMtxDSmXtbbmqO4Q5Ca+j2hTSGnyB8dsVqqXuje7iH5P56NPfXDsMD3H7nzUA4i8CXLSn3uYJQHG0vcJbAjJRoyTSEMwYz3QrlXM/Wb99/Q2N2gJBfaRAGoxCi5kK1NhhFEHW9dldHMbD7a3d/NRg57vT3V4eKIYwKiIzoDceodPCdx1CiCqJeDPo8EM4dksoxwkExX8FcfBY4T2BOsvk79ecMmGiMVvzqTNcwJ6P5u/0C5oZURxj7ENNRK4yDd52fGJLy/tpgPIPY8JE0cQTx7Zt5mpgfz6cb7q9XL7YDqZGlcQyx5UZ1fMB4AbHjYryEkB+5FL3rygbjFGJz+2tMBGMGMU21jTOIheIY5xBbqrnU9swx/jjC2Gvjud1OYFzA3qo3EYorZ91z1wwYb9yixXgHzvhDILqOLMZ7mELERTKSyltodfIcb73bH/evAS4yWGhVW0MdLgpY3F/NhfrJw6KCBYle4GIDjJ7eWn85g0jVD4SXEdy/GpcR4TJ15TMkJC4ZMPohs8sMFkLVj+NrCIzoXZG9hZOIdTOQsp31Kn021Ex1Wn6EpaEGl4W7nxQuHTeuD0cV3L/2owyuIlg5WRtwxWBFLTmOrtZw+5epb98UrnJY0XlkYBJBwseP2LWBOd1fr1NIycI131HfvrjdJSTCiCP1AhMGiW61cXKTZA9JS0Tfd074M34RHSY5JPbKBy/RhmNSOK6oH8sdJPbChx6TPYQ0tBMoU47JARK6UjMFkfqQku4wDSC9fTsqUpiGD5epfRKE6RJSwZ+ee666nguFaQKlEV5KqiFMC0jFkbuXIi0h5Z3unzWmFaSivzi0Md24m5uOt0drTphuIeVTc6Z7d3YgZD7MqUMWAtOKGo1sBkzLWl8IWTTqc033F2TzR2SMjHu9WAyMajpR15BBtgBGpnsmKX9myxJg1PB6dMhSYCQFMgZsINWS8X4JrGnpV2QZMH1/0XKCha0n4jJqSD9Iy94gZ2QEKXrn5KPz3Dt5QlYA0w+mIStdRn3HjaEeWeXc94TMMdsa0QvbIdu6X2txkdwg27kFXQSyT8B40zlisb37cSQ+OwDTV9fBDWY+ZNSOAGYh7LkSXkLykWoWQSY+c4PZHDJtftOyeeuLLJ62nNUSMtGQoZMlzFJ4ewExW7tTF377glkOGT+TxKyAjKyxmG0gE5Zjdpi8HvjUIGsGm7YlhbkPOSlqCfMIcm4s5u/kK9dSjG3sFCyPIaezJW+8yakI5gv3gomzUA6RPIH8jax5CrmWY345e1AhF9wY7hqDiX9YLrXCvIBcm157hWg05uV4XdcL5QW3v+SJj7NVXo1GvBFXzHeQkySL+R7yCY0BP7rnuyeDRQTF7U+rhFZYrKHQ06tVlFDeX81xCq3YQGG7gbDYQWFHOEofSmtr4ea6h1UZQKmlbvW7fixDKPnw/YVLl245g9LWztRJXDdWoIyhFOpEZ2uwXDzyXnDJFWG5hFLqy6gw5QrKC8kjmdrhsSLlMRq4wjIZjci9u2UKpYOMvJRrxbF0QDpDI5TGMoOSK34iieUaSt26dikLKK0RilosyzeheURZQXn7j/Yq3d7+dE5zc/uvehJnjuUWIumVJKdHufzkkug9RmR6F9N+vHTgWB5c2FfhSISVD9Wk+F5NXmjVM2EVQmVNM6pfSMINUj/Qq2Zw+51qrGKo9EljtYCKhumqFVT0JB7cqRKodMNdlasUKtFq8yHlXc+xYlDdvpnmx6hcZVBZZTus1lBpdSKsislEDByrykWoRE0jypU+kvO7hcpeSFqsdi6Ci7vnABWp68iWjQ+bxtCgCDcxbE4jQzY/pm/v5yww4xfJ+5Gjmz1sjB3ZuDnA7Y/rkT+y2fqwpd6N7bh9k+utUE9c9S6oaYaYGmgbwZYrfrVcEm7jn8/gVpjP4h0Rt0vwg9n//5zBVvDeVX+7gS0pS73F3Rx2JKUYizK3vVWEuxLG/xD4KXFjo1PvfQR73nKFe6d9g+57jgf/jUPTfIsHBofHUHLYwUG0RzpeOP5vAOybCG87DQAA",
+	"uk": "H4sIAAAAAAAA/4xY21IbV7B93v0VekyqQv5BNyQhjZA1IzB8jaRxsFNFGbCToeLEt8TnxG8ZCY3RBQ3veVqbT8iXnFo9ewYJ4jp5gt3q3fde3XvKVYN3dmTHdmgjTEt4hRTXWCOxIxthjbU9k3LN4BxrzJDaoR0ilnLd4B0mf9/YnzDDGjHWNirhnD9iYkf2FEsSfsaNjUi0Y8ylvGtwbp/hKuOnWv4n5YbKJ8/dhQ0Rl3j3ArEdYmJDzKixpTx3FzbCyp7Sqg4pK0yctDMpewavVN8Nkpy2T641rpBiRTl92jDElXKMMaf1UvbJdYPEDjHHUiWqE0hK+IgYN0jJFpBtmseL4gcbFMRYZdRDVWJDGiflp+RZUShm97FJ3aUUU8bmmEzXagE9WmCGL7wilbLBBVI7yjzS2Lwmnz1BcneR3VcvKhWTRw2xZmsklZrSNAI0YYbEvpBKndSEkWRE7ZlUdg0ubGiHNI7SdvAJsR0hlUqDzAzfFVOSsTdJi+0PdogEC5rZcgJsqH5GUtkjD8Mc8feOwUcGfUcvDu04088KkYqnrMycDb8epEqXOjINCRZS2XeWsXYzu54YvLWRujl8HPM31Iw5Jl/V0FcNiHGthaYyfWqJtWxv7i++274Y8GLoKrpyyCupHdsRpqQwO0fkiLDSLgopSCrHeR4iXEu1bPAm49aar1Z5TrFkJplnG5W+wZvMMFfTUxt9+9CJai27pxylf4avS3ppbV9oRmOp7hr8L3NRVO0p1qxw++xRA8QlfCAa4FbLmZYuaVvjkQ4XN1wjpqmUKtWmwWdMWSP2pKieaksv2/EOZv8Mf8EJpmx7O5Rqu8AjOoc5TQ9phlQ7Bn+q2EiqHgWwLdkHIdZS7ZIy1/gvpLrPEwsjxA0mmdK+0pg1VveH3JGBURXs1OoBWWJMkO7glZbQDIlUD8nyku4xfEil+vQBbFLaNWZMtlSPyB7hlv4cG/yJxP6gJtTqWXXS7Of2uWvb2p7BT/iiZRnasY2k1iYlh7Wax1PKRiFFra7tP6L9fxmrKcKsqMgOpV41+BlLGqxIMZR63eBnjU7qFNcbBkTrOW6R2LHUmwaX2vRssDUVfeSRmUMs9T4FDDHXqkoowDc4YekUvtQD8jyzEVLcKmW3ZfCJnmFlz7RblbqXUWf4YiPZbRt8UgBfYoVku6e3a3/XM/iNQVJkXSNhI1PgPkXQUFbMV5p4t0+trOK1PdFrjbLBa840CpNGxbAssMp6pKRQkdVcFrFGzdxxuiRFDzfqBq+1RjM7GrubKkJcu5TdcYpEDioaDXN3ruXHnEbSaGZWEOQbLaqIMHGdO2b0pdG5V5yjnqrzspt5EzS6PFMTR/yZNHrUlBUBYShkrqTxpCgOlgO7tkCJuLQtoK/+IckDFjiBiu83vEaBA5oXEkClcbhlwg5BkW1lQ2kc8TKHHz2IpdkmK0Fmyfjj6rsS3n2P8+/x4ftS3u72pTS7Od9MR1hsR9LsG/wPbWeJs6vsmTQD8sX2kmdpDgz+IETaof3R9WKrZnBCOVvF06qzju/nCSPZ6pDzWrVdMjbS8h6Awm8sVWl1nchM1L7Zqpq8ZRkuR+Z4iOxZieuYu4iF6212fFjCOyyR6P1IWk8y42IspdXP/19LyzXfltEBaeNiUdmrE0a+uJ6IZM8z+EuztmBVyt6+wS8MEGZFje/1yHNboES7TtDM9622zoW5blhzXBc7XruZI/YEabbbSJtjgPuajbgoaVLaiuwpFy47/FqTt7tul+AEeWMjzlndi94iYW3akbR7Bu+5Fqhdz5lcVkzqkKndz39m3a8f/ayIH7rBNZY2IV2jwtis3Sx+aNVxxnRNTCwc75QNfuXQsCPpVAx+VaOYoE51w4tf7UtmwJ5Jp6VM9geWBNb2hf7litVpG3xmtHZUIrsilk5f2THRJDIFHZ8aOQBSVpN0Ap7ZK5xOnQH5X2JpR9qgE3bM3YV0DsgVkysz48jZqievbPAbkUbXkSVS8aqksEti1qV4tey80t6hJq/O2ceQMmBZn6msXef3TiZS/VuL16AAApGuWnZE9+xQvKZTzfUFK+5PmD7Gbq+tbNoYs6I4vU5GXdlIPI6Gv290ytwgLn2jyDNkuX8rXjd3R18KmdP7ucgY6T30bCKP97DMaFhmbXTf3/9i7ZPcqfHGVPf6SsV0AyDUEN8Zx0LXPBN1xuIFuXen5BZvUNynRBthId5BwcPZqpXqHTpatqmJ95TnRGtCJ5t4R/ccC4eC3jFpfB66eYKldMsGbwnrbGHl6lZJoZcxA0URmwnpuhXoim5Id/cBZL7VKnnGFFB6Y4M5k97KKFoaiLPnonQ7/7L1Yy7dfbVFZ0DiHjrdHmkJ5xxW0u3zFLMHbCjdgcqxIRLpHm/4cYlkC0f3CfUsHKylVzZ477b2G8TSq/NMg0PpfWXa4717tdzPmF6D12Jds+OdQrXbC3RUSq9JcboC45b7E9aYS6+d3VxiXqBOr2OcjlP7I43yip57z1e7HSpe6qakvnG96SkohswMgSPfkVPp+ZSmqVQN843CfjS4LqUXkJ2JHNvw7qKYNr3DXExsQ+kd6YnRubugr1jIk+wFRCfsUPp1o2+Pl1jbUyzY1NLfN/hgQ45BV1F9X13juzk7D3grdWjaP8z4NXeIxS+TO9ZXJsGtWJm5PcUO7fwKmViDOojuAX+7iX0H4Av7gjG0p1/rdr9m8JEqNTd+3b2J3MrkN/gr1yG+MG9Zi+I3HzTGR0ztmcb4soRnbguei9+iCbRzWoxgf4/yuV2fZFPhjk/MtSb8L4e6WJAkfvv+erzxJPH5WLenrBOGhfMmUXBMxNdKiu/Rm4an4ucjGQnRGyvx90lhADX/4vcpM3Q3YtyI7z8cwnMsSpuRCgpdv6ukRJ14n8lghtkE4h9kbIS4+/eM/9SF1Y63B41/RPa5QxT/mKdp/uJXQ2YSlA1+Vw7XUTs0ictGyGK0ZxJUH71Uf2ewCaIl961Bt6jUjiSocRTGmEnQMOrLFVIJmvw/tpc5tkiwpxTdj+ZbDR20+UvqupX9E3RoIh+TqR1qhrgq2EgCjz9wD14WH8IKMV39LaNJsJ8JXbNJJejn9zZfp0HgIsE7DAJm6h7vTbR1UwkOyMN1iUkIJTikXE4OUtb2VILjjLLW6UHtZzIoc/9eug2augYNUq6KZh1oa3PVXNnQPs98KeEznSEMyeDI4A8ibYEgg2OKuGbhb8XvoMynG9cb93lDDjbXr1dZQWVH+rf5jHM4e1CniMR9HA3Z8ojloPEvCz2tzL5EXtlok7i9MR60zH9g+47P7M84l4OuwStF77HroIMBTWKI+flkbEM53KX/hC5u+CO6gk/6WYEfzWI59M3GB82nbfdNRDWlclTni1+LRo7ccrHQ71hcL47LD/s13cH5f/9mdOzp94PiMXp8aHCpFTxBjAmmSOT/BgBlU0rqkRYAAA==",
+	"vi": "H4sIAAAAAAAA/3xXQW/rxhE+z/yKPSZA3f9AUhIlk0vRWkq2dRtJG3KfqV1nyZUjnxoERdFbHoIc3u29vAbBC/DQos2l1qEHGvkf6i8plrITxAF6Mr0cznwz8823oyCCx6+PD+8NC5q11I0yGoMBBHpjrCUMhhB179asUMfDV44tfv7Hz+90yT53x8PrNTs+fMdmx4ePt6yojofXumS6Oj5832IwguCzsiKtmpY0BjEEulWlI7br3rKQ7MptCIMJBLp0qq4JgxSCekVaEQYcAruVWhEGU29hasJgBoEtpW6VJgzEM2hhtoZYW7nj4c2a8ePh3xgU0H1pMJhD4JrWUu1dXkJg3YowuIILd3z4QbPH133W3Z9r0hsMlhDcS7si9Yo0hgGEptHqBHcs7b0szc5HDkPw6GljGgwHEJIua9rIpsJwCOHx8FcMRxA6e6M0sRE1BsMYQleXZBVhOIaQKktKYzjxZk5vFIbnEErtz1IQ7R99edqq+1DL7R5DDqG0W1+tMIPQOi0VhlMITa123uMFRHRm1dmq+5GNu7cs9fBnEFq6VzWGwgekLTUYFhBWzncjvITQtM0d+XyuIZQ1WddguPSP6l5iFEBEmjaEUfSiXJFZm4Z9kkhZK11+itEAIqNLw85YonRTUUMYjSA6Ht7oklXdP4kV1umS5ZXCKP7F2OO7p52qa4nRGDx9vt8z8fgBowlE3U+tZJv//unNZGeUlRglv4NhbjBKIaqUd8Ahoq20xmiMMjgFvOgpitEUIlOb7UoRRjOITNMSm6k1YTSHyFMiWkBEt5ItpN1IjC4hcpa6H8hgdPVMs1h1nvZC6QqjaxDdx1uMliC6D2scDL3VwT+dw+CVWhnXKhwk8Pg1acaPD+/WFQ44DMxWaR94MP1NfX49X0JQl9ITZRjBcO1oYywOhzBsWuOnYRhDoFjkBw6HYyi67/ZMUEWWcDiDoVWtlYRDcXoTkmZZRTgsYNhWytwqwtEE8qqnv+fJ6BxG6pXC0cvyjqi+6cdixIGrtTVaNv7r6e/srJE4mkFede9uMQ4gppXRGIfwQikCXWE8gNjKnlfxEGLr7hVhPILYKdK/DvGTrxhiJ61u5B7jMcSVZ2s8gVitLNUtWYxT707qHmjMIaa+yXHmHWpJGOcQO9rI2rhbifHF0zm76j6uK+Y7+85gPIPxnqXHB4+/8B+0cks1YTz3/2wxvnz67ixUTUMO42uI3d7DGScwPh6+0SVLup90+Qf2G+aNMxgbvXGWGhzPILKGWkU4LmBMqlU4nsPY6ZLsHicDmOjNU5UnQ5hY2Wc1SWHSWJI1TvgzFTlpnGRwfPib9jkc3uBkCmn3XlesrY6Hb9npzevj4Q3bPDXhubS+C5MLmFj6HCcz/1fjRMBk/RSugElL9R7Ph3AurS/9OYdz2pKn7fkUzo3dkMbzHLLq+PCxZeHx4b3GZAiJ1HvCJIZkb8v9fa/6ydiP5a1bV4owmUCirFpRqzDhEJmtsabBJOslL1Ft2/Rim8mdajDJobDqePjSsUJ1P2pMZjDu3urn2iaXkLg7Ui0m1y84GdF+64MvIaF7uql6KGkAaffWYBpCqs5Wj1/pEtOoD526tSJMJ5Aqua5aqZtWKo1pAsIqL6c3hOkMUrXqJzMVkMrGtJXBtIBUtTvCdA6p+0JuV8bZEtMFpNR6cU6v/Wd7Qh4ApzPrJwF5BNxoWhvkA+Cm3pgdIR/6w1ZqWVqDfNQj42RbpZHHwGlDJTVrssjHL/LlZJuK6hp5ApzWctNLBU+BU62Qc+B70luy7BOujg//8aRRx8Nf9KfIM+Cetyw6Hr5FPvWfk3nBYp6/iBceH/6+ZtzfZ5qQX8AJpvrcSeQz4OSsavt7nIs+qUZaSy3ywiNqCfn8yUi5BvnCn27UTjbIL/0z3SnkV8DlF8rX6Lo/23v54Uvg5t5PuY+VBZDRVvmRzyLI5B2LqH7KPhtCpkppMRs9j01m7GemvsEsPr3yVhPI1JoslY4wS+H5+symkBGb7zHLIZO3VGM2g4ycdZjNIVM++LKPuJTUD86Uw9STLg8gJ01bwnwIubQO8xHkpt73k/1C4fIYcrp1xLynJ8nKx5BXqla3t0rLBvMEcro57VB5CiH1+HIOgpRuWa6ktbIfG+7rXxuN+QxyJ21r/CVnMBe/0Yacatm0SkvMC7+ufMMev+7emv6eyC8hp5oc5teQn6qyx4sALsir7WwIs+6D034/nE1hZrZ9j2cChLS+CbM5ZCXh7BJmd6Q3hCKAX/fDq+7Hs+6hRRG+oJMwtdkajSICIffrSta1bFAMQDgvNWL4vBv0tP1Ko4hBKF3SrbESxbiflLGspSYUExC12UkPTJyD2FG9IrvpC3Tu72LaS40i6a3oxlulIHwRiaXSaInCl9YbWqUNigyEH0mqUUxBmG2/S4oZCGeVpq1EITwJ2RPWAkT33rDCbLsPfdDcdh/1Wt1KFAsY1kxQvesvdXHlk2gZJ7Ktx3QNYu/lRSxB3NG96mlVBF4FfffZhljkdEVYvNzICmdvTtIZkVqbBosBRBVtsIihMKXBwq9X3btey7A4h4JeqSdOFQkU5kb6phcpFGpr7Fkqm1ZiwcE79lv4yTKDwmnlx7CYQmF0SVjM+t58y7Lq8QNLjod/YVF4xFpt6FT1wqzIQ1hA4XZUOywuwTNOsdR4r0soSN/3TJoHML/xqzHhPIZ52XNoLmBs6OR7fg1z63pWzpcwv1/JpywWgU/wra7Yglq19icnaV8ovZa67ZGcNo9+qhZDWEgt752sCRfxi3oulC2V/mVW/ZW5mPx/m/5nxyKDhRfWlmW0xcUcFqQdtQ4vR3BJda1OTRq51mnCSwGCtobwKoHENGZn8HoI13IrNV57pdybtpW47EWuX56XHJZe+wiXl7BU2xWt7iT+bwA0itGavw0AAA==",
+	"zh": "H4sIAAAAAAAA/2RX21raUNM+nrmX/yIAERCClIBWr6bWokBAECgpG8ENUFFrgqBCQLiZzFrJUW/hfybG+j3fd8ZkzVqze+edIRAC99dG3DyKizU9dTCwBfRYcI/qZDYwEPYOtYK9MpyjBlXzbk5zf26o84aBbT4joyQmOgYifEtUqrLepVqJXmc0P3bWawzE+IC6f4RWwEDCu2I2+Nxc2VYbAwrYVluuzz7kXU+/PvT006wvLhe0fMWACqw26TnVW3E+9i5ngIZD6pqUH2MgC2I9pcFvyr8f7vNl9+mIXmcY+Opp5ky5HnhRHvIhXfWE1pXNEwwGQEyvRdN4d4NqJXd5Tlc9qg/lcuZ+v8FgENjr1xkN2qJpYHAL6E+fipdCK3A6gmEQRoPyY6G/YHAbaH5MfUtaUzH9KWfXGIyAvbmg4qXvXjDK74mejsEYK7vtE2dzh8EdcGYX9HiEwQRQ94Z1piZpNTmZYlAB2VqL3E8a/MZgEkTz1DmbYHAX5NmS8mO5nHHkwS/glF9p0mNrWo3dKlkYTLNBZ7jBoMq/qJ53xw8YzIA9L9vzBQb3gcptp3Qr6yNpTTF4ALL1Zm9+yDfdi/gQ7JVB+THlFhgKgBf7hl0JhUD+Ppe/z0XT+LvKU98SPf3vquAnO7QFlG+LXvfvKu+env9dFTC0Dfb8j3vRo9yE8dJ5w1DkU4vmx55WFOR5j27uMRTzLBQWzuZO1kcYigNZdcppHyYSIFoWwyCkADW/udbIbZ9gKMlmuDyhXaD60F6NhNHgHIXSLIum4azX7vKMipcYygJVBoyW0B7Ybx1a687vGob22ZTQClzF0FcuimP0GEShA2AEPY+cV4MTFDoEUX6lnIZbYaD1K9vd2gGqFmh+LCpV3IqDPV+41gi3FKBBW06mZK68C7v/IRcvP7OydfjRMpWij5xwCOjsBzfaoM1NGg6DzE/EU8tvoXAEqH9MlSKGo+AMN6JWo3qe+ymc9m7ea7KwcE9LnIewyiqy8kcWWhjOeDevevbmh5+n7Rg4xQfKmbi9A6JZFc9nuB0HOapQTvvsp20FyDjherzpZK6c4YYf394FRv+b/qGVZpnDinjwceoPGAmCo028b1sgLleip0trytQRCbPMDVwt8GuRbe/2pEfdP7bV5qaMREBcLpzhhssRifKbjNxIDGSHGUi+6W7rG0YS3kutZ44jogCd5P3wIkmg00s6yXkGUsBZXb8y4FuPGPkCzvOAqfBTJQ00Lzm5IkYyQOUJdU13/MC5jWSBclPPj/3PN4XRkMsyRg7g0+lo3AelO2qJuSELC8rfO1cl0dhQycJoEsTszj1+E1qBURVNg5/XrsleRjMgnl+pa2I0C1TKy0KLcR/bAiqbZI0+0x/zgEFmg8OOJcBeDp3CE+V1jCnAbh+N2d9Y0r+JsV2vFpPeuyxmmnt9wpZiX8BeFZnpchrG0p7Q1TGmAp2Y3hsZED8q7+SLO2EQ0ze/KjsKyELLXpjcYju7IK2R0Ee4kwKhD0X3AeNhcL77vIvxiNcuZoOqHDwT8sUI41EQ/QfqN8i4xXgMqG+5pyWmxqaBcYWpQZyP5ZuO8ST3J/Utp3TLvGKu5PJdKwWie+E+dTGeBrc/ZsDF9/km3R7JwgLjB0Crb6Kz8rEaPwSq553qLeU0341EAJxvR6J0gYkgMGm8zuh+jImQZ7N87ac9EQPK66JhiJ8jurmXrSUm4iCaBvucM6l8hYk08KQwGn4XJlRwziZydi0KvzCRAXmvua0XeuxgIgtUvhY3j3R5hYk9EFrB+THxiT5x4D/DqVMCIM7HYtah+hCVEAvSmnrCFguMg0GbyVMJA0+3yQSVz5a6cccP9vwIlQhDw1mvmd6bBldNifInuSyT2fCzo8T5E+Ve3KsNKgkW3NMSKgrIVU425qgkwam1qDJAZdeHu1hPXf32v+GupIBK+vt93gSs6YeJL/yqqFR9ilTSIIwO5/Cq4aNFUdkIv8iDvMBlVDJ8y/k2tZdNVLL+FTH5ziBQ9viQzIa9vKHBPSr7LPPN5QyVr0DXt4xaTtmBd3Ix9GuqHIJzdi+uznmY5jRMBkBaUzmZ+slPhkA0TR48pyWPlj3/kmEgcyX0IdN0chscw/KJ86mDycjH4TutJ2Msc9a1gqx3MZnwpnjOxOQuiNId3R5hMsU6Ylr0HkyDPG+5T0eYzIK03qh/jMlD9oODyJm4q/DsEJ0VpgI88YW24YmfCoP8/YvvpT7KL6bXPNf/MUcqwvo0P7atNsf1j/9SUXAqT7TW6HGNqbin1bf8/kgleIliskkp3BKy/cjjxGxwJ3rTwW2f+NVN8Si4pkGb+6j6A1PvO4lWe3/L450+pjLgnF851R88nVL7QPOfVJxi6oCVmY66f/BLAKh85YGigekwyJ88CMXkDtO7wAOAK+6VI63yvGYQv7dPOguf202at59roVs8etQAiKcWT8mP7RfVIIjCN35Pv/VjUEP8npMvsWV1CxytwnuUGva2ltwc1QhXg7ewiytUo5wT5/metJrQNqjGmBPErCOap76DKo9YQ9ZH3KuvM6qVROGBEbt8QTXuq9urKm8MaoKNcw7yY/HrO6peylm52HEMC9Ukn9NJjs2zf7sgZ9d+n6ppdpZ7qayjqgKV9Q/nM/wMDbyVvFYSrUfR03mJMBqo7gHzodlgDwdtVL9+7Jn/2EM9AKq0fESrDEaDbo/o5p5RkQkAp/S0xF8vRv/HJGPV32P3U5oJsYpPubUSnRrM7U3j43gL7EWZ1jpmIrwwMbtloiCmJvN5ZgcYBtXCJ2Vn4iAKv3hVKU4xkwB73qX5hZi8YIbz1efNrrP6UE6CvDti8DQNzOyCmAyY/jJp1nS+TSn3gpkMOyjvNR6z6zUje9Bm5LIne0CdNSenfI2ZfaCKKRZrzBwCXYzE1blf5WwA7EWJfcqZmI14wuKJYZd9/4vTecPsAX/2EZ49ZIFyTJyfoe0FQFw/O7XvdJLHPW8GieapuHnkBNZK/1Ype37E+N4LA/1u0ElOnjNX4l7En/VyOWNGtB78HO/F/P9Z/3OQBOelSGUd97LAURbveDXtrHF/25PzY79qRonzYE1xX4XPf2tf4zxuecg9HeNBGOxF39Vv8cBjbGmNmL4PuZl196KHhwo4zz2fXg/3QcyG9Dqj+bHbH+H/DwDE7rgRtQ4AAA==",
+	"zh_Hant": "H4sIAAAAAAAA/1xXW14iT6x+TnYFiC1CIwOoo6sRlbGhQVAYELmJchlGkOsIKtNshlRVP80Wzi9N+/ec80a6Ukkq+fIleHxg363F04uoWzR7Rc8O0EvKThSkYaHH7xyaqc1qpBIjyhvU7lFyQDUTPbt8RqOMmJTRo/Etqo0p26JcmhZzWl7YiSJ6As5B51qYKfSE+Io0LD4frzbvDfTosHlvSOv6Uz5w9AsdRz/K+uLhjT4W6IkBq00GqtQXN33nchyo02OvRh89hyCsmZhP0XMMKrugycC+W9vDES3m6PnOmupuKK2288pTdkuthjBr8vIVvR4Qs0d6et6GoYwr9eeZWg0qdOTrUM6z6PUCLeZqWqf2PXp3gIZNSj9wlF4/iFGRjL6onKN3F2h5QfmUnGfF7KecP6JXg826TukHMpyovXtsSTTK6A2Aup3a9z+oNkbvPrD1bBm9IVCJEi3m8qYpJzP06iArlkj+5IR6wyAmryqfRu8ByOsPMvrydejY/cbPVndDdmXeckzZM/RG2ZvqrNEb419UMOzuAL1x2Cyzm+Ubeo/56aI2tM+f0HsCsvJ3s76UVlKURug95aDsqwwLPg84T15Tu4c+H8jeDSVNURr9WxnUfLfrxr9Vyk2wbwcoVRWN2r+VYV/dUDsnppV/qxT6dmGzHNr1BiUndJthJPm0L11aXggzpUp9R3cP5E2Dnp7RFwA1aclURfxZ0HSJviDQ+zMlzU93IRCVdzL66NOBSmf2e8e+/4G+MDtzfBwAFTp09ixGRU6WL8qyKI2o3bM/rin9gL5DoFyb0eI7Avk6JGuherfoO2ZXwkxxLX3fuTTq908Gke8E1OyvqLyQeevk5xREdkFJE3f8QNaC/e7sA+VTDInaGHeCsFm+2e8d3NGB2vdyMqPxyrlwwLK44zbg8v2Xm53Tz5bJpV38+H1A15eyUGMk+v0gLqtiWnH7x68BNS8ol0b/HqjOWtzeUsFgmPqjfI2MPjXOOQP+GJ/L3FCmKuiPg2o9yfmjm57dAKj0QN0NcXcfRCkv1k3cDYLs5ihpfrXRrg40+uGUIUnjleqs2fLuATD0reSnVpRlzobmIIj+DlHzgjInzrcdEA8redOwz58Y4JofqDQQ0w82pe06VycDqjHGGaKaBuLhTSRGqO2BWne5ObUAyCpTjrSSduUMtRDbtCt/+AWaDrJ45z5MCwO9WZTsOdYjwGmsjcW8KiovqH0D9adtJwr/SyUKtMyoqzvU4q6y3R1wPrVDsMs1zoJ2/GVTjIqME+0EVMF0I94Luii0uxWxHMnUGxkd1cqI4pqyZ7gXBnrJ2Rd/hZliGO1Fwc1o88LN6F4cGPi1Me4dAmUMmaow1gM7QNkxjVcYcGAgDYsfHAjB5qOjUlMyyhjQQVRXHGYg7Gi/dzFw4CR/MtjKYm7aD3fMQYFvsFmlhZliTAaijlArYyAG9GPs2IiDtPo8BIw+7vtBWG3VWfPJvg4yVVHTBbfS/gHI+aUod3E/AqLcEbUBBv2gzkec06DGPcGYzjsPDu6BaA6oWaTRLwwG+NC+yjBH8qEO6qYqbvoYDHPvUdLc0hG17xmOuTSNV/J16BiKAGXKdrOAwShQdvvr2OGpXwmZesPgCdglU1RXLjKDp0AFQ5X6/N6QB2j0wpAMeYE5YTGn5z6GfOzWrk3dWoQCQEZZFEfiZ1eURrLygaEgiNLIvsow+WZbGIqCms0ZCjcNfnAoxjJ3V+oOQ3GQz6ZdeaWXKoYOQVZ74umFHloYOgLmvstnl9BDJ8BTZQtc3QPipi/mVSp0UPexIOdZR9hxBMPijBS6qPtB3VYYZckezy8rifpnG/FLuoPNMoG6BnZ3YCeKTOmlEVdN3+NPcv4mDctNkB7kT5R8tR8KqIccweijroNcDGRxiXqYnVGujfqBi3JhzezS2f9Hub6tjXOfXng8frr4xlY3y4RLhXoUVCbJWq2iS2p6zHFSmHIf6nHWl4a1+SihfgjiV4sz31kzAvQj95CXgvYz6scsM6B/JVD/DvT4i/HKWTvhk431wy2rfgrq+nmzTPHcTJoY9gDHOJm5+Q/7QJTGPFquMtS+dyML+4HGK1HuYHgX1LPFiEya3A9hDahjfLF2OMCanGwzJQs1DIfcaY3hAxCZ3/QrgeEI64hZmnevcJQN2sMRhg9BzvNM62z3lAXVWfPVA52ng6iuMOLh4S7MNQ/3iB9k746vRj4LL68/uKf+4+iIxvq0vODta57/IrzIHqjclCxTzQoYCTpazXcelPUuRkK8K7DniM5dIe9fqHmhjKvtFLDvf7hFjTDlP1L7nvsof4mR7e7hzEmqd9XVbzH9YFhE4qBuWip/yVMocgy0rMp8CiMnrM91qw3xmwco2+LZEPWD/MmjTpTfMXoATPfdgVuLaAyo1WDsbtsneghfi0z0mBvN2WyLGPOAmFbkRY7Z/HO/xZgX5PyRTZbO3GfEfGySlkMuSGwH1N0Vr0wxv7OXJJcY0xxUpB+o3sLYHueEF0fzVpgOK8YCzAxiXuV9YhtmbN8tN00GzB+FLmeGaawknlIYC7o3Nqs8JU2MhUBmkzTM02SCMSfr2yZUzxbGwk58yR6lH5wQmXcft+eOryiHzL2ULWMsxpz4+YQ4W2LGsK5F5UU0yrwsjIoYOwJV6nMWC11eMGLf3WX6izpiJyB+3n26OAXKTWWhK9ZNBkbcAzL15lJ0vcsE8+5u1W5S4z6gWnO7OVIubZdM2bvhTLjHOyCaHbIWGNd4KWKKi++BmI2ZmuP7QK0ir1RJE+NBEKk7SppkNj5vh0DUJ7Ssi8krxnXH0/szd0g8DPJ34qsD4geOZvoB41FWU2czSr5iPA6UvbCvMhx5Ls32eWnuYPwIKJ+XhS73VfwYKDeWiSeMnwLVuzT+ZIRDDzCwnC0JDzVHeJsyeA+3f2BqJh6e8GcX3YenLKjMlIwOF/zIA+Lxj7o9p2kLj5zhI0pXPCJy6f8WJS7DkR+oV6RkT940eCE50typ7oyPgZuQo4D7t+n/fg2Dek1TtoxHh6DyA/v8SdRreLwLstDlofNZDl5DRxmqlezzJ/fucQy+/oJ9D/J45dE2vcATP6iblF06w5MtSc8veQzMXvGUW7hs1xt4qgON711SPT0GZVVpMadfCfyfAQC8QDRrig4AAA==",
+})
+	}
+	return _regionNameData.Load().(map[string]string)
+}
\ No newline at end of file
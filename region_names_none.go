@@ -0,0 +1,19 @@
+//go:build phonenumbers_nogeocoding
+// +build phonenumbers_nogeocoding
+
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+// Built with the phonenumbers_nogeocoding tag, region_names_bin.go is left
+// out and there are no region names.
+var _regionNameData atomic.Value
+
+func getRegionNameData() map[string]string {
+	if _regionNameData.Load() == nil {
+		_regionNameData.Store(map[string]string{})
+	}
+	return _regionNameData.Load().(map[string]string)
+}
@@ -0,0 +1,186 @@
+package phonenumbers
+
+import (
+	"regexp/syntax"
+)
+
+// RegionInfo describes the numbering plan of a region.
+type RegionInfo struct {
+	RegionCode  string
+	CountryCode int
+
+	// InternationalPrefix is the pattern of prefixes dialled to call other
+	// countries from the region, such as 00 or 011
+	InternationalPrefix string
+
+	// PreferredInternationalPrefix is the prefix to dial when the
+	// international prefix matches more than one, empty if it doesn't
+	PreferredInternationalPrefix string
+
+	// NationalPrefix is dialled before national numbers within the region,
+	// such as 0 in GB, empty if there is none
+	NationalPrefix string
+
+	// PossibleLengths are the lengths national significant numbers of each
+	// type the region has can be
+	PossibleLengths map[PhoneNumberType][]int
+
+	// LocalOnlyLengths are the lengths numbers of each type can be when
+	// only dialled locally, without an area code
+	LocalOnlyLengths map[PhoneNumberType][]int
+
+	// ExampleNumbers are example national significant numbers of each type
+	// the region has an example for
+	ExampleNumbers map[PhoneNumberType]string
+
+	IsNANPA                bool
+	IsMobileNumberPortable bool
+	IsLeadingZeroPossible  bool
+}
+
+// GetRegionInfo returns a description of the numbering plan of the passed
+// in region, such as "GB", or nil if the region isn't supported.
+func GetRegionInfo(regionCode string) *RegionInfo {
+	return CurrentMetadata().GetRegionInfo(regionCode)
+}
+
+// GetRegionInfo is the same as the package level GetRegionInfo, using
+// this metadata.
+func (md *Metadata) GetRegionInfo(regionCode string) *RegionInfo {
+	metadata := md.getMetadataForRegion(regionCode)
+	if metadata == nil {
+		return nil
+	}
+
+	info := &RegionInfo{
+		RegionCode:                   regionCode,
+		CountryCode:                  int(metadata.GetCountryCode()),
+		InternationalPrefix:          metadata.GetInternationalPrefix(),
+		PreferredInternationalPrefix: metadata.GetPreferredInternationalPrefix(),
+		NationalPrefix:               metadata.GetNationalPrefix(),
+		PossibleLengths:              make(map[PhoneNumberType][]int),
+		LocalOnlyLengths:             make(map[PhoneNumberType][]int),
+		ExampleNumbers:               make(map[PhoneNumberType]string),
+		IsNANPA:                      md.IsNANPACountry(regionCode),
+		IsMobileNumberPortable:       metadata.GetMobileNumberPortableRegion(),
+		IsLeadingZeroPossible:        isLeadingZeroPossible(metadata),
+	}
+
+	for _, numberType := range EXAMPLE_NUMBER_TYPES {
+		desc := getNumberDescByType(metadata, numberType)
+		if !hasNationalNumberPattern(desc) {
+			continue
+		}
+
+		// types with the same lengths as the general description don't
+		// have their own
+		lengths := desc.GetPossibleLength()
+		if len(lengths) == 0 {
+			lengths = metadata.GetGeneralDesc().GetPossibleLength()
+		}
+		if len(lengths) == 0 || lengths[0] == -1 {
+			continue
+		}
+		info.PossibleLengths[numberType] = intLengths(lengths)
+		if localOnly := desc.GetPossibleLengthLocalOnly(); len(localOnly) > 0 {
+			info.LocalOnlyLengths[numberType] = intLengths(localOnly)
+		}
+		if example := desc.GetExampleNumber(); example != "" {
+			info.ExampleNumbers[numberType] = example
+		}
+	}
+	return info
+}
+
+func intLengths(lengths []int32) []int {
+	ints := make([]int, len(lengths))
+	for i, length := range lengths {
+		ints[i] = int(length)
+	}
+	return ints
+}
+
+// Returns whether national numbers of the region can start with a zero,
+// which upstream metadata no longer flags, so it is worked out from the
+// pattern of its general description.
+func isLeadingZeroPossible(metadata *PhoneMetadata) bool {
+	if metadata.GetLeadingZeroPossible() {
+		return true
+	}
+	re, err := syntax.Parse(metadata.GetGeneralDesc().GetNationalNumberPattern(), syntax.Perl)
+	if err != nil {
+		return false
+	}
+	return canStartWith(re.Simplify(), '0')
+}
+
+// Returns whether a string the passed in expression matches can start with
+// the passed in rune.
+func canStartWith(re *syntax.Regexp, r rune) bool {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return len(re.Rune) > 0 && re.Rune[0] == r
+	case syntax.OpCharClass:
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i] <= r && r <= re.Rune[i+1] {
+				return true
+			}
+		}
+		return false
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return true
+	case syntax.OpCapture, syntax.OpStar, syntax.OpPlus, syntax.OpQuest:
+		return canStartWith(re.Sub[0], r)
+	case syntax.OpRepeat:
+		return re.Max != 0 && canStartWith(re.Sub[0], r)
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if canStartWith(sub, r) {
+				return true
+			}
+		}
+		return false
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if canStartWith(sub, r) {
+				return true
+			}
+			if !matchesEmpty(sub) {
+				return false
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// Returns whether the passed in expression matches the empty string.
+func matchesEmpty(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpStar, syntax.OpQuest,
+		syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return true
+	case syntax.OpLiteral:
+		return len(re.Rune) == 0
+	case syntax.OpRepeat:
+		return re.Min == 0 || matchesEmpty(re.Sub[0])
+	case syntax.OpCapture, syntax.OpPlus:
+		return matchesEmpty(re.Sub[0])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if matchesEmpty(sub) {
+				return true
+			}
+		}
+		return false
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !matchesEmpty(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
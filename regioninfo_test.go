@@ -0,0 +1,105 @@
+package phonenumbers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetRegionInfo(t *testing.T) {
+	tests := []struct {
+		region         string
+		countryCode    int
+		intlPrefix     string
+		nationalPrefix string
+		nanpa          bool
+		portable       bool
+		leadingZero    bool
+		mobileLengths  []int
+		mobileExample  string
+	}{
+		{"GB", 44, "00", "0", false, true, false, []int{10}, "7400123456"},
+		{"US", 1, "011", "1", true, true, false, []int{10}, "2015550123"},
+		{"IT", 39, "00", "", false, true, true, []int{9, 10}, "3123456789"},
+	}
+
+	for i, test := range tests {
+		info := GetRegionInfo(test.region)
+		if info == nil {
+			t.Errorf("[test %d:info] failed: no info for %s\n", i, test.region)
+			continue
+		}
+		if info.RegionCode != test.region {
+			t.Errorf("[test %d:region] failed: %s != %s\n", i, info.RegionCode, test.region)
+		}
+		if info.CountryCode != test.countryCode {
+			t.Errorf("[test %d:countryCode] failed: %d != %d\n", i, info.CountryCode, test.countryCode)
+		}
+		if info.InternationalPrefix != test.intlPrefix {
+			t.Errorf("[test %d:intlPrefix] failed: %s != %s\n", i, info.InternationalPrefix, test.intlPrefix)
+		}
+		if info.NationalPrefix != test.nationalPrefix {
+			t.Errorf("[test %d:nationalPrefix] failed: %s != %s\n", i, info.NationalPrefix, test.nationalPrefix)
+		}
+		if info.IsNANPA != test.nanpa {
+			t.Errorf("[test %d:nanpa] failed: %v != %v\n", i, info.IsNANPA, test.nanpa)
+		}
+		if info.IsMobileNumberPortable != test.portable {
+			t.Errorf("[test %d:portable] failed: %v != %v\n", i, info.IsMobileNumberPortable, test.portable)
+		}
+		if info.IsLeadingZeroPossible != test.leadingZero {
+			t.Errorf("[test %d:leadingZero] failed: %v != %v\n", i, info.IsLeadingZeroPossible, test.leadingZero)
+		}
+		if lengths := info.PossibleLengths[MOBILE]; !reflect.DeepEqual(lengths, test.mobileLengths) {
+			t.Errorf("[test %d:mobileLengths] failed: %v != %v\n", i, lengths, test.mobileLengths)
+		}
+		if example := info.ExampleNumbers[MOBILE]; example != test.mobileExample {
+			t.Errorf("[test %d:mobileExample] failed: %s != %s\n", i, example, test.mobileExample)
+		}
+
+		// every example number is valid and of its type
+		for numberType, example := range info.ExampleNumbers {
+			num, err := Parse(example, test.region)
+			if err != nil || GetNumberType(num) != numberType && GetNumberType(num) != FIXED_LINE_OR_MOBILE {
+				t.Errorf("[test %d:example %d] failed: %s, %v\n", i, numberType, example, err)
+			}
+		}
+	}
+
+	// lengths of types without their own are those of the region
+	if lengths := GetRegionInfo("RU").PossibleLengths[TOLL_FREE]; !reflect.DeepEqual(lengths, []int{10}) {
+		t.Errorf("[test inherited lengths] failed: %v\n", lengths)
+	}
+	if lengths := GetRegionInfo("GB").LocalOnlyLengths[FIXED_LINE]; !reflect.DeepEqual(lengths, []int{4, 5, 6, 7, 8}) {
+		t.Errorf("[test local only lengths] failed: %v\n", lengths)
+	}
+	if _, ok := GetRegionInfo("GB").PossibleLengths[FIXED_LINE_OR_MOBILE]; ok {
+		t.Errorf("[test types] failed: has FIXED_LINE_OR_MOBILE lengths\n")
+	}
+
+	for _, region := range []string{"ZZ", "001", ""} {
+		if info := GetRegionInfo(region); info != nil {
+			t.Errorf("[test unknown %s] failed: %v != nil\n", region, info)
+		}
+	}
+}
+
+func TestIsLeadingZeroPossible(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected bool
+	}{
+		{`0\d{9}`, true},
+		{`[1-9]\d{9}`, false},
+		{`[02-9]\d{5}`, true},
+		{`1\d{4}|0\d{5}`, true},
+		{`(?:3[1-5])?0\d{4}`, true},
+		{`(?:3[1-5])0\d{4}`, false},
+		{`(?:0{0})1\d{4}`, false},
+	}
+	for i, test := range tests {
+		metadata := &PhoneMetadata{GeneralDesc: &PhoneNumberDesc{NationalNumberPattern: &test.pattern}}
+		if result := isLeadingZeroPossible(metadata); result != test.expected {
+			t.Errorf("[test %d:%s] failed: %v != %v\n", i, test.pattern, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package phonenumbers
+
+import (
+	"sync/atomic"
+)
+
+var _shortNumberMetadataData atomic.Value
+
+func getShortNumberMetadataData() string {
+	if _shortNumberMetadataData.Load() == nil {
+		_shortNumberMetadataData.Store("H4sIAAAAAAAA/wMAAAAAAAAAAAA=")
+	}
+	return _shortNumberMetadataData.Load().(string)
+}
\ No newline at end of file
@@ -0,0 +1,473 @@
+package phonenumbers
+
+// ----------------------------------------------------------------------------
+// Golang port of:
+// https://github.com/googlei18n/libphonenumber/blob/master/java/libphonenumber/src/com/google/i18n/phonenumbers/ShortNumberInfo.java
+// ----------------------------------------------------------------------------
+
+// ShortNumberCost is the expected cost of dialing a short number.
+type ShortNumberCost int
+
+const (
+	TOLL_FREE_COST ShortNumberCost = iota
+	STANDARD_RATE_COST
+	PREMIUM_RATE_COST
+	UNKNOWN_COST
+)
+
+// In these countries, if extra digits are added to an emergency number,
+// it no longer connects to the emergency service.
+var REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT = map[string]bool{
+	"BR": true,
+	"CL": true,
+	"NI": true,
+}
+
+// Returns a list with the region codes that match the specific country
+// calling code. For non-geographical country calling codes, the region
+// code 001 is returned. Also, in the case of no region code being found,
+// an empty list is returned.
+func (md *Metadata) getRegionCodesForShortNumberCountryCode(countryCallingCode int) []string {
+	return md.countryCodeToRegion[countryCallingCode]
+}
+
+// Helper method to check that the country calling code of the number
+// matches the region it's being dialed from.
+func (md *Metadata) regionDialingFromMatchesNumber(number *PhoneNumber, regionDialingFrom string) bool {
+	for _, regionCode := range md.getRegionCodesForShortNumberCountryCode(int(number.GetCountryCode())) {
+		if regionCode == regionDialingFrom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPossibleShortNumberForRegion checks whether a short number is a
+// possible number when dialed from the given region. This provides a
+// more lenient check than IsValidShortNumberForRegion.
+func IsPossibleShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	return CurrentMetadata().IsPossibleShortNumberForRegion(number, regionDialingFrom)
+}
+
+// IsPossibleShortNumberForRegion is the same as the package level
+// IsPossibleShortNumberForRegion, using this metadata.
+func (md *Metadata) IsPossibleShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !md.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return false
+	}
+	numberLength := len(GetNationalSignificantNumber(number))
+	return containsPossibleLength(phoneMetadata.GetGeneralDesc(), numberLength)
+}
+
+// IsPossibleShortNumber checks whether a short number is a possible
+// number. If a country calling code is shared by multiple regions, this
+// returns true if it's possible in any of them. This provides a more
+// lenient check than IsValidShortNumber.
+func IsPossibleShortNumber(number *PhoneNumber) bool {
+	return CurrentMetadata().IsPossibleShortNumber(number)
+}
+
+// IsPossibleShortNumber is the same as the package level
+// IsPossibleShortNumber, using this metadata.
+func (md *Metadata) IsPossibleShortNumber(number *PhoneNumber) bool {
+	shortNumberLength := len(GetNationalSignificantNumber(number))
+	for _, region := range md.getRegionCodesForShortNumberCountryCode(int(number.GetCountryCode())) {
+		phoneMetadata := md.getShortNumberMetadataForRegion(region)
+		if phoneMetadata == nil {
+			continue
+		}
+		if containsPossibleLength(phoneMetadata.GetGeneralDesc(), shortNumberLength) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidShortNumberForRegion tests whether a short number matches a
+// valid pattern in a region. Note that this doesn't verify the number
+// is actually in use, which is impossible to tell by just looking at
+// the number itself.
+func IsValidShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	return CurrentMetadata().IsValidShortNumberForRegion(number, regionDialingFrom)
+}
+
+// IsValidShortNumberForRegion is the same as the package level
+// IsValidShortNumberForRegion, using this metadata.
+func (md *Metadata) IsValidShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !md.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return false
+	}
+	shortNumber := GetNationalSignificantNumber(number)
+	generalDesc := phoneMetadata.GetGeneralDesc()
+	if !matchesPossibleNumberAndNationalNumber(shortNumber, generalDesc) {
+		return false
+	}
+	shortNumberDesc := phoneMetadata.GetShortCode()
+	return matchesPossibleNumberAndNationalNumber(shortNumber, shortNumberDesc)
+}
+
+// IsValidShortNumber tests whether a short number matches a valid
+// pattern. If a country calling code is shared by multiple regions,
+// this returns true if it's valid in any of them. Note that this doesn't
+// verify the number is actually in use, which is impossible to tell by
+// just looking at the number itself. See IsValidShortNumberForRegion
+// for details.
+func IsValidShortNumber(number *PhoneNumber) bool {
+	return CurrentMetadata().IsValidShortNumber(number)
+}
+
+// IsValidShortNumber is the same as the package level IsValidShortNumber,
+// using this metadata.
+func (md *Metadata) IsValidShortNumber(number *PhoneNumber) bool {
+	regionCodes := md.getRegionCodesForShortNumberCountryCode(int(number.GetCountryCode()))
+	regionCode := md.getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	if len(regionCodes) > 1 && regionCode != "" {
+		// If a matching region had been found for the phone number from
+		// among two or more regions, then we have already implicitly
+		// verified its validity for that region.
+		return true
+	}
+	return md.IsValidShortNumberForRegion(number, regionCode)
+}
+
+// GetExpectedCostForRegion gets the expected cost category of a short
+// number when dialed from a region (however, nothing is implied about
+// its validity). If it is important that the number is valid, then its
+// validity must first be checked using IsValidShortNumberForRegion. Note
+// that emergency numbers are always considered toll-free. Returns
+// UNKNOWN_COST if the number does not match a cost category, or if the
+// region the number is dialed from doesn't match the country calling
+// code of the number.
+//
+// Example usage:
+//
+//	number, _ := phonenumbers.Parse("110", "FR")
+//	if phonenumbers.IsValidShortNumberForRegion(number, "FR") {
+//		cost := phonenumbers.GetExpectedCostForRegion(number, "FR")
+//		// Do something with the cost information here.
+//	}
+func GetExpectedCostForRegion(number *PhoneNumber, regionDialingFrom string) ShortNumberCost {
+	return CurrentMetadata().GetExpectedCostForRegion(number, regionDialingFrom)
+}
+
+// GetExpectedCostForRegion is the same as the package level
+// GetExpectedCostForRegion, using this metadata.
+func (md *Metadata) GetExpectedCostForRegion(number *PhoneNumber, regionDialingFrom string) ShortNumberCost {
+	if !md.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return UNKNOWN_COST
+	}
+	// Note that regionDialingFrom may be "" (no region) or "ZZ", in which
+	// case we return UNKNOWN_COST.
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return UNKNOWN_COST
+	}
+
+	shortNumber := GetNationalSignificantNumber(number)
+
+	// The possible lengths are not present for a particular sub-type if
+	// they match the general description; for this reason, we check the
+	// possible lengths against the general description first to allow an
+	// early exit if possible.
+	if !containsPossibleLength(phoneMetadata.GetGeneralDesc(), len(shortNumber)) {
+		return UNKNOWN_COST
+	}
+
+	// The cost categories are tested in order of decreasing expense,
+	// since if for some reason the patterns overlap the most expensive
+	// matching cost category should be returned.
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetPremiumRate()) {
+		return PREMIUM_RATE_COST
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetStandardRate()) {
+		return STANDARD_RATE_COST
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetTollFree()) {
+		return TOLL_FREE_COST
+	}
+	if md.IsEmergencyNumber(shortNumber, regionDialingFrom) {
+		// Emergency numbers are implicitly toll-free.
+		return TOLL_FREE_COST
+	}
+	return UNKNOWN_COST
+}
+
+// GetExpectedCost gets the expected cost category of a short number
+// (however, nothing is implied about its validity). If the country
+// calling code is unique to a region, this method behaves exactly the
+// same as GetExpectedCostForRegion. However, if the country calling code
+// is shared by multiple regions, then it returns the highest cost in the
+// sequence PREMIUM_RATE_COST, UNKNOWN_COST, STANDARD_RATE_COST,
+// TOLL_FREE_COST. The reason for the position of UNKNOWN_COST in this
+// order is that if a number is UNKNOWN_COST in one region but
+// STANDARD_RATE_COST or TOLL_FREE_COST in another, its expected cost
+// cannot be estimated as one of the latter since it might be a
+// PREMIUM_RATE_COST number.
+//
+// For example, if a number is STANDARD_RATE_COST in the US, but
+// TOLL_FREE_COST in Canada, the expected cost returned by this method
+// will be STANDARD_RATE_COST, since the NANPA countries share the same
+// country calling code.
+func GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	return CurrentMetadata().GetExpectedCost(number)
+}
+
+// GetExpectedCost is the same as the package level GetExpectedCost, using
+// this metadata.
+func (md *Metadata) GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	regionCodes := md.getRegionCodesForShortNumberCountryCode(int(number.GetCountryCode()))
+	if len(regionCodes) == 0 {
+		return UNKNOWN_COST
+	}
+	if len(regionCodes) == 1 {
+		return md.GetExpectedCostForRegion(number, regionCodes[0])
+	}
+	cost := TOLL_FREE_COST
+	for _, regionCode := range regionCodes {
+		costForRegion := md.GetExpectedCostForRegion(number, regionCode)
+		switch costForRegion {
+		case PREMIUM_RATE_COST:
+			return PREMIUM_RATE_COST
+		case UNKNOWN_COST:
+			cost = UNKNOWN_COST
+		case STANDARD_RATE_COST:
+			if cost != UNKNOWN_COST {
+				cost = STANDARD_RATE_COST
+			}
+		case TOLL_FREE_COST:
+			// Do nothing.
+		}
+	}
+	return cost
+}
+
+// Helper method to get the region code for a given phone number, from
+// a list of possible region codes. If the list contains more than one
+// region, the first region for which the number is valid is returned.
+func (md *Metadata) getRegionCodeForShortNumberFromRegionList(number *PhoneNumber, regionCodes []string) string {
+	if len(regionCodes) == 0 {
+		return ""
+	} else if len(regionCodes) == 1 {
+		return regionCodes[0]
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	for _, regionCode := range regionCodes {
+		phoneMetadata := md.getShortNumberMetadataForRegion(regionCode)
+		if phoneMetadata != nil &&
+			matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetShortCode()) {
+			// The number is valid for this region.
+			return regionCode
+		}
+	}
+	return ""
+}
+
+// GetExampleShortNumber gets a valid short number for the specified
+// region, or an empty string if no such number exists.
+func GetExampleShortNumber(regionCode string) string {
+	return CurrentMetadata().GetExampleShortNumber(regionCode)
+}
+
+// GetExampleShortNumber is the same as the package level
+// GetExampleShortNumber, using this metadata.
+func (md *Metadata) GetExampleShortNumber(regionCode string) string {
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+	return phoneMetadata.GetShortCode().GetExampleNumber()
+}
+
+// GetExampleShortNumberForCost gets a valid short number for the
+// specified cost category, or an empty string if no such number exists.
+func GetExampleShortNumberForCost(regionCode string, cost ShortNumberCost) string {
+	return CurrentMetadata().GetExampleShortNumberForCost(regionCode, cost)
+}
+
+// GetExampleShortNumberForCost is the same as the package level
+// GetExampleShortNumberForCost, using this metadata.
+func (md *Metadata) GetExampleShortNumberForCost(regionCode string, cost ShortNumberCost) string {
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+	var desc *PhoneNumberDesc
+	switch cost {
+	case TOLL_FREE_COST:
+		desc = phoneMetadata.GetTollFree()
+	case STANDARD_RATE_COST:
+		desc = phoneMetadata.GetStandardRate()
+	case PREMIUM_RATE_COST:
+		desc = phoneMetadata.GetPremiumRate()
+	default:
+		// UNKNOWN_COST numbers are computed by the process of
+		// elimination from the other cost categories.
+	}
+	return desc.GetExampleNumber()
+}
+
+// ConnectsToEmergencyNumber returns true if the given number, exactly as
+// dialed, might be used to connect to an emergency service in the given
+// region.
+//
+// This method accepts a string, rather than a PhoneNumber, because it
+// needs to distinguish cases such as "+1 911" and "911", where the
+// former may not connect to an emergency service in all cases but the
+// latter would. This method takes into account cases where the number
+// might contain formatting, or might have additional digits appended
+// (when it is okay to do that in the specified region).
+func ConnectsToEmergencyNumber(number, regionCode string) bool {
+	return CurrentMetadata().ConnectsToEmergencyNumber(number, regionCode)
+}
+
+// ConnectsToEmergencyNumber is the same as the package level
+// ConnectsToEmergencyNumber, using this metadata.
+func (md *Metadata) ConnectsToEmergencyNumber(number, regionCode string) bool {
+	return md.matchesEmergencyNumberHelper(number, regionCode, true /* allows prefix match */)
+}
+
+// IsEmergencyNumber returns true if the given number exactly matches an
+// emergency service number in the given region.
+//
+// This method takes into account cases where the number might contain
+// formatting, but doesn't allow additional digits to be appended. Note
+// that IsEmergencyNumber(number, region) implies
+// ConnectsToEmergencyNumber(number, region).
+func IsEmergencyNumber(number, regionCode string) bool {
+	return CurrentMetadata().IsEmergencyNumber(number, regionCode)
+}
+
+// IsEmergencyNumber is the same as the package level IsEmergencyNumber,
+// using this metadata.
+func (md *Metadata) IsEmergencyNumber(number, regionCode string) bool {
+	return md.matchesEmergencyNumberHelper(number, regionCode, false /* doesn't allow prefix match */)
+}
+
+func (md *Metadata) matchesEmergencyNumberHelper(number, regionCode string, allowPrefixMatch bool) bool {
+	possibleNumber := extractPossibleNumber(number)
+	if loc := PLUS_CHARS_PATTERN.FindStringIndex(possibleNumber); loc != nil && loc[0] == 0 {
+		// Returns false if the number starts with a plus sign. We don't
+		// believe dialing the country code before emergency numbers
+		// (e.g. +1911) works, but later, if that proves to work, we can
+		// add additional logic here to handle it.
+		return false
+	}
+	metadata := md.getShortNumberMetadataForRegion(regionCode)
+	if metadata == nil || metadata.GetEmergency() == nil {
+		return false
+	}
+
+	normalizedNumber := NormalizeDigitsOnly(possibleNumber)
+	allowPrefixMatchForRegion := allowPrefixMatch &&
+		!REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT[regionCode]
+	return matchNationalNumber(normalizedNumber, metadata.GetEmergency(), allowPrefixMatchForRegion)
+}
+
+// IsCarrierSpecific given a valid short number, determines whether it is
+// carrier-specific (however, nothing is implied about its validity).
+// Carrier-specific numbers may connect to a different end-point, or not
+// connect at all, depending on the user's carrier. If it is important
+// that the number is valid, then its validity must first be checked
+// using IsValidShortNumber or IsValidShortNumberForRegion.
+func IsCarrierSpecific(number *PhoneNumber) bool {
+	return CurrentMetadata().IsCarrierSpecific(number)
+}
+
+// IsCarrierSpecific is the same as the package level IsCarrierSpecific,
+// using this metadata.
+func (md *Metadata) IsCarrierSpecific(number *PhoneNumber) bool {
+	regionCodes := md.getRegionCodesForShortNumberCountryCode(int(number.GetCountryCode()))
+	regionCode := md.getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionCode)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// IsCarrierSpecificForRegion given a valid short number, determines
+// whether it is carrier-specific when dialed from the given region
+// (however, nothing is implied about its validity). Carrier-specific
+// numbers may connect to a different end-point, or not connect at all,
+// depending on the user's carrier. Returns false if the number doesn't
+// match the region provided.
+func IsCarrierSpecificForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	return CurrentMetadata().IsCarrierSpecificForRegion(number, regionDialingFrom)
+}
+
+// IsCarrierSpecificForRegion is the same as the package level
+// IsCarrierSpecificForRegion, using this metadata.
+func (md *Metadata) IsCarrierSpecificForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !md.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// IsSmsServiceForRegion given a valid short number, determines whether
+// it is an SMS service (however, nothing is implied about its validity).
+// An SMS service is where the primary or only intended usage is to
+// receive and/or send text messages (SMSs). This includes MMS as MMS
+// numbers downgrade to SMS if the other party isn't MMS-capable. Returns
+// false if the number doesn't match the region provided.
+func IsSmsServiceForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	return CurrentMetadata().IsSmsServiceForRegion(number, regionDialingFrom)
+}
+
+// IsSmsServiceForRegion is the same as the package level
+// IsSmsServiceForRegion, using this metadata.
+func (md *Metadata) IsSmsServiceForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	if !md.regionDialingFromMatchesNumber(number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := md.getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(GetNationalSignificantNumber(number), phoneMetadata.GetSmsServices())
+}
+
+// Returns true if the number length is one of the possible lengths of
+// the given description.
+func containsPossibleLength(numberDesc *PhoneNumberDesc, length int) bool {
+	for _, possibleLength := range numberDesc.GetPossibleLength() {
+		if int(possibleLength) == length {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the number has one of the possible lengths of the
+// description, when it lists any, and matches its national number pattern.
+func matchesPossibleNumberAndNationalNumber(number string, numberDesc *PhoneNumberDesc) bool {
+	if len(numberDesc.GetPossibleLength()) > 0 && !containsPossibleLength(numberDesc, len(number)) {
+		return false
+	}
+	return matchNationalNumber(number, numberDesc, false)
+}
+
+// Returns whether the given national number (a string containing only
+// decimal digits) matches the national number pattern defined in the
+// given PhoneNumberDesc. If allowPrefixMatch is true, the number only
+// needs to start with a match of the pattern.
+func matchNationalNumber(number string, numberDesc *PhoneNumberDesc, allowPrefixMatch bool) bool {
+	nationalNumberPattern := numberDesc.GetNationalNumberPattern()
+	// We don't want to consider it a prefix match when matching
+	// non-empty input against an empty pattern.
+	if len(nationalNumberPattern) == 0 {
+		return false
+	}
+	if allowPrefixMatch {
+		return regexFor("^(?:" + nationalNumberPattern + ")").MatchString(number)
+	}
+	return regexFor("^(?:" + nationalNumberPattern + ")$").MatchString(number)
+}
@@ -0,0 +1,309 @@
+package phonenumbers
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A cut down short number metadata file, in the same format as
+// ShortNumberMetadata.xml, with patterns made up for testing.
+var testShortNumberMetadataXML = []byte(`<phoneNumberMetadata>
+  <territories>
+    <territory id="BR" countryCode="55">
+      <generalDesc>
+        <nationalNumberPattern>1\d\d</nationalNumberPattern>
+      </generalDesc>
+      <shortCode>
+        <possibleLengths national="3"/>
+        <nationalNumberPattern>1(?:12|28|9[023])</nationalNumberPattern>
+        <exampleNumber>190</exampleNumber>
+      </shortCode>
+      <emergency>
+        <possibleLengths national="3"/>
+        <nationalNumberPattern>1(?:12|28|9[023])</nationalNumberPattern>
+        <exampleNumber>190</exampleNumber>
+      </emergency>
+    </territory>
+    <territory id="CA" countryCode="1">
+      <generalDesc>
+        <nationalNumberPattern>[1-9]\d{2,4}</nationalNumberPattern>
+      </generalDesc>
+      <standardRate>
+        <possibleLengths national="5"/>
+        <nationalNumberPattern>3\d{4}</nationalNumberPattern>
+        <exampleNumber>30000</exampleNumber>
+      </standardRate>
+      <shortCode>
+        <possibleLengths national="3,5"/>
+        <nationalNumberPattern>112|911|[3-9]\d{4}</nationalNumberPattern>
+        <exampleNumber>911</exampleNumber>
+      </shortCode>
+      <emergency>
+        <possibleLengths national="3"/>
+        <nationalNumberPattern>112|911</nationalNumberPattern>
+        <exampleNumber>911</exampleNumber>
+      </emergency>
+    </territory>
+    <territory id="FR" countryCode="33">
+      <generalDesc>
+        <nationalNumberPattern>[1-8]\d{1,5}</nationalNumberPattern>
+      </generalDesc>
+      <tollFree>
+        <possibleLengths national="4"/>
+        <nationalNumberPattern>30\d\d</nationalNumberPattern>
+        <exampleNumber>3010</exampleNumber>
+      </tollFree>
+      <premiumRate>
+        <possibleLengths national="4"/>
+        <nationalNumberPattern>36\d\d</nationalNumberPattern>
+        <exampleNumber>3610</exampleNumber>
+      </premiumRate>
+      <standardRate>
+        <possibleLengths national="4"/>
+        <nationalNumberPattern>31\d\d</nationalNumberPattern>
+        <exampleNumber>3110</exampleNumber>
+      </standardRate>
+      <shortCode>
+        <possibleLengths national="2,3,4,5,6"/>
+        <nationalNumberPattern>1(?:[578]|12)|3\d{3}|[4-8]\d{4,5}</nationalNumberPattern>
+        <exampleNumber>3010</exampleNumber>
+      </shortCode>
+      <emergency>
+        <possibleLengths national="2,3"/>
+        <nationalNumberPattern>1(?:[578]|12)</nationalNumberPattern>
+        <exampleNumber>112</exampleNumber>
+      </emergency>
+      <carrierSpecific>
+        <possibleLengths national="5"/>
+        <nationalNumberPattern>[4-8]\d{4}</nationalNumberPattern>
+        <exampleNumber>40000</exampleNumber>
+      </carrierSpecific>
+      <smsServices>
+        <possibleLengths national="5,6"/>
+        <nationalNumberPattern>[4-8]\d{4,5}</nationalNumberPattern>
+        <exampleNumber>400000</exampleNumber>
+      </smsServices>
+    </territory>
+    <territory id="US" countryCode="1">
+      <generalDesc>
+        <nationalNumberPattern>[1-9]\d{2,5}</nationalNumberPattern>
+      </generalDesc>
+      <premiumRate>
+        <possibleLengths national="5"/>
+        <nationalNumberPattern>2\d{4}</nationalNumberPattern>
+        <exampleNumber>20000</exampleNumber>
+      </premiumRate>
+      <shortCode>
+        <possibleLengths national="3,5,6"/>
+        <nationalNumberPattern>112|611|911|[2-9]\d{4,5}</nationalNumberPattern>
+        <exampleNumber>911</exampleNumber>
+      </shortCode>
+      <emergency>
+        <possibleLengths national="3"/>
+        <nationalNumberPattern>112|911</nationalNumberPattern>
+        <exampleNumber>911</exampleNumber>
+      </emergency>
+      <carrierSpecific>
+        <possibleLengths national="3"/>
+        <nationalNumberPattern>611</nationalNumberPattern>
+        <exampleNumber>611</exampleNumber>
+      </carrierSpecific>
+    </territory>
+  </territories>
+</phoneNumberMetadata>`)
+
+func withTestShortNumberMetadata(t *testing.T) func() {
+	collection, err := BuildShortNumberMetadataCollection(testShortNumberMetadataXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := CurrentMetadata()
+	metadata := *previous
+	metadata.shortNumberMetadataMap = make(map[string]*PhoneMetadata)
+	for _, meta := range collection.GetMetadata() {
+		metadata.shortNumberMetadataMap[meta.GetId()] = meta
+	}
+	metadata.store()
+	return func() { previous.store() }
+}
+
+func shortNumber(countryCode int32, nationalNumber uint64) *PhoneNumber {
+	return &PhoneNumber{
+		CountryCode:    proto.Int32(countryCode),
+		NationalNumber: proto.Uint64(nationalNumber),
+	}
+}
+
+func TestIsEmergencyNumber(t *testing.T) {
+	defer withTestShortNumberMetadata(t)()
+
+	var tests = []struct {
+		number     string
+		region     string
+		isExact    bool
+		connectsTo bool
+	}{
+		{"911", "US", true, true},
+		{"112", "US", true, true},
+		{"9-1-1", "US", true, true},
+		{"9116666666", "US", false, true},
+		{"+911", "US", false, false},
+		{"999", "US", false, false},
+		{"112", "FR", true, true},
+		{"15", "FR", true, true},
+		{"190", "BR", true, true},
+		// emergency numbers must be dialed exactly in Brazil
+		{"1900", "BR", false, false},
+		{"911", "ZZ", false, false},
+		{"911", "DE", false, false},
+	}
+
+	for _, test := range tests {
+		if isExact := IsEmergencyNumber(test.number, test.region); isExact != test.isExact {
+			t.Errorf("IsEmergencyNumber(%s, %s) = %v, expected %v", test.number, test.region, isExact, test.isExact)
+		}
+		if connectsTo := ConnectsToEmergencyNumber(test.number, test.region); connectsTo != test.connectsTo {
+			t.Errorf("ConnectsToEmergencyNumber(%s, %s) = %v, expected %v", test.number, test.region, connectsTo, test.connectsTo)
+		}
+	}
+}
+
+func TestIsEmergencyNumberWithBuiltinMetadata(t *testing.T) {
+	if len(CurrentMetadata().shortNumberMetadataMap) == 0 {
+		t.Fatalf("no short number metadata built in, run buildmetadata to generate it")
+	}
+
+	var tests = []struct {
+		number     string
+		region     string
+		isExact    bool
+		connectsTo bool
+	}{
+		{"911", "US", true, true},
+		{"112", "FR", true, true},
+		{"112", "DE", true, true},
+		{"999", "GB", true, true},
+		{"1234", "US", false, false},
+	}
+
+	for _, test := range tests {
+		if isExact := IsEmergencyNumber(test.number, test.region); isExact != test.isExact {
+			t.Errorf("IsEmergencyNumber(%s, %s) = %v, expected %v", test.number, test.region, isExact, test.isExact)
+		}
+		if connectsTo := ConnectsToEmergencyNumber(test.number, test.region); connectsTo != test.connectsTo {
+			t.Errorf("ConnectsToEmergencyNumber(%s, %s) = %v, expected %v", test.number, test.region, connectsTo, test.connectsTo)
+		}
+	}
+}
+
+func TestIsValidShortNumber(t *testing.T) {
+	defer withTestShortNumberMetadata(t)()
+
+	var tests = []struct {
+		number    *PhoneNumber
+		region    string
+		possible  bool
+		valid     bool
+		validAnyR bool
+	}{
+		{shortNumber(33, 3010), "FR", true, true, true},
+		{shortNumber(33, 3610), "FR", true, true, true},
+		{shortNumber(33, 9999), "FR", true, false, false},
+		{shortNumber(33, 1234567), "FR", false, false, false},
+		// region doesn't match the country calling code
+		{shortNumber(33, 3010), "US", false, false, true},
+		{shortNumber(1, 611), "US", true, true, true},
+		{shortNumber(1, 611), "CA", true, false, true},
+		{shortNumber(1, 30000), "CA", true, true, true},
+		{shortNumber(49, 110), "DE", false, false, false},
+	}
+
+	for _, test := range tests {
+		if possible := IsPossibleShortNumberForRegion(test.number, test.region); possible != test.possible {
+			t.Errorf("IsPossibleShortNumberForRegion(%v, %s) = %v, expected %v", test.number, test.region, possible, test.possible)
+		}
+		if valid := IsValidShortNumberForRegion(test.number, test.region); valid != test.valid {
+			t.Errorf("IsValidShortNumberForRegion(%v, %s) = %v, expected %v", test.number, test.region, valid, test.valid)
+		}
+		if valid := IsValidShortNumber(test.number); valid != test.validAnyR {
+			t.Errorf("IsValidShortNumber(%v) = %v, expected %v", test.number, valid, test.validAnyR)
+		}
+	}
+}
+
+func TestGetExpectedCost(t *testing.T) {
+	defer withTestShortNumberMetadata(t)()
+
+	var tests = []struct {
+		number        *PhoneNumber
+		region        string
+		costForRegion ShortNumberCost
+		cost          ShortNumberCost
+	}{
+		{shortNumber(33, 3010), "FR", TOLL_FREE_COST, TOLL_FREE_COST},
+		{shortNumber(33, 3110), "FR", STANDARD_RATE_COST, STANDARD_RATE_COST},
+		{shortNumber(33, 3610), "FR", PREMIUM_RATE_COST, PREMIUM_RATE_COST},
+		// emergency numbers are implicitly toll free
+		{shortNumber(33, 112), "FR", TOLL_FREE_COST, TOLL_FREE_COST},
+		{shortNumber(33, 40000), "FR", UNKNOWN_COST, UNKNOWN_COST},
+		{shortNumber(33, 3010), "US", UNKNOWN_COST, TOLL_FREE_COST},
+		// shared country calling codes report the highest cost
+		{shortNumber(1, 20000), "CA", UNKNOWN_COST, PREMIUM_RATE_COST},
+		{shortNumber(1, 30000), "CA", STANDARD_RATE_COST, UNKNOWN_COST},
+		// other NANPA regions have no short number metadata in this test
+		{shortNumber(1, 911), "US", TOLL_FREE_COST, UNKNOWN_COST},
+	}
+
+	for _, test := range tests {
+		if cost := GetExpectedCostForRegion(test.number, test.region); cost != test.costForRegion {
+			t.Errorf("GetExpectedCostForRegion(%v, %s) = %v, expected %v", test.number, test.region, cost, test.costForRegion)
+		}
+		if cost := GetExpectedCost(test.number); cost != test.cost {
+			t.Errorf("GetExpectedCost(%v) = %v, expected %v", test.number, cost, test.cost)
+		}
+	}
+}
+
+func TestIsCarrierSpecific(t *testing.T) {
+	defer withTestShortNumberMetadata(t)()
+
+	if !IsCarrierSpecific(shortNumber(1, 611)) {
+		t.Errorf("expected 611 to be carrier specific")
+	}
+	if !IsCarrierSpecificForRegion(shortNumber(1, 611), "US") {
+		t.Errorf("expected 611 to be carrier specific in US")
+	}
+	if IsCarrierSpecificForRegion(shortNumber(1, 611), "CA") {
+		t.Errorf("expected 611 not to be carrier specific in CA")
+	}
+	if IsCarrierSpecific(shortNumber(1, 911)) {
+		t.Errorf("expected 911 not to be carrier specific")
+	}
+	if !IsCarrierSpecificForRegion(shortNumber(33, 40000), "FR") {
+		t.Errorf("expected 40000 to be carrier specific in FR")
+	}
+	if !IsSmsServiceForRegion(shortNumber(33, 400000), "FR") {
+		t.Errorf("expected 400000 to be an SMS service in FR")
+	}
+	if IsSmsServiceForRegion(shortNumber(33, 400000), "US") {
+		t.Errorf("expected 400000 not to be an SMS service in US")
+	}
+}
+
+func TestGetExampleShortNumber(t *testing.T) {
+	defer withTestShortNumberMetadata(t)()
+
+	if example := GetExampleShortNumber("FR"); example != "3010" {
+		t.Errorf("expected example 3010, got %s", example)
+	}
+	if example := GetExampleShortNumberForCost("FR", PREMIUM_RATE_COST); example != "3610" {
+		t.Errorf("expected premium rate example 3610, got %s", example)
+	}
+	if example := GetExampleShortNumberForCost("FR", UNKNOWN_COST); example != "" {
+		t.Errorf("expected no example for unknown cost, got %s", example)
+	}
+	if example := GetExampleShortNumber("ZZ"); example != "" {
+		t.Errorf("expected no example for ZZ, got %s", example)
+	}
+}
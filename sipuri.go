@@ -0,0 +1,109 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSIPURI is returned, wrapped with details, when a SIP URI has
+// no telephone number in its user part.
+var ErrInvalidSIPURI = errors.New("invalid SIP URI")
+
+const (
+	SIP_PREFIX          = "sip:"
+	SIPS_PREFIX         = "sips:"
+	SIP_USER_PHONE      = ";user=phone"
+	SIP_USER_SEPARATOR  = '@'
+	SIP_PASSWORD_PREFIX = ':'
+)
+
+func newSIPURIError(uri string, reason string) error {
+	return fmt.Errorf("%w %s: %s", ErrInvalidSIPURI, strconv.Quote(uri), reason)
+}
+
+// ParseSIPURI parses the telephone number in the user part of a sip: or
+// sips: URI such as "sip:+1-415-555-0100@example.com;user=phone". As
+// described by RFC 3261, the user part is a telephone-subscriber from
+// RFC 3966, so extensions and phone-context parameters are handled just
+// as Parse handles them for tel: URIs. Any password in the user info and
+// the parameters of the SIP URI itself are ignored.
+func ParseSIPURI(uri, defaultRegion string) (*PhoneNumber, error) {
+	return CurrentMetadata().ParseSIPURI(uri, defaultRegion)
+}
+
+// ParseSIPURI is the same as the package level ParseSIPURI, using this
+// metadata.
+func (md *Metadata) ParseSIPURI(uri, defaultRegion string) (*PhoneNumber, error) {
+	var rest string
+	switch {
+	case len(uri) >= len(SIP_PREFIX) && strings.EqualFold(uri[:len(SIP_PREFIX)], SIP_PREFIX):
+		rest = uri[len(SIP_PREFIX):]
+	case len(uri) >= len(SIPS_PREFIX) && strings.EqualFold(uri[:len(SIPS_PREFIX)], SIPS_PREFIX):
+		rest = uri[len(SIPS_PREFIX):]
+	default:
+		return nil, newSIPURIError(uri, "missing sip: or sips: scheme")
+	}
+
+	// the user info ends at the last @, any @ in it must be escaped
+	userEnd := strings.LastIndexByte(rest, SIP_USER_SEPARATOR)
+	if userEnd <= 0 || userEnd == len(rest)-1 {
+		return nil, newSIPURIError(uri, "missing user or host")
+	}
+	user := rest[:userEnd]
+	if passwordStart := strings.IndexByte(user, SIP_PASSWORD_PREFIX); passwordStart >= 0 {
+		user = user[:passwordStart]
+	}
+	user, err := url.PathUnescape(user)
+	if err != nil || user == "" {
+		return nil, newSIPURIError(uri, "invalid user")
+	}
+	return md.Parse(RFC3966_PREFIX+user, defaultRegion)
+}
+
+// FormatSIPURI formats the passed in number as a SIP URI on the passed in
+// host, for example "sip:+1-415-555-0100;ext=12@example.com;user=phone".
+// The user part is the number formatted as for RFC3966, including any
+// extension, and user=phone marks it as a telephone number.
+func FormatSIPURI(number *PhoneNumber, host string) string {
+	return CurrentMetadata().FormatSIPURI(number, host)
+}
+
+// FormatSIPURI is the same as the package level FormatSIPURI, using this
+// metadata.
+func (md *Metadata) FormatSIPURI(number *PhoneNumber, host string) string {
+	user := strings.TrimPrefix(md.Format(number, RFC3966), RFC3966_PREFIX)
+
+	uri := NewBuilderString(SIP_PREFIX)
+	uri.WriteString(escapeSIPUser(user))
+	uri.WriteRune(SIP_USER_SEPARATOR)
+	uri.WriteString(host)
+	uri.WriteString(SIP_USER_PHONE)
+	return uri.String()
+}
+
+// Percent-encodes the bytes of user which RFC 3261 doesn't allow in the
+// user part of a SIP URI.
+func escapeSIPUser(user string) string {
+	escaped := NewBuilder(nil)
+	for i := 0; i < len(user); i++ {
+		c := user[i]
+		if isSIPUserChar(c) {
+			escaped.WriteByte(c)
+		} else {
+			escaped.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return escaped.String()
+}
+
+// user = 1*( unreserved / escaped / user-unreserved )
+func isSIPUserChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("-_.!~*'()&=+$,;?/", c) >= 0
+}
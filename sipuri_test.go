@@ -0,0 +1,105 @@
+package phonenumbers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestParseSIPURI(t *testing.T) {
+	var tests = []struct {
+		input     string
+		region    string
+		cc        int32
+		nn        uint64
+		extension string
+	}{
+		{"sip:+14155550100@example.com;user=phone", "ZZ", 1, 4155550100, ""},
+		{"SIPS:+1-415-555-0100@example.com:5061;user=phone", "ZZ", 1, 4155550100, ""},
+		{"sip:+1-415-555-0100;ext=12@example.com;user=phone", "ZZ", 1, 4155550100, "12"},
+		{"sip:+44-20-7031-3000:secret@example.com;user=phone", "US", 44, 2070313000, ""},
+		{"sip:555-0100;phone-context=+1-415@example.com;user=phone", "ZZ", 1, 4155550100, ""},
+		{"sip:4155550100;phone-context=example.com@example.com;user=phone", "US", 1, 4155550100, ""},
+		{"sip:%2B14155550100;isub=1234@example.com", "ZZ", 1, 4155550100, ""},
+	}
+
+	for i, test := range tests {
+		number, err := ParseSIPURI(test.input, test.region)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if number.GetCountryCode() != test.cc || number.GetNationalNumber() != test.nn {
+			t.Errorf("[test %d:number] failed: %v != +%d %d\n", i, number, test.cc, test.nn)
+		}
+		if number.GetExtension() != test.extension {
+			t.Errorf("[test %d:extension] failed: %s != %s\n", i, number.GetExtension(), test.extension)
+		}
+	}
+}
+
+func TestParseSIPURIErrors(t *testing.T) {
+	var tests = []string{
+		"",
+		"tel:+14155550100",
+		"sip:example.com",
+		"sip:@example.com",
+		"sip:+14155550100@",
+		"sip::secret@example.com",
+	}
+
+	for i, test := range tests {
+		if _, err := ParseSIPURI(test, "US"); !errors.Is(err, ErrInvalidSIPURI) {
+			t.Errorf("[test %d:err] failed: %s, %v\n", i, test, err)
+		}
+	}
+
+	// users which aren't phone numbers, or have an empty or invalid
+	// phone-context, fail to parse as numbers
+	notNumbers := []string{
+		"sip:alice@example.com",
+		"sip:1234;phone-context=@example.com;user=phone",
+		"sip:1234;phone-context=;isub=1@example.com",
+		"sip:1234;phone-context=+@example.com",
+		"sip:1234;phone-context=a%7Bb%7Dc@example.com",
+	}
+	for i, test := range notNumbers {
+		if _, err := ParseSIPURI(test, "US"); !errors.Is(err, ErrNotANumber) {
+			t.Errorf("[test %d:not a number] failed: %s, %v\n", i, test, err)
+		}
+	}
+}
+
+func TestFormatSIPURI(t *testing.T) {
+	var tests = []struct {
+		number string
+		region string
+		host   string
+		output string
+	}{
+		{"+14155550100", "ZZ", "example.com", "sip:+1-415-555-0100@example.com;user=phone"},
+		{"+1 415 555 0100 ext. 12", "ZZ", "example.com:5060", "sip:+1-415-555-0100;ext=12@example.com:5060;user=phone"},
+		{"020 7031 3000", "GB", "10.0.0.1", "sip:+44-20-7031-3000@10.0.0.1;user=phone"},
+	}
+
+	for i, test := range tests {
+		number, err := Parse(test.number, test.region)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		output := FormatSIPURI(number, test.host)
+		if output != test.output {
+			t.Errorf("[test %d:output] failed: %s != %s\n", i, output, test.output)
+		}
+
+		// and back again
+		parsed, err := ParseSIPURI(output, "ZZ")
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+		} else if !proto.Equal(parsed, number) {
+			t.Errorf("[test %d:roundtrip] failed: %v != %v\n", i, parsed, number)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+package phonenumbers
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MetadataSource is where the upstream libphonenumber resources metadata is
+// built from are read, such as PhoneNumberMetadata.xml, timezones/map_data.txt
+// and the carrier and geocoding directories. Paths are slash separated and
+// relative to the resources directory.
+type MetadataSource interface {
+	// ReadFile returns the contents of the file at the passed in path
+	ReadFile(ctx context.Context, path string) ([]byte, error)
+
+	// ReadDir returns the sorted names of the entries of the directory at
+	// the passed in path, directories with a trailing slash
+	ReadDir(ctx context.Context, path string) ([]string, error)
+}
+
+// metadataSourceLoader is implemented by sources which load all their
+// resources at once, such as archives, so that they are loaded again for
+// each update and not kept once it is done.
+type metadataSourceLoader interface {
+	load(ctx context.Context) error
+	release()
+}
+
+// OpenMetadataSource returns the source for the passed in location, which
+// may be a local libphonenumber checkout or resources directory, a local
+// tarball or zip archive, the URL of an archive ending in .tar.gz, .tgz,
+// .tar or .zip, or the URL of an HTTP mirror of the resources directory.
+func OpenMetadataSource(location string) (MetadataSource, error) {
+	if isURL(location) {
+		if isArchiveName(location) {
+			return NewArchiveSource(location), nil
+		}
+		return NewHTTPSource(location), nil
+	}
+
+	info, err := os.Stat(location)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return NewDirectorySource(location), nil
+	}
+	return NewArchiveSource(location), nil
+}
+
+func isArchiveName(location string) bool {
+	location = strings.ToLower(location)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(location, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the passed in resource path cleaned of any leading slash and
+// parent directory references, "" for the resources directory itself.
+func cleanResourcePath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+type directorySource struct {
+	dir string
+}
+
+// NewDirectorySource returns a source which reads the resources of the
+// passed in local libphonenumber checkout, or the passed in resources
+// directory itself if it has no resources directory.
+func NewDirectorySource(dir string) MetadataSource {
+	resourcesDir := filepath.Join(dir, "resources")
+	if info, err := os.Stat(resourcesDir); err == nil && info.IsDir() {
+		dir = resourcesDir
+	}
+	return &directorySource{dir: dir}
+}
+
+func (s *directorySource) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(s.dir, filepath.FromSlash(cleanResourcePath(name))))
+}
+
+func (s *directorySource) ReadDir(ctx context.Context, name string) ([]string, error) {
+	infos, err := ioutil.ReadDir(filepath.Join(s.dir, filepath.FromSlash(cleanResourcePath(name))))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		if info.IsDir() {
+			names = append(names, info.Name()+"/")
+		} else {
+			names = append(names, info.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// the links of a directory listing page
+var HREF_PATTERN = regexp.MustCompile(`(?i)href\s*=\s*["']([^"'?#]+)["']`)
+
+type httpSource struct {
+	baseURL string
+}
+
+// NewHTTPSource returns a source which fetches resources from the passed in
+// URL of an HTTP mirror of the resources directory. The mirror must serve
+// listings of its directories linking to their entries, as the directory
+// listings of most web servers do.
+func NewHTTPSource(baseURL string) MetadataSource {
+	return &httpSource{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+func (s *httpSource) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	return fetchURL(ctx, s.baseURL+"/"+cleanResourcePath(name))
+}
+
+func (s *httpSource) ReadDir(ctx context.Context, name string) ([]string, error) {
+	dir := cleanResourcePath(name)
+	if dir != "" {
+		dir += "/"
+	}
+	body, err := fetchURL(ctx, s.baseURL+"/"+dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// only relative links to entries of the directory itself are entries
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, match := range HREF_PATTERN.FindAllSubmatch(body, -1) {
+		entry, err := url.PathUnescape(string(match[1]))
+		if err != nil || strings.Contains(entry, ":") || strings.HasPrefix(entry, "/") {
+			continue
+		}
+		entry = strings.TrimPrefix(entry, "./")
+		trimmed := strings.TrimSuffix(entry, "/")
+		if trimmed == "" || trimmed == "." || trimmed == ".." || strings.Contains(trimmed, "/") {
+			continue
+		}
+		if !seen[entry] {
+			seen[entry] = true
+			names = append(names, entry)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+type archiveSource struct {
+	location string
+
+	mutex sync.Mutex
+	files map[string][]byte
+	dirs  map[string][]string
+}
+
+// NewArchiveSource returns a source which reads resources from the passed
+// in tarball, optionally gzipped, or zip archive of a libphonenumber
+// checkout or resources directory, such as the archives GitHub serves for
+// each release. The location may be a local path or a URL. The archive is
+// read in full on first use.
+func NewArchiveSource(location string) MetadataSource {
+	return &archiveSource{location: location}
+}
+
+func (s *archiveSource) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	files, _, err := s.loaded(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := files[cleanResourcePath(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return data, nil
+}
+
+func (s *archiveSource) ReadDir(ctx context.Context, name string) ([]string, error) {
+	_, dirs, err := s.loaded(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names, ok := dirs[cleanResourcePath(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return names, nil
+}
+
+// Returns the resources of the archive, loading it if it isn't already.
+func (s *archiveSource) loaded(ctx context.Context) (map[string][]byte, map[string][]string, error) {
+	s.mutex.Lock()
+	files, dirs := s.files, s.dirs
+	s.mutex.Unlock()
+
+	if files == nil {
+		var err error
+		files, dirs, err = s.read(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.mutex.Lock()
+		s.files, s.dirs = files, dirs
+		s.mutex.Unlock()
+	}
+	return files, dirs, nil
+}
+
+func (s *archiveSource) load(ctx context.Context) error {
+	files, dirs, err := s.read(ctx)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.files, s.dirs = files, dirs
+	s.mutex.Unlock()
+	return nil
+}
+
+func (s *archiveSource) release() {
+	s.mutex.Lock()
+	s.files, s.dirs = nil, nil
+	s.mutex.Unlock()
+}
+
+// Returns the version of the archive, from the ETag or Last-Modified
+// header of its URL, or the modification time and size of its file, so
+// that it is only downloaded again once it changed. Returns "" if it has
+// neither.
+func (s *archiveSource) version(ctx context.Context) (string, error) {
+	if isURL(s.location) {
+		header, err := headURL(ctx, s.location)
+		if err != nil {
+			return "", err
+		}
+		if etag := header.Get("ETag"); etag != "" {
+			return s.location + " " + etag, nil
+		}
+		if lastModified := header.Get("Last-Modified"); lastModified != "" {
+			return s.location + " " + lastModified, nil
+		}
+		return "", nil
+	}
+
+	info, err := os.Stat(s.location)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %d %d", s.location, info.ModTime().UnixNano(), info.Size()), nil
+}
+
+// Reads the archive in a single pass, streaming it if it is a tarball,
+// returning the files under its resources directory which are read to
+// build metadata and the listings of its directories.
+func (s *archiveSource) read(ctx context.Context) (map[string][]byte, map[string][]string, error) {
+	var r io.ReadCloser
+	var err error
+	if isURL(s.location) {
+		r, err = openURL(ctx, s.location)
+	} else {
+		r, err = os.Open(s.location)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer r.Close()
+
+	// the resources directory is where the metadata is, which we only know
+	// once we've seen it, so the files of every directory they could be in
+	// are kept until then
+	var names []string
+	candidates := make(map[string]map[string][]byte)
+	err = walkArchive(r, func(name string, open func() ([]byte, error)) error {
+		names = append(names, name)
+
+		var contents []byte
+		for i := 0; i < len(name); i++ {
+			if i > 0 && name[i-1] != '/' {
+				continue
+			}
+			root, resource := name[:i], name[i:]
+			if !isArchiveResource(resource) {
+				continue
+			}
+			if contents == nil {
+				if contents, err = open(); err != nil {
+					return err
+				}
+			}
+			if candidates[root] == nil {
+				candidates[root] = make(map[string][]byte)
+			}
+			candidates[root][resource] = contents
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := ""
+	found := false
+	for candidate, files := range candidates {
+		if _, ok := files[metadataPath]; ok && (!found || len(candidate) < len(root)) {
+			root, found = candidate, true
+		}
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("no %s in archive %s", metadataPath, s.location)
+	}
+
+	dirs := map[string][]string{"": nil}
+	for _, name := range names {
+		if !strings.HasPrefix(name, root) {
+			continue
+		}
+		name = name[len(root):]
+
+		// add the file and its parents to the listings of their directories
+		entry := path.Base(name)
+		for dir := path.Dir(name); ; dir = path.Dir(dir) {
+			if dir == "." {
+				dir = ""
+			}
+			_, listed := dirs[dir]
+			dirs[dir] = append(dirs[dir], entry)
+			if listed {
+				break
+			}
+			entry = path.Base(dir) + "/"
+		}
+	}
+	for _, names := range dirs {
+		sort.Strings(names)
+	}
+	return candidates[root], dirs, nil
+}
+
+// Returns whether the passed in path, relative to the resources directory,
+// is of a file read to build metadata.
+func isArchiveResource(name string) bool {
+	switch name {
+	case metadataPath, alternateFormatsPath, shortNumberPath, tzPath:
+		return true
+	}
+	parts := strings.Split(name, "/")
+	return len(parts) == 3 && (parts[0] == carrier.dir || parts[0] == geocoding.dir) &&
+		parts[1] != "" && strings.HasSuffix(parts[2], ".txt")
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// Calls the passed in function with the name of each regular file in the
+// passed in archive, and a function returning its contents which can be
+// called once. Files outside the archive's top directory are skipped.
+// Tarballs are streamed, zip archives are read in full as their index is
+// at their end.
+func walkArchive(r io.Reader, fn func(name string, open func() ([]byte, error)) error) error {
+	buffered := bufio.NewReader(r)
+	magic, _ := buffered.Peek(4)
+
+	if bytes.HasPrefix(magic, []byte("PK\x03\x04")) {
+		data, err := ioutil.ReadAll(buffered)
+		if err != nil {
+			return err
+		}
+		reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return err
+		}
+		for _, file := range reader.File {
+			if file.FileInfo().IsDir() {
+				continue
+			}
+			name, ok := archiveFileName(file.Name)
+			if !ok {
+				continue
+			}
+			file := file
+			err := fn(name, func() ([]byte, error) {
+				r, err := file.Open()
+				if err != nil {
+					return nil, err
+				}
+				defer r.Close()
+				return ioutil.ReadAll(r)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var tarReader io.Reader = buffered
+	if bytes.HasPrefix(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		tarReader = gz
+	}
+	reader := tar.NewReader(tarReader)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name, ok := archiveFileName(header.Name)
+		if !ok || !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		err = fn(name, func() ([]byte, error) {
+			return ioutil.ReadAll(reader)
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Returns the passed in archive file name cleaned, and whether it is inside
+// the archive's top directory.
+func archiveFileName(name string) (string, bool) {
+	name = path.Clean(name)
+	if name == ".." || strings.HasPrefix(name, "../") || strings.HasPrefix(name, "/") {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,219 @@
+package phonenumbers
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// metadata for a single region, GB, with a fixed line example number
+const testMetadataXML = `<phoneNumberMetadata><territories>
+  <territory id="GB" countryCode="44" internationalPrefix="00" nationalPrefix="0">
+    <generalDesc><nationalNumberPattern>[1-9]\d{9}</nationalNumberPattern></generalDesc>
+    <fixedLine>
+      <possibleLengths national="10"/>
+      <exampleNumber>1212345678</exampleNumber>
+      <nationalNumberPattern>[1-3]\d{9}</nationalNumberPattern>
+    </fixedLine>
+  </territory>
+</territories></phoneNumberMetadata>`
+
+// the resources of our test sources
+var testResources = map[string]string{
+	"PhoneNumberMetadata.xml":         testMetadataXML,
+	"PhoneNumberAlternateFormats.xml": "<phoneNumberMetadata/>",
+	"ShortNumberMetadata.xml":         "<phoneNumberMetadata/>",
+	"timezones/map_data.txt":          "# timezones\n1|America/New_York\n",
+	"carrier/en/1.txt":                "1212|Verizon\n",
+	"carrier/en/44.txt":               "447700|Vodafone\n",
+	"carrier/de/49.txt":               "49151|T-Mobile\n",
+	"carrier/README":                  "not a mapping",
+	"geocoding/en/44.txt":             "4420|London\n",
+}
+
+func writeTestResources(t *testing.T, dir string) {
+	for name, contents := range testResources {
+		fullName := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fullName), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fullName, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testTarGz(t *testing.T, prefix string) []byte {
+	data := &bytes.Buffer{}
+	gz := gzip.NewWriter(data)
+	writer := tar.NewWriter(gz)
+	for name, contents := range testResources {
+		err := writer.WriteHeader(&tar.Header{Name: prefix + name, Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := writer.Write([]byte(contents)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// files outside the resources directory are ignored
+	if err := writer.WriteHeader(&tar.Header{Name: prefix + "../README.md", Mode: 0644, Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return data.Bytes()
+}
+
+func testZip(t *testing.T, prefix string) []byte {
+	data := &bytes.Buffer{}
+	writer := zip.NewWriter(data)
+	for name, contents := range testResources {
+		w, err := writer.Create(prefix + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(contents)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return data.Bytes()
+}
+
+func TestMetadataSources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a checkout, a resources directory and archives of both
+	writeTestResources(t, filepath.Join(dir, "libphonenumber", "resources"))
+	writeTestResources(t, filepath.Join(dir, "resources"))
+	archives := map[string][]byte{
+		"checkout.tar.gz": testTarGz(t, "libphonenumber-master/resources/"),
+		"resources.tgz":   testTarGz(t, "./"),
+		"checkout.zip":    testZip(t, "libphonenumber-master/resources/"),
+	}
+	for name, data := range archives {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// and a mirror of the resources directory
+	server := httptest.NewServer(http.FileServer(http.Dir(filepath.Join(dir, "resources"))))
+	defer server.Close()
+
+	archiveServer := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer archiveServer.Close()
+
+	sources := []string{
+		filepath.Join(dir, "libphonenumber"),
+		filepath.Join(dir, "resources"),
+		filepath.Join(dir, "checkout.tar.gz"),
+		filepath.Join(dir, "resources.tgz"),
+		filepath.Join(dir, "checkout.zip"),
+		server.URL,
+		server.URL + "/",
+		archiveServer.URL + "/checkout.tar.gz",
+	}
+
+	ctx := context.Background()
+	for i, location := range sources {
+		source, err := OpenMetadataSource(location)
+		if err != nil {
+			t.Errorf("[test %d:open] failed: %v\n", i, err)
+			continue
+		}
+
+		for name, expected := range testResources {
+			contents, err := source.ReadFile(ctx, name)
+
+			// archives only keep the files metadata is built from
+			if _, isArchive := source.(*archiveSource); isArchive && !isArchiveResource(name) {
+				if err == nil {
+					t.Errorf("[test %d:unread] failed: %s: expected error\n", i, name)
+				}
+				continue
+			}
+			if err != nil || string(contents) != expected {
+				t.Errorf("[test %d:read] failed: %s: %q, %v != %q\n", i, name, contents, err, expected)
+			}
+		}
+		if _, err := source.ReadFile(ctx, "missing.xml"); err == nil {
+			t.Errorf("[test %d:missing] failed: expected error\n", i)
+		}
+
+		entries, err := source.ReadDir(ctx, "")
+		expected := []string{"PhoneNumberAlternateFormats.xml", "PhoneNumberMetadata.xml", "ShortNumberMetadata.xml", "carrier/", "geocoding/", "timezones/"}
+		if err != nil || !reflect.DeepEqual(entries, expected) {
+			t.Errorf("[test %d:root] failed: %v, %v != %v\n", i, entries, err, expected)
+		}
+		entries, err = source.ReadDir(ctx, "carrier")
+		expected = []string{"README", "de/", "en/"}
+		if err != nil || !reflect.DeepEqual(entries, expected) {
+			t.Errorf("[test %d:carrier] failed: %v, %v != %v\n", i, entries, err, expected)
+		}
+		entries, err = source.ReadDir(ctx, "carrier/en/")
+		expected = []string{"1.txt", "44.txt"}
+		if err != nil || !reflect.DeepEqual(entries, expected) {
+			t.Errorf("[test %d:carrier/en] failed: %v, %v != %v\n", i, entries, err, expected)
+		}
+	}
+
+	if _, err := OpenMetadataSource(filepath.Join(dir, "missing")); err == nil {
+		t.Errorf("[test missing] failed: expected error\n")
+	}
+	if _, err := NewArchiveSource(filepath.Join(dir, "resources", "PhoneNumberMetadata.xml")).ReadFile(ctx, "PhoneNumberMetadata.xml"); err == nil {
+		t.Errorf("[test not archive] failed: expected error\n")
+	}
+}
+
+func TestBuildPrefixDataFromSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestResources(t, dir)
+
+	data, err := buildPrefixData(context.Background(), NewDirectorySource(dir), &carrier, testLogger{t})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]map[int]string{
+		"en": {1212: "Verizon", 447700: "Vodafone"},
+		"de": {49151: "T-Mobile"},
+	}
+	if len(data) != len(expected) {
+		t.Errorf("[test languages] failed: %d != %d\n", len(data), len(expected))
+	}
+	for lang, mappings := range expected {
+		prefixMap, err := loadPrefixMap(data[lang])
+		if err != nil {
+			t.Errorf("[test %s:load] failed: %v\n", lang, err)
+			continue
+		}
+		if !reflect.DeepEqual(prefixMap.Map, mappings) {
+			t.Errorf("[test %s:map] failed: %v != %v\n", lang, prefixMap.Map, mappings)
+		}
+	}
+}
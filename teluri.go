@@ -0,0 +1,248 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidTelURI is returned, wrapped with details, when a tel: URI
+// does not follow the RFC 3966 syntax.
+var ErrInvalidTelURI = errors.New("invalid tel URI")
+
+const (
+	RFC3966_PARAM_EXTENSION       = "ext"
+	RFC3966_PARAM_ISDN_SUBADDRESS = "isub"
+	RFC3966_PARAM_PHONE_CONTEXT   = "phone-context"
+)
+
+var (
+	// global-number-digits = "+" *phonedigit DIGIT *phonedigit
+	RFC3966_GLOBAL_NUMBER_DIGITS_PATTERN = regexp.MustCompile(`^\+[0-9().\-]*[0-9][0-9().\-]*$`)
+
+	// local-number-digits = *phonedigit-hex (HEXDIG / "*" / "#") *phonedigit-hex
+	RFC3966_LOCAL_NUMBER_DIGITS_PATTERN = regexp.MustCompile(`^[0-9A-Fa-f*#().\-]*[0-9A-Fa-f*#][0-9A-Fa-f*#().\-]*$`)
+
+	// extension = ";ext=" 1*phonedigit
+	RFC3966_EXTENSION_PATTERN = regexp.MustCompile(`^[0-9().\-]*[0-9][0-9().\-]*$`)
+
+	// domainname = *( domainlabel "." ) toplabel [ "." ]
+	RFC3966_DOMAINNAME_PATTERN = regexp.MustCompile(
+		`^(?:[A-Za-z0-9](?:[A-Za-z0-9\-]*[A-Za-z0-9])?\.)*[A-Za-z](?:[A-Za-z0-9\-]*[A-Za-z0-9])?\.?$`)
+
+	// pname = 1*( alphanum / "-" )
+	RFC3966_PARAM_NAME_PATTERN = regexp.MustCompile(`^[A-Za-z0-9\-]+$`)
+
+	// pvalue = 1*paramchar
+	RFC3966_PARAM_VALUE_PATTERN = regexp.MustCompile(`^(?:[\[\]/:&+$A-Za-z0-9\-_.!~*'()]|%[0-9A-Fa-f]{2})+$`)
+
+	// isdn-subaddress = ";isub=" 1*uric
+	RFC3966_URIC_PATTERN = regexp.MustCompile(`^(?:[;/?:@&=+$,A-Za-z0-9\-_.!~*'()]|%[0-9A-Fa-f]{2})+$`)
+)
+
+// TelURIParam is a parameter of a tel: URI other than the ones with their
+// own field in TelURI.
+type TelURIParam struct {
+	// Name is the parameter name, which is compared case-insensitively
+	Name string
+
+	// Value is the decoded parameter value, empty for parameters without one
+	Value string
+}
+
+// TelURI is a tel: URI as described by RFC 3966. Global numbers start
+// with a "+" and have no phone context, local numbers must have a phone
+// context which is either a domain name or the global number digits
+// that prefix them.
+//
+//	uri, err := phonenumbers.ParseTelURI("tel:7042;phone-context=example.com")
+//	// uri.Number is "7042", uri.PhoneContext is "example.com"
+type TelURI struct {
+	// Number is the global or local number, including visual separators
+	Number string
+
+	// PhoneContext is the phone-context of a local number
+	PhoneContext string
+
+	// Extension is the ext parameter, including visual separators
+	Extension string
+
+	// ISDNSubaddress is the decoded isub parameter
+	ISDNSubaddress string
+
+	// Params are any other parameters, in the order they appeared
+	Params []TelURIParam
+}
+
+func newTelURIError(uri string, format string, args ...interface{}) error {
+	return fmt.Errorf("%w %s: %s", ErrInvalidTelURI, strconv.Quote(uri), fmt.Sprintf(format, args...))
+}
+
+// ParseTelURI parses a tel: URI, validating it against the RFC 3966
+// syntax. Percent-encoded parameter values are decoded.
+func ParseTelURI(uri string) (*TelURI, error) {
+	if len(uri) < len(RFC3966_PREFIX) || !strings.EqualFold(uri[:len(RFC3966_PREFIX)], RFC3966_PREFIX) {
+		return nil, newTelURIError(uri, "missing %s scheme", RFC3966_PREFIX)
+	}
+	parts := strings.Split(uri[len(RFC3966_PREFIX):], ";")
+
+	telURI := &TelURI{Number: parts[0]}
+	if !telURI.IsGlobal() && !RFC3966_LOCAL_NUMBER_DIGITS_PATTERN.MatchString(telURI.Number) {
+		return nil, newTelURIError(uri, "invalid local number %q", telURI.Number)
+	} else if telURI.IsGlobal() && !RFC3966_GLOBAL_NUMBER_DIGITS_PATTERN.MatchString(telURI.Number) {
+		return nil, newTelURIError(uri, "invalid global number %q", telURI.Number)
+	}
+
+	seen := make(map[string]bool, len(parts)-1)
+	for _, part := range parts[1:] {
+		name, value := part, ""
+		hasValue := false
+		if i := strings.Index(part, "="); i >= 0 {
+			name, value, hasValue = part[:i], part[i+1:], true
+		}
+		if !RFC3966_PARAM_NAME_PATTERN.MatchString(name) {
+			return nil, newTelURIError(uri, "invalid parameter name %q", name)
+		}
+		lowerName := strings.ToLower(name)
+		if seen[lowerName] {
+			return nil, newTelURIError(uri, "repeated parameter %q", name)
+		}
+		seen[lowerName] = true
+
+		switch lowerName {
+		case RFC3966_PARAM_EXTENSION:
+			if !RFC3966_EXTENSION_PATTERN.MatchString(value) {
+				return nil, newTelURIError(uri, "invalid extension %q", value)
+			}
+			telURI.Extension = value
+		case RFC3966_PARAM_ISDN_SUBADDRESS:
+			if !RFC3966_URIC_PATTERN.MatchString(value) {
+				return nil, newTelURIError(uri, "invalid isdn-subaddress %q", value)
+			}
+			decoded, err := url.PathUnescape(value)
+			if err != nil {
+				return nil, newTelURIError(uri, "invalid isdn-subaddress %q", value)
+			}
+			telURI.ISDNSubaddress = decoded
+		case RFC3966_PARAM_PHONE_CONTEXT:
+			if !RFC3966_GLOBAL_NUMBER_DIGITS_PATTERN.MatchString(value) && !RFC3966_DOMAINNAME_PATTERN.MatchString(value) {
+				return nil, newTelURIError(uri, "invalid phone-context %q", value)
+			}
+			telURI.PhoneContext = value
+		default:
+			if hasValue && !RFC3966_PARAM_VALUE_PATTERN.MatchString(value) {
+				return nil, newTelURIError(uri, "invalid value for parameter %q", name)
+			}
+			decoded, err := url.PathUnescape(value)
+			if err != nil {
+				return nil, newTelURIError(uri, "invalid value for parameter %q", name)
+			}
+			telURI.Params = append(telURI.Params, TelURIParam{Name: name, Value: decoded})
+		}
+	}
+
+	if telURI.Extension != "" && telURI.ISDNSubaddress != "" {
+		return nil, newTelURIError(uri, "extension and isdn-subaddress can't be used together")
+	}
+	if telURI.IsGlobal() && telURI.PhoneContext != "" {
+		return nil, newTelURIError(uri, "global numbers can't have a phone-context")
+	} else if !telURI.IsGlobal() && telURI.PhoneContext == "" {
+		return nil, newTelURIError(uri, "local numbers must have a phone-context")
+	}
+	return telURI, nil
+}
+
+// NewTelURI returns the tel: URI for the passed in number, which is a
+// global number formatted as for Format(number, RFC3966).
+func NewTelURI(number *PhoneNumber) *TelURI {
+	return CurrentMetadata().NewTelURI(number)
+}
+
+// NewTelURI is the same as the package level NewTelURI, using this
+// metadata.
+func (md *Metadata) NewTelURI(number *PhoneNumber) *TelURI {
+	telURI, err := ParseTelURI(md.Format(number, RFC3966))
+	if err != nil {
+		// fall back to an unformatted global number
+		return &TelURI{
+			Number:    "+" + strconv.Itoa(int(number.GetCountryCode())) + GetNationalSignificantNumber(number),
+			Extension: NormalizeDigitsOnly(number.GetExtension()),
+		}
+	}
+	return telURI
+}
+
+// IsGlobal returns whether this URI has a global number, that is one
+// starting with the country calling code.
+func (u *TelURI) IsGlobal() bool {
+	return strings.HasPrefix(u.Number, "+")
+}
+
+// ToPhoneNumber parses the number of this URI. The country calling code
+// comes from global numbers and phone contexts which are global number
+// digits, otherwise the default region is used.
+func (u *TelURI) ToPhoneNumber(defaultRegion string) (*PhoneNumber, error) {
+	return CurrentMetadata().TelURIToPhoneNumber(u, defaultRegion)
+}
+
+// TelURIToPhoneNumber is the same as ToPhoneNumber of the passed in URI,
+// using this metadata.
+func (md *Metadata) TelURIToPhoneNumber(u *TelURI, defaultRegion string) (*PhoneNumber, error) {
+	number := u.Number
+	if !u.IsGlobal() && strings.HasPrefix(u.PhoneContext, "+") {
+		number = u.PhoneContext + number
+	}
+	if u.Extension != "" {
+		number += RFC3966_EXTN_PREFIX + u.Extension
+	}
+	return md.Parse(number, defaultRegion)
+}
+
+// String returns the URI in the form recommended by RFC 3966: the
+// isdn-subaddress or extension first, then the phone context, followed
+// by the other parameters in the order they were added.
+func (u *TelURI) String() string {
+	uri := NewBuilderString(RFC3966_PREFIX)
+	uri.WriteString(u.Number)
+	if u.ISDNSubaddress != "" {
+		uri.WriteString(RFC3966_ISDN_SUBADDRESS)
+		uri.WriteString(escapeTelURIValue(u.ISDNSubaddress, RFC3966_URIC_PATTERN))
+	}
+	if u.Extension != "" {
+		uri.WriteString(RFC3966_EXTN_PREFIX)
+		uri.WriteString(u.Extension)
+	}
+	if u.PhoneContext != "" {
+		uri.WriteString(RFC3966_PHONE_CONTEXT)
+		uri.WriteString(u.PhoneContext)
+	}
+	for _, param := range u.Params {
+		uri.WriteString(";")
+		uri.WriteString(param.Name)
+		if param.Value != "" {
+			uri.WriteString("=")
+			uri.WriteString(escapeTelURIValue(param.Value, RFC3966_PARAM_VALUE_PATTERN))
+		}
+	}
+	return uri.String()
+}
+
+// Percent-encodes every byte of value which isn't allowed unescaped by
+// the passed in pattern, which must match a single allowed character.
+// ";", "=" and "?" are always encoded as they separate parameters and
+// their values.
+func escapeTelURIValue(value string, allowed *regexp.Regexp) string {
+	escaped := NewBuilder(nil)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if !strings.ContainsRune("%;=?", rune(c)) && c < 0x80 && allowed.MatchString(string(c)) {
+			escaped.WriteByte(c)
+		} else {
+			escaped.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,201 @@
+package phonenumbers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestParseTelURI(t *testing.T) {
+	var tests = []struct {
+		input  string
+		uri    TelURI
+		output string
+	}{
+		{
+			input:  "tel:+1-201-555-0123",
+			uri:    TelURI{Number: "+1-201-555-0123"},
+			output: "tel:+1-201-555-0123",
+		}, {
+			input:  "TEL:+44(20)7031.3000;ext=1234",
+			uri:    TelURI{Number: "+44(20)7031.3000", Extension: "1234"},
+			output: "tel:+44(20)7031.3000;ext=1234",
+		}, {
+			input:  "tel:7042;phone-context=example.com",
+			uri:    TelURI{Number: "7042", PhoneContext: "example.com"},
+			output: "tel:7042;phone-context=example.com",
+		}, {
+			input:  "tel:863-1234;phone-context=+1-914-555",
+			uri:    TelURI{Number: "863-1234", PhoneContext: "+1-914-555"},
+			output: "tel:863-1234;phone-context=+1-914-555",
+		}, {
+			// parameters are written in the order recommended by RFC 3966
+			input:  "tel:*86#;foo=bar;phone-context=example.com;isub=a%20b",
+			uri:    TelURI{Number: "*86#", PhoneContext: "example.com", ISDNSubaddress: "a b", Params: []TelURIParam{{"foo", "bar"}}},
+			output: "tel:*86#;isub=a%20b;phone-context=example.com;foo=bar",
+		}, {
+			input:  "tel:+33123456789;Cic=%2B1234;flag",
+			uri:    TelURI{Number: "+33123456789", Params: []TelURIParam{{"Cic", "+1234"}, {"flag", ""}}},
+			output: "tel:+33123456789;Cic=+1234;flag",
+		}, {
+			input:  "tel:+1234;x=%C3%A9",
+			uri:    TelURI{Number: "+1234", Params: []TelURIParam{{"x", "é"}}},
+			output: "tel:+1234;x=%C3%A9",
+		}, {
+			// separators in values stay encoded
+			input:  "tel:+1-201-555-0123;isub=a%3Bb%3Dc%3F;x=%3B",
+			uri:    TelURI{Number: "+1-201-555-0123", ISDNSubaddress: "a;b=c?", Params: []TelURIParam{{"x", ";"}}},
+			output: "tel:+1-201-555-0123;isub=a%3Bb%3Dc%3F;x=%3B",
+		},
+	}
+
+	for i, test := range tests {
+		uri, err := ParseTelURI(test.input)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(*uri, test.uri) {
+			t.Errorf("[test %d:uri] failed: %+v != %+v\n", i, *uri, test.uri)
+		}
+		if output := uri.String(); output != test.output {
+			t.Errorf("[test %d:output] failed: %s != %s\n", i, output, test.output)
+		}
+
+		// the output parses back to the same URI
+		reparsed, err := ParseTelURI(uri.String())
+		if err != nil || !reflect.DeepEqual(reparsed, uri) {
+			t.Errorf("[test %d:reparse] failed: %+v, %v != %+v\n", i, reparsed, err, *uri)
+		}
+	}
+}
+
+func TestParseTelURIErrors(t *testing.T) {
+	var tests = []string{
+		"",
+		"sip:+1234",
+		"tel:",
+		"tel:+",
+		"tel:+12a4",
+		"tel:12 34;phone-context=example.com",
+		"tel:1234",
+		"tel:+1234;phone-context=example.com",
+		"tel:1234;phone-context=-example.com",
+		"tel:1234;phone-context=+",
+		"tel:+1234;ext=",
+		"tel:+1234;ext=12a",
+		"tel:+1234;ext=1;isub=2",
+		"tel:+1234;ext=1;EXT=2",
+		"tel:+1234;foo=a b",
+		"tel:+1234;foo=%zz",
+		"tel:+1234;f_o=1",
+		"tel:+1234;=1",
+	}
+
+	for i, test := range tests {
+		_, err := ParseTelURI(test)
+		if !errors.Is(err, ErrInvalidTelURI) {
+			t.Errorf("[test %d:err] failed: %s, %v\n", i, test, err)
+		}
+	}
+}
+
+func TestTelURIPhoneNumber(t *testing.T) {
+	var tests = []struct {
+		input  string
+		region string
+		output string
+	}{
+		{"tel:+1-650-253-0000;ext=12", "US", "tel:+1-650-253-0000;ext=12"},
+		{"tel:253-0000;phone-context=+1-650", "ZZ", "tel:+1-650-253-0000"},
+		{"tel:253-0000;phone-context=+1-650;isub=12", "ZZ", "tel:+1-650-253-0000"},
+		{"tel:650-253-0000;phone-context=example.com", "US", "tel:+1-650-253-0000"},
+		{"tel:+44-20-7031-3000", "US", "tel:+44-20-7031-3000"},
+	}
+
+	for i, test := range tests {
+		uri, err := ParseTelURI(test.input)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		number, err := uri.ToPhoneNumber(test.region)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		if output := NewTelURI(number).String(); output != test.output {
+			t.Errorf("[test %d:output] failed: %s != %s\n", i, output, test.output)
+		}
+		if bound, err := CurrentMetadata().TelURIToPhoneNumber(uri, test.region); err != nil || !proto.Equal(bound, number) {
+			t.Errorf("[test %d:metadata] failed: %v, %v != %v\n", i, bound, err, number)
+		}
+	}
+}
+
+func TestTelURIPhoneNumberWithMetadata(t *testing.T) {
+	// metadata without +1 can't parse North American numbers
+	collection := &PhoneMetadataCollection{}
+	for _, metadata := range getCurrMetadataColl().GetMetadata() {
+		if metadata.GetCountryCode() != 1 {
+			collection.Metadata = append(collection.Metadata, metadata)
+		}
+	}
+	util, err := NewUtil(collection, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uri, err := ParseTelURI("tel:+1-650-253-0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uri.ToPhoneNumber("ZZ"); err != nil {
+		t.Errorf("[test current] failed: %v\n", err)
+	}
+	if _, err := util.TelURIToPhoneNumber(uri, "ZZ"); !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("[test util] failed: %v != %v\n", err, ErrInvalidCountryCode)
+	}
+}
+
+func TestParseRFC3966PhoneContext(t *testing.T) {
+	// the isdn-subaddress after the phone-context is ignored
+	number, err := Parse("tel:253-0000;phone-context=+1-650;isub=12", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number.GetCountryCode() != 1 || number.GetNationalNumber() != 6502530000 {
+		t.Errorf("expected +1 6502530000, got %v", number)
+	}
+}
+
+func TestParseRFC3966InvalidPhoneContext(t *testing.T) {
+	var tests = []string{
+		"1234;phone-context=",
+		"tel:1234;phone-context=",
+		"tel:1234;phone-context=;isub=12",
+		"tel:1234;phone-context=+",
+		"tel:1234;phone-context=64",
+		"tel:1234;phone-context=++64",
+		"tel:1234;phone-context=+abc",
+		"tel:1234;phone-context=.",
+		"tel:1234;phone-context=3phone",
+		"tel:1234;phone-context=a-.nz",
+		"tel:1234;phone-context=a{b}c",
+	}
+
+	for i, test := range tests {
+		if _, err := Parse(test, "US"); !errors.Is(err, ErrNotANumber) {
+			t.Errorf("[test %d:err] failed: %s, %v\n", i, test, err)
+		}
+	}
+
+	// valid phone-contexts are still accepted, with or without tel:
+	for i, test := range []string{"253-0000;phone-context=+1-650", "tel:650-253-0000;phone-context=example.com."} {
+		if number, err := Parse(test, "US"); err != nil || number.GetNationalNumber() != 6502530000 {
+			t.Errorf("[test %d:valid] failed: %s, %v\n", i, test, err)
+		}
+	}
+}
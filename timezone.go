@@ -0,0 +1,143 @@
+package phonenumbers
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrUnknownTimezone is returned when we don't know any timezone for a number
+var ErrUnknownTimezone = errors.New("unknown timezone for number")
+
+var (
+	// locations by timezone name, loaded on first use
+	locations      = make(map[string]*time.Location)
+	locationsMutex sync.Mutex
+)
+
+// Returns the location for the passed in IANA timezone name, loading it if
+// this is its first use.
+func loadLocation(name string) (*time.Location, error) {
+	locationsMutex.Lock()
+	defer locationsMutex.Unlock()
+
+	location, ok := locations[name]
+	if !ok {
+		var err error
+		location, err = time.LoadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+		locations[name] = location
+	}
+	return location, nil
+}
+
+// GetLocationsForNumber returns the locations of the timezones which we
+// believe map to the passed in number. Numbers we don't know the timezone
+// of have no locations. Returns an error if a timezone can't be loaded,
+// such as when the system has no timezone database.
+func GetLocationsForNumber(number *PhoneNumber) ([]*time.Location, error) {
+	return CurrentMetadata().GetLocationsForNumber(number)
+}
+
+// GetLocationsForNumber is the same as the package level
+// GetLocationsForNumber, using this metadata.
+func (md *Metadata) GetLocationsForNumber(number *PhoneNumber) ([]*time.Location, error) {
+	timezones, err := md.GetTimezonesForNumber(number)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*time.Location, 0, len(timezones))
+	for _, timezone := range timezones {
+		if timezone == UNKNOWN_TIMEZONE {
+			continue
+		}
+		location, err := loadLocation(timezone)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, location)
+	}
+	return result, nil
+}
+
+// GetLocalTimeRangeForNumber returns the passed in time in the timezones of
+// the passed in number with the earliest and the latest local wall-clock
+// time, which are the same for numbers with a single timezone. Returns
+// ErrUnknownTimezone if we don't know the timezone of the number.
+func GetLocalTimeRangeForNumber(number *PhoneNumber, t time.Time) (time.Time, time.Time, error) {
+	return CurrentMetadata().GetLocalTimeRangeForNumber(number, t)
+}
+
+// GetLocalTimeRangeForNumber is the same as the package level
+// GetLocalTimeRangeForNumber, using this metadata.
+func (md *Metadata) GetLocalTimeRangeForNumber(number *PhoneNumber, t time.Time) (time.Time, time.Time, error) {
+	locations, err := md.GetLocationsForNumber(number)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if len(locations) == 0 {
+		return time.Time{}, time.Time{}, ErrUnknownTimezone
+	}
+
+	var earliest, latest time.Time
+	for i, location := range locations {
+		local := t.In(location)
+		if i == 0 || wallClock(local).Before(wallClock(earliest)) {
+			earliest = local
+		}
+		if i == 0 || wallClock(local).After(wallClock(latest)) {
+			latest = local
+		}
+	}
+	return earliest, latest, nil
+}
+
+// IsWithinLocalTimeWindow returns whether the passed in time falls inside
+// the window from start to end, as durations since local midnight, in every
+// timezone of the passed in number. The window includes its start but not
+// its end, and wraps past midnight when start is after end, so a window of
+// 9h to 21h is daytime and 21h to 9h is nighttime. Returns
+// ErrUnknownTimezone if we don't know the timezone of the number.
+func IsWithinLocalTimeWindow(number *PhoneNumber, t time.Time, start, end time.Duration) (bool, error) {
+	return CurrentMetadata().IsWithinLocalTimeWindow(number, t, start, end)
+}
+
+// IsWithinLocalTimeWindow is the same as the package level
+// IsWithinLocalTimeWindow, using this metadata.
+func (md *Metadata) IsWithinLocalTimeWindow(number *PhoneNumber, t time.Time, start, end time.Duration) (bool, error) {
+	locations, err := md.GetLocationsForNumber(number)
+	if err != nil {
+		return false, err
+	}
+	if len(locations) == 0 {
+		return false, ErrUnknownTimezone
+	}
+
+	for _, location := range locations {
+		local := t.In(location)
+		timeOfDay := time.Duration(local.Hour())*time.Hour +
+			time.Duration(local.Minute())*time.Minute +
+			time.Duration(local.Second())*time.Second +
+			time.Duration(local.Nanosecond())
+
+		var inside bool
+		if start <= end {
+			inside = timeOfDay >= start && timeOfDay < end
+		} else {
+			inside = timeOfDay >= start || timeOfDay < end
+		}
+		if !inside {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Returns the wall-clock time of the passed in time as if it were UTC, so
+// that the local times of different timezones can be compared.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
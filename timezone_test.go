@@ -0,0 +1,131 @@
+package phonenumbers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetLocationsForNumber(t *testing.T) {
+	if getTimezoneMap().MaxLength == 0 {
+		t.Skip("built without timezone data")
+	}
+	tests := []struct {
+		num      string
+		expected []string
+	}{
+		{"+15167706076", []string{"America/New_York"}},
+		{"+442073238299", []string{"Europe/London"}},
+		{"+61491570156", []string{"Australia/Adelaide", "Australia/Eucla", "Australia/Lord_Howe", "Australia/Perth", "Australia/Sydney", "Indian/Christmas", "Indian/Cocos"}},
+		{"+80012345678", []string{}},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.num, "")
+		if err != nil {
+			t.Errorf("[test %d:parse] failed: %v\n", i, err)
+			continue
+		}
+		locations, err := GetLocationsForNumber(num)
+		if err != nil {
+			t.Errorf("[test %d:err] failed: %v\n", i, err)
+			continue
+		}
+		names := make([]string, len(locations))
+		for j, location := range locations {
+			names[j] = location.String()
+		}
+		if len(names) != len(test.expected) {
+			t.Errorf("[test %d:len] failed: %v != %v\n", i, names, test.expected)
+			continue
+		}
+		for j := range names {
+			if names[j] != test.expected[j] {
+				t.Errorf("[test %d:name] failed: %v != %v\n", i, names, test.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestGetLocalTimeRangeForNumber(t *testing.T) {
+	if getTimezoneMap().MaxLength == 0 {
+		t.Skip("built without timezone data")
+	}
+	tests := []struct {
+		num      string
+		at       time.Time
+		earliest string
+		latest   string
+		err      error
+	}{
+		{"+15167706076", time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), "09:00", "09:00", nil},
+		{"+15167706076", time.Date(2024, 7, 15, 14, 0, 0, 0, time.UTC), "10:00", "10:00", nil},
+		// Cocos Islands are UTC+6:30, Sydney and Lord Howe are UTC+11 in summer
+		{"+61491570156", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "06:30", "11:00", nil},
+		// spanning midnight
+		{"+61491570156", time.Date(2024, 1, 15, 15, 0, 0, 0, time.UTC), "21:30", "02:00", nil},
+		{"+80012345678", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "", "", ErrUnknownTimezone},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.num, "")
+		if err != nil {
+			t.Errorf("[test %d:parse] failed: %v\n", i, err)
+			continue
+		}
+		earliest, latest, err := GetLocalTimeRangeForNumber(num, test.at)
+		if err != test.err {
+			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if earliest.Format("15:04") != test.earliest {
+			t.Errorf("[test %d:earliest] failed: %s != %s\n", i, earliest.Format("15:04"), test.earliest)
+		}
+		if latest.Format("15:04") != test.latest {
+			t.Errorf("[test %d:latest] failed: %s != %s\n", i, latest.Format("15:04"), test.latest)
+		}
+		if !earliest.Equal(test.at) || !latest.Equal(test.at) {
+			t.Errorf("[test %d:instant] failed: %v, %v != %v\n", i, earliest, latest, test.at)
+		}
+	}
+}
+
+func TestIsWithinLocalTimeWindow(t *testing.T) {
+	if getTimezoneMap().MaxLength == 0 {
+		t.Skip("built without timezone data")
+	}
+	tests := []struct {
+		num      string
+		at       time.Time
+		start    time.Duration
+		end      time.Duration
+		expected bool
+		err      error
+	}{
+		{"+15167706076", time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), 9 * time.Hour, 21 * time.Hour, true, nil},
+		{"+15167706076", time.Date(2024, 1, 15, 13, 59, 0, 0, time.UTC), 9 * time.Hour, 21 * time.Hour, false, nil},
+		{"+15167706076", time.Date(2024, 1, 16, 2, 0, 0, 0, time.UTC), 9 * time.Hour, 21 * time.Hour, false, nil},
+		{"+15167706076", time.Date(2024, 1, 16, 2, 0, 0, 0, time.UTC), 21 * time.Hour, 9 * time.Hour, true, nil},
+		{"+61491570156", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 6 * time.Hour, 12 * time.Hour, true, nil},
+		{"+61491570156", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 7 * time.Hour, 12 * time.Hour, false, nil},
+		{"+80012345678", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 9 * time.Hour, 21 * time.Hour, false, ErrUnknownTimezone},
+	}
+
+	for i, test := range tests {
+		num, err := Parse(test.num, "")
+		if err != nil {
+			t.Errorf("[test %d:parse] failed: %v\n", i, err)
+			continue
+		}
+		within, err := IsWithinLocalTimeWindow(num, test.at, test.start, test.end)
+		if err != test.err {
+			t.Errorf("[test %d:err] failed: %v != %v\n", i, err, test.err)
+		}
+		if within != test.expected {
+			t.Errorf("[test %d:within] failed: %v != %v\n", i, within, test.expected)
+		}
+	}
+}
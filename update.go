@@ -1,24 +1,22 @@
 package phonenumbers
 
 import (
-	"bufio"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"math"
 	"net/http"
-	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"time"
 
 	"bytes"
@@ -26,77 +24,25 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
-// InitAutoUpdateDaemon will start a daemon and interval update all metadata and then take effect atomic
-func InitAutoUpdateDaemon(interval time.Duration, fileCacheDir string) {
-	const defaultInterval = time.Hour * 24 // 每天更新
-	if interval <= 0 {
-		interval = defaultInterval
-	}
-	const defaultCacheDir = "/tmp/phonenumbersCacheDir"
-	if fileCacheDir == "" {
-		fileCacheDir = defaultCacheDir
-	}
-	err := os.MkdirAll(fileCacheDir, 0755)
-	if err != nil && !os.IsExist(err) {
-		log.Printf("[E]failed to fMkdirAll, err:%s, dir:%s", err, fileCacheDir)
-	}
-	err = initFromCache(fileCacheDir)
-	if err != nil {
-		log.Printf("[E]failed to initFromcache, err:%s, try force update phonenumbers metadata...", err)
-		err := update(fileCacheDir)
-		if err != nil {
-			log.Printf("[E]failed to force update, err:%s", err)
-		} else {
-			log.Printf("[I]force update phonenumbers metadata, version:%s", getVersion())
-		}
-	} else {
-		log.Printf("[I]initFromcache, version:%s", getVersion())
-	}
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				err := update(fileCacheDir)
-				if err != nil {
-					log.Printf("[E]failed to update, err:%s", err)
-				} else {
-					log.Printf("[I]update phonenumbers metadata, version:%s", getVersion())
-				}
-			}
-		}
-	}()
-}
-
-var (
-	_version atomic.Value
-)
+// the version of the built-in metadata
+const BUILTIN_METADATA_VERSION = "2019-11-12T00:00:00Z"
 
 func getVersion() string {
-	if _version.Load() == nil {
-		_version.Store("2019-11-12T00:00:00Z")
-	}
-	return _version.Load().(string)
+	return CurrentMetadata().Version()
 }
 
 type metadataRaw struct {
-	MetadataData     string            `json:"metadata_data"`
-	RegionMapData    string            `json:"region_map_data"`
-	TimezoneMapData  string            `json:"timezone_map_data"`
-	CarrierMapData   map[string]string `json:"carrier_map_data"`
-	GeocodingMapData map[string]string `json:"geocoding_map_data"`
-	Version          string            `json:"version"`
-}
-
-func atomicReplaceeMetadataVar(m *metadataRaw) {
-	// atomic store
-	_metadataData.Store(m.MetadataData)
-	_regionMapData.Store(m.RegionMapData)
-	_timezoneMapData.Store(m.TimezoneMapData)
-	_carrierMapData.Store(m.CarrierMapData)
-	_geocodingMapData.Store(m.GeocodingMapData)
-	_version.Store(m.Version)
+	MetadataData            string            `json:"metadata_data"`
+	AlternateFormatsData    string            `json:"alternate_formats_data"`
+	ShortNumberMetadataData string            `json:"short_number_metadata_data"`
+	RegionMapData           string            `json:"region_map_data"`
+	TimezoneMapData         string            `json:"timezone_map_data"`
+	CarrierMapData          map[string]string `json:"carrier_map_data"`
+	GeocodingMapData        map[string]string `json:"geocoding_map_data"`
+	Version                 string            `json:"version"`
+
+	// the version of the source the metadata was built from, if it has one
+	SourceVersion string `json:"source_version,omitempty"`
 }
 
 const (
@@ -139,32 +85,172 @@ func initFromCache(fileCacheDir string) error {
 	if err != nil {
 		return fmt.Errorf("readFromFileCache:%s", err)
 	}
-	atomicReplaceeMetadataVar(m)
-	if err := loadDataAndAtomicReplaceVar(); err != nil {
-		return fmt.Errorf("loadDataAndAtomicReplaceVar:%s", err)
+	if err := commitMetadata(m, ""); err != nil {
+		return fmt.Errorf("commitMetadata:%s", err)
 	}
 	return nil
 }
 
-func update(fileCacheDir string) error {
-	m, err := fetchLatestMetadata(fileCacheDir)
+// metadataSourceVersioner is implemented by sources which can cheaply tell
+// whether they changed, such as archives, so that unchanged ones aren't
+// fetched again.
+type metadataSourceVersioner interface {
+	version(ctx context.Context) (string, error)
+}
+
+// returned by update when the source hasn't changed since the metadata in
+// use was built from it
+var errMetadataUpToDate = errors.New("metadata is up to date")
+
+func update(ctx context.Context, fileCacheDir string, source MetadataSource, logger Logger) error {
+	sourceVersion := ""
+	if versioner, ok := source.(metadataSourceVersioner); ok {
+		version, err := versioner.version(ctx)
+		if err != nil {
+			logger.Printf("[I]Failed to get source version, fetching it, err:%s", err)
+		} else if version != "" && version == CurrentMetadata().raw.SourceVersion {
+			return errMetadataUpToDate
+		}
+		sourceVersion = version
+	}
+
+	m, err := fetchLatestMetadata(ctx, source, logger)
 	if err != nil {
 		return fmt.Errorf("update metadata:%s", err)
 	}
-	err = storeFileCache(m, fileCacheDir)
+	m.SourceVersion = sourceVersion
+	if err := commitMetadata(m, fileCacheDir); err != nil {
+		return fmt.Errorf("commitMetadata:%s", err)
+	}
+	return nil
+}
+
+// Returns the raw data of the metadata built in to the package.
+func builtinMetadataRaw() *metadataRaw {
+	return &metadataRaw{
+		MetadataData:            getMetadataData(),
+		AlternateFormatsData:    getAlternateFormatData(),
+		ShortNumberMetadataData: getShortNumberMetadataData(),
+		RegionMapData:           getRegionMapData(),
+		TimezoneMapData:         getTimezoneMapData(),
+		CarrierMapData:          getCarrierMapData(),
+		GeocodingMapData:        getGeocodingMapData(),
+		Version:                 BUILTIN_METADATA_VERSION,
+	}
+}
+
+// held while putting metadata in use, so that metadata loaded with one set
+// of overrides never replaces metadata loaded with newer ones
+var commitMetadataMutex sync.Mutex
+
+// Loads the passed in raw metadata, with any overrides, and puts it in use
+// if every example number in it is valid. If fileCacheDir isn't empty, the
+// metadata is first written to the cache in it, and only put in use once
+// that succeeds. Otherwise the metadata in use is kept and the reason
+// returned.
+func commitMetadata(m *metadataRaw, fileCacheDir string) (err error) {
+	commitMetadataMutex.Lock()
+	defer commitMetadataMutex.Unlock()
+	defer recoverMetadataPanic(&err)
+
+	// caches written by older versions have no alternate formats or short
+	// number metadata, keep the ones in use
+	if m.AlternateFormatsData == "" {
+		m.AlternateFormatsData = CurrentMetadata().raw.AlternateFormatsData
+	}
+	if m.ShortNumberMetadataData == "" {
+		m.ShortNumberMetadataData = CurrentMetadata().raw.ShortNumberMetadataData
+	}
+
+	metadata, err := loadMetadata(m, getMetadataOverrides())
 	if err != nil {
-		return fmt.Errorf("storeFileCache:%s", err)
+		return err
+	}
+	if err := metadata.validateMetadata(); err != nil {
+		return err
+	}
+	if fileCacheDir != "" {
+		if err := storeFileCache(m, fileCacheDir); err != nil {
+			return fmt.Errorf("storeFileCache:%s", err)
+		}
+	}
+	metadata.store()
+	return nil
+}
+
+// Sets the passed in error to any panic raised loading or validating
+// metadata, such as the builder's on invalid possible lengths or patterns,
+// so that bad metadata fails the update instead of the process.
+func recoverMetadataPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("invalid metadata: %v", r)
+	}
+}
+
+// the types of numbers regions have example numbers for
+var EXAMPLE_NUMBER_TYPES = []PhoneNumberType{
+	FIXED_LINE,
+	MOBILE,
+	TOLL_FREE,
+	PREMIUM_RATE,
+	SHARED_COST,
+	VOIP,
+	PERSONAL_NUMBER,
+	PAGER,
+	UAN,
+	VOICEMAIL,
+}
+
+// Checks the metadata by parsing the example number of every type for
+// every region and non-geographical entity, returning an error for the
+// first which can't be parsed or isn't valid.
+func (md *Metadata) validateMetadata() error {
+	for regionCode := range md.supportedRegions {
+		metadata := md.getMetadataForRegion(regionCode)
+		if metadata == nil {
+			return fmt.Errorf("no metadata for region %s", regionCode)
+		}
+		for _, numberType := range EXAMPLE_NUMBER_TYPES {
+			exampleNumber := getNumberDescByType(metadata, numberType).GetExampleNumber()
+			if exampleNumber == "" {
+				continue
+			}
+			number := md.GetExampleNumberForType(regionCode, numberType)
+			if number == nil || !md.IsValidNumberForRegion(number, regionCode) {
+				return fmt.Errorf("invalid example number %s for region %s", exampleNumber, regionCode)
+			}
+		}
 	}
-	atomicReplaceeMetadataVar(m)
-	if err := loadDataAndAtomicReplaceVar(); err != nil {
-		return fmt.Errorf("load metadata:%s", err)
+
+	for countryCode := range md.countryCodesForNonGeographicalRegion {
+		number := md.GetExampleNumberForNonGeoEntity(countryCode)
+		if number == nil || !md.IsValidNumber(number) {
+			return fmt.Errorf("invalid example number for country calling code %d", countryCode)
+		}
 	}
 	return nil
 }
 
-func fetchLatestMetadata(fileCacheDir string) (m *metadataRaw, err error) {
+func fetchLatestMetadata(ctx context.Context, source MetadataSource, logger Logger) (m *metadataRaw, err error) {
 	m = new(metadataRaw)
-	metadata, metadataData, err := buildMetadata()
+
+	// sources which load everything at once only keep it for the update
+	if loader, ok := source.(metadataSourceLoader); ok {
+		if err := loader.load(ctx); err != nil {
+			return m, err
+		}
+		defer loader.release()
+	}
+
+	metadata, metadataData, err := buildMetadata(ctx, source, logger)
+	if err != nil {
+		return m, err
+	}
+	alternateFormatsData, err := buildAlternateFormats(ctx, source, logger)
+	if err != nil {
+		return m, err
+	}
+	shortNumberMetadataData, err := buildShortNumberMetadata(ctx, source, logger)
 	if err != nil {
 		return m, err
 	}
@@ -172,19 +258,21 @@ func fetchLatestMetadata(fileCacheDir string) (m *metadataRaw, err error) {
 	if err != nil {
 		return m, err
 	}
-	timezoneMapData, err := buildTimezones()
+	timezoneMapData, err := buildTimezones(ctx, source, logger)
 	if err != nil {
 		return m, err
 	}
-	carrierMapData, err := buildPrefixData(&carrier, fileCacheDir)
+	carrierMapData, err := buildPrefixData(ctx, source, &carrier, logger)
 	if err != nil {
 		return m, err
 	}
-	geocodingMapData, err := buildPrefixData(&geocoding, fileCacheDir)
+	geocodingMapData, err := buildPrefixData(ctx, source, &geocoding, logger)
 	if err != nil {
 		return m, err
 	}
 	m.MetadataData = metadataData
+	m.AlternateFormatsData = alternateFormatsData
+	m.ShortNumberMetadataData = shortNumberMetadataData
 	m.RegionMapData = regionMapData
 	m.TimezoneMapData = timezoneMapData
 	m.CarrierMapData = carrierMapData
@@ -194,35 +282,39 @@ func fetchLatestMetadata(fileCacheDir string) (m *metadataRaw, err error) {
 }
 
 type prefixBuild struct {
-	url     string
-	dir     string
-	srcPath string
-	varName string
+	dir string
 }
 
+// DEFAULT_METADATA_SOURCE is the URL of the archive of the upstream
+// repository metadata is fetched from by default
+const DEFAULT_METADATA_SOURCE = "https://github.com/google/libphonenumber/archive/refs/heads/master.tar.gz"
+
+// paths of the resources we fetch, relative to the resources directory
 const (
-	metadataURL = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/PhoneNumberMetadata.xml"
-	tzURL       = "https://raw.githubusercontent.com/googlei18n/libphonenumber/master/resources/timezones/map_data.txt"
+	metadataPath         = "PhoneNumberMetadata.xml"
+	alternateFormatsPath = "PhoneNumberAlternateFormats.xml"
+	shortNumberPath      = "ShortNumberMetadata.xml"
+	tzPath               = "timezones/map_data.txt"
 )
 
 var carrier = prefixBuild{
-	url: "https://github.com/googlei18n/libphonenumber/trunk/resources/carrier",
 	dir: "carrier",
 }
 
 var geocoding = prefixBuild{
-	url: "https://github.com/googlei18n/libphonenumber/trunk/resources/geocoding",
 	dir: "geocoding",
 }
 
-func buildMetadata() (*PhoneMetadataCollection, string, error) {
-	log.Println("[I]Fetching PhoneNumberMetadata.xml from Github")
-	body, err := fetchURL(metadataURL)
+func buildMetadata(ctx context.Context, source MetadataSource, logger Logger) (_ *PhoneMetadataCollection, _ string, err error) {
+	defer recoverMetadataPanic(&err)
+
+	logger.Printf("[I]Reading PhoneNumberMetadata.xml")
+	body, err := source.ReadFile(ctx, metadataPath)
 	if err != nil {
 		return nil, "", err
 	}
 
-	log.Println("[I]Building new metadata collection")
+	logger.Printf("[I]Building new metadata collection")
 	collection, err := BuildPhoneMetadataCollection(body, false, false)
 	if err != nil {
 		err = fmt.Errorf("error converting XML: %s", err)
@@ -232,75 +324,108 @@ func buildMetadata() (*PhoneMetadataCollection, string, error) {
 	// write it out as a protobuf
 	data, err := proto.Marshal(collection)
 	if err != nil {
-		err = fmt.Errorf("rrror marshalling metadata: %v", err)
+		return nil, "", fmt.Errorf("error marshalling metadata: %v", err)
 	}
 	return collection, gzipBytesAndBase64(data), nil
 }
 
-func fetchURL(url string) ([]byte, error) {
-	resp, err := (&http.Client{
-		Timeout: time.Minute,
-	}).Get(url)
-	if err != nil || resp.StatusCode != 200 {
-		err = fmt.Errorf("error fetching URL '%s': %v", url, err)
+func buildAlternateFormats(ctx context.Context, source MetadataSource, logger Logger) (_ string, err error) {
+	defer recoverMetadataPanic(&err)
+
+	logger.Printf("[I]Reading PhoneNumberAlternateFormats.xml")
+	body, err := source.ReadFile(ctx, alternateFormatsPath)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Printf("[I]Building new alternate formats collection")
+	collection, err := BuildAlternateFormatsCollection(body)
+	if err != nil {
+		return "", fmt.Errorf("error converting XML: %s", err)
+	}
+
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling alternate formats: %v", err)
+	}
+	return gzipBytesAndBase64(data), nil
+}
+
+func buildShortNumberMetadata(ctx context.Context, source MetadataSource, logger Logger) (_ string, err error) {
+	defer recoverMetadataPanic(&err)
+
+	logger.Printf("[I]Reading ShortNumberMetadata.xml")
+	body, err := source.ReadFile(ctx, shortNumberPath)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Printf("[I]Building new short number metadata collection")
+	collection, err := BuildShortNumberMetadataCollection(body)
+	if err != nil {
+		return "", fmt.Errorf("error converting XML: %s", err)
+	}
+
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling short number metadata: %v", err)
+	}
+	return gzipBytesAndBase64(data), nil
+}
+
+func fetchURL(ctx context.Context, url string) ([]byte, error) {
+	body, err := openURL(ctx, url)
+	if err != nil {
 		return nil, err
 	}
 	defer func() {
-		_ = resp.Body.Close()
+		_ = body.Close()
 	}()
-	body, err := ioutil.ReadAll(resp.Body)
+	data, err := ioutil.ReadAll(body)
 	if err != nil {
 		err = fmt.Errorf("error reading body: %s", err)
 		return nil, err
 	}
-	return body, nil
+	return data, nil
 }
 
-func svnExport(dir string, url string) (err error) {
-	_ = os.RemoveAll(dir)
-	err = os.MkdirAll(dir, 0755)
+// Requests the passed in URL, returning its body which the caller must
+// close.
+func openURL(ctx context.Context, url string) (io.ReadCloser, error) {
+	resp, err := requestURL(ctx, http.MethodGet, url)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cmd := exec.Command(
-		"/bin/bash",
-		"-c",
-		fmt.Sprintf("svn export %s --force", url),
-	)
-	cmd.Dir = dir
+	return resp.Body, nil
+}
 
-	stdout, err := cmd.StdoutPipe()
+// Requests the headers of the passed in URL, without its body.
+func headURL(ctx context.Context, url string) (http.Header, error) {
+	resp, err := requestURL(ctx, http.MethodHead, url)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	stderr, err := cmd.StderrPipe()
+	_ = resp.Body.Close()
+	return resp.Header, nil
+}
+
+func requestURL(ctx context.Context, method string, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
-		return err
-	}
-	if err = cmd.Start(); err != nil {
-		return err
+		return nil, fmt.Errorf("error fetching URL '%s': %v", url, err)
 	}
-	_, err = ioutil.ReadAll(stderr)
+	resp, err := (&http.Client{
+		Timeout: time.Minute,
+	}).Do(req)
 	if err != nil {
-		return err
-	}
-	outputBuf := bufio.NewReader(stdout)
-
-	for {
-		output, _, err := outputBuf.ReadLine()
-		if err != nil {
-			if err != io.EOF {
-				return err
-			}
-			break
-		}
-		log.Println("[I]", string(output))
+		err = fmt.Errorf("error fetching URL '%s': %v", url, err)
+		return nil, err
 	}
-
-	if err = cmd.Wait(); err != nil {
-		return err
+	if resp.StatusCode != 200 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("error fetching URL '%s': %s", url, resp.Status)
 	}
-	return nil
+	return resp, nil
 }
 
 func buildRegions(metadata *PhoneMetadataCollection) (string, error) {
@@ -312,12 +437,20 @@ func buildRegions(metadata *PhoneMetadataCollection) (string, error) {
 	return result, nil
 }
 
-func buildTimezones() (string, error) {
-	log.Println("[I]Building timezone map")
-	body, err := fetchURL(tzURL)
+func buildTimezones(ctx context.Context, source MetadataSource, logger Logger) (string, error) {
+	prefixMap, err := readTimezones(ctx, source, logger)
 	if err != nil {
 		return "", err
 	}
+	return intStringArrayMapToString(prefixMap)
+}
+
+func readTimezones(ctx context.Context, source MetadataSource, logger Logger) (map[int][]string, error) {
+	logger.Printf("[I]Building timezone map")
+	body, err := source.ReadFile(ctx, tzPath)
+	if err != nil {
+		return nil, err
+	}
 
 	// build our map of prefix to timezones
 	prefixMap := make(map[int][]string)
@@ -333,28 +466,24 @@ func buildTimezones() (string, error) {
 		fields := strings.Split(line, "|")
 		if len(fields) != 2 {
 			err = fmt.Errorf("invalid format in timezone file: %s", line)
-			return "", err
+			return nil, err
 		}
 
 		zones := strings.Split(fields[1], "&")
 		if len(zones) < 1 {
 			err = fmt.Errorf("invalid format in timezone file: %s", line)
-			return "", err
+			return nil, err
 		}
 
 		// parse our prefix
 		prefix, err := strconv.Atoi(fields[0])
 		if err != nil {
 			err = fmt.Errorf("invalid prefix in line: %s", line)
-			return "", err
+			return nil, err
 		}
 		prefixMap[prefix] = zones
 	}
-	result, err := intStringArrayMapToString(prefixMap)
-	if err != nil {
-		return "", nil
-	}
-	return result, nil
+	return prefixMap, nil
 }
 
 func intStringArrayMapToString(prefixMap map[int][]string) (dst string, err error) {
@@ -439,138 +568,134 @@ func gzipBytesAndBase64(data []byte) string {
 	return encoded
 }
 
-func buildPrefixData(build *prefixBuild, fileCacheDir string) (map[string]string, error) {
-	prefixDataMap := make(map[string]string)
-	buildDir := filepath.Join(fileCacheDir, build.dir)
-	log.Println("[I]Fetching " + build.url + " from Github to " + fileCacheDir)
-	err := svnExport(buildDir, build.url)
+func buildPrefixData(ctx context.Context, source MetadataSource, build *prefixBuild, logger Logger) (map[string]string, error) {
+	languageMappings, err := readPrefixData(ctx, source, build, logger)
 	if err != nil {
 		return nil, err
 	}
+	return prefixMapsToStrings(languageMappings)
+}
+
+func readPrefixData(ctx context.Context, source MetadataSource, build *prefixBuild, logger Logger) (map[string]map[int]string, error) {
+	logger.Printf("[I]Reading %s", build.dir)
+
 	// get our top level language directories
-	dirs, err := filepath.Glob(fmt.Sprintf("%s/*", buildDir))
+	entries, err := source.ReadDir(ctx, build.dir)
 	if err != nil {
 		return nil, err
 	}
 
 	// for each directory
 	languageMappings := make(map[string]map[int]string)
-	for _, dir := range dirs {
+	for _, entry := range entries {
 		// only look at directories
-		fi, _ := os.Stat(dir)
-		if !fi.IsDir() {
-			log.Printf("[I]Ignoring directory: %s\n", dir)
+		if !strings.HasSuffix(entry, "/") {
+			logger.Printf("[I]Ignoring file: %s\n", entry)
 			continue
 		}
 
 		// get our language code
-		parts := strings.Split(dir, "/")
+		lang := strings.TrimSuffix(entry, "/")
 
 		// build a map for that directory
-		mappings, err := readMappingsForDir(dir)
+		mappings, err := readMappingsForDir(ctx, source, build.dir+"/"+lang, logger)
 		if err != nil {
 			return nil, err
 		}
 
 		// save it for our language
-		languageMappings[parts[1]] = mappings
+		languageMappings[lang] = mappings
 	}
+	return languageMappings, nil
+}
 
-	for lang, mappings := range languageMappings {
-		// iterate through our map, creating our full set of values and prefixes
-		prefixes := make([]int, 0, len(mappings))
-		seenValues := make(map[string]bool)
-		values := make([]string, 0, 255)
-		for prefix, value := range mappings {
-			prefixes = append(prefixes, prefix)
-			_, seen := seenValues[value]
-			if !seen {
-				values = append(values, value)
-				seenValues[value] = true
-			}
-		}
-
-		// make sure we won't overrun uint16s
-		if len(values) > math.MaxUint16 {
-			return nil, fmt.Errorf("too many values to represent in uint16")
+// Encodes the passed in map of prefixes to values, as loaded by loadPrefixMap.
+func intStringMapToString(mappings map[int]string) (string, error) {
+	// iterate through our map, creating our full set of values and prefixes
+	prefixes := make([]int, 0, len(mappings))
+	seenValues := make(map[string]bool)
+	values := make([]string, 0, 255)
+	for prefix, value := range mappings {
+		prefixes = append(prefixes, prefix)
+		_, seen := seenValues[value]
+		if !seen {
+			values = append(values, value)
+			seenValues[value] = true
 		}
+	}
 
-		// need sorted prefixes for our diff writing to work
-		sort.Ints(prefixes)
+	// make sure we won't overrun uint16s
+	if len(values) > math.MaxUint16 {
+		return "", fmt.Errorf("too many values to represent in uint16")
+	}
 
-		// sorted values compress better
-		sort.Strings(values)
+	// need sorted prefixes for our diff writing to work
+	sort.Ints(prefixes)
 
-		// build our reverse mapping from value to offset
-		internMappings := make(map[string]uint16)
-		for i, value := range values {
-			internMappings[value] = uint16(i)
-		}
+	// sorted values compress better
+	sort.Strings(values)
 
-		// write our map
-		data := &bytes.Buffer{}
+	// build our reverse mapping from value to offset
+	internMappings := make(map[string]uint16)
+	for i, value := range values {
+		internMappings[value] = uint16(i)
+	}
 
-		// first write our values, as length of string and raw bytes
-		joinedValues := strings.Join(values, "\n")
-		if err = binary.Write(data, binary.LittleEndian, uint32(len(joinedValues))); err != nil {
-			return nil, err
-		}
-		if err = binary.Write(data, binary.LittleEndian, []byte(joinedValues)); err != nil {
-			return nil, err
-		}
+	// write our map
+	data := &bytes.Buffer{}
 
-		// then then number of prefix / value pairs
-		if err = binary.Write(data, binary.LittleEndian, uint32(len(prefixes))); err != nil {
-			return nil, err
-		}
+	// first write our values, as length of string and raw bytes
+	joinedValues := strings.Join(values, "\n")
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(joinedValues))); err != nil {
+		return "", err
+	}
+	if err := binary.Write(data, binary.LittleEndian, []byte(joinedValues)); err != nil {
+		return "", err
+	}
 
-		// we write our prefix / value pairs as a varint of the difference of the previous prefix
-		// and a uint16 of the value index
-		last := 0
-		intBuf := make([]byte, 6)
-		for _, prefix := range prefixes {
-			value := mappings[prefix]
-			valueIntern := internMappings[value]
-			diff := prefix - last
-			l := binary.PutUvarint(intBuf, uint64(diff))
-			if err = binary.Write(data, binary.LittleEndian, intBuf[:l]); err != nil {
-				return nil, err
-			}
-			if err = binary.Write(data, binary.LittleEndian, uint16(valueIntern)); err != nil {
-				return nil, err
-			}
+	// then then number of prefix / value pairs
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(prefixes))); err != nil {
+		return "", err
+	}
 
-			last = prefix
+	// we write our prefix / value pairs as a varint of the difference of the previous prefix
+	// and a uint16 of the value index
+	last := 0
+	intBuf := make([]byte, 6)
+	for _, prefix := range prefixes {
+		value := mappings[prefix]
+		valueIntern := internMappings[value]
+		diff := prefix - last
+		l := binary.PutUvarint(intBuf, uint64(diff))
+		if err := binary.Write(data, binary.LittleEndian, intBuf[:l]); err != nil {
+			return "", err
+		}
+		if err := binary.Write(data, binary.LittleEndian, uint16(valueIntern)); err != nil {
+			return "", err
 		}
 
-		var compressed bytes.Buffer
-		w := gzip.NewWriter(&compressed)
-		_, _ = w.Write(data.Bytes())
-		_ = w.Close()
-		c := base64.StdEncoding.EncodeToString(compressed.Bytes())
-		prefixDataMap[lang] = c
+		last = prefix
 	}
 
-	return prefixDataMap, nil
+	return gzipBytesAndBase64(data.Bytes()), nil
 }
 
-func readMappingsForDir(dir string) (map[int]string, error) {
-	log.Printf("[I]Building map for: %s\n", dir)
+func readMappingsForDir(ctx context.Context, source MetadataSource, dir string, logger Logger) (map[int]string, error) {
+	logger.Printf("[I]Building map for: %s\n", dir)
 	mappings := make(map[int]string)
 
-	files, err := filepath.Glob(dir + "/*.txt")
+	files, err := source.ReadDir(ctx, dir)
 	if err != nil {
 		return nil, err
 	}
 	for _, file := range files {
-		body, err := ioutil.ReadFile(file)
+		if !strings.HasSuffix(file, ".txt") {
+			continue
+		}
+		body, err := source.ReadFile(ctx, dir+"/"+file)
 		if err != nil {
 			return nil, err
 		}
-		items := strings.Split(file, "/")
-		if len(items) != 3 {
-			err = fmt.Errorf("file name %s not correct", file)
-		}
 
 		for _, line := range strings.Split(string(body), "\n") {
 			if strings.HasPrefix(line, "#") {
@@ -592,7 +717,7 @@ func readMappingsForDir(dir string) (map[int]string, error) {
 
 			value := strings.TrimSpace(fields[1])
 			if value == "" {
-				log.Printf("[I]Ignoring empty value: %s", line)
+				logger.Printf("[I]Ignoring empty value: %s", line)
 			}
 
 			_, repeat := mappings[prefixInt]
@@ -604,6 +729,6 @@ func readMappingsForDir(dir string) (map[int]string, error) {
 		}
 	}
 
-	log.Printf("[I]Read %d mappings in %s\n", len(mappings), dir)
+	logger.Printf("[I]Read %d mappings in %s\n", len(mappings), dir)
 	return mappings, nil
 }
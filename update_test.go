@@ -1,12 +1,166 @@
 package phonenumbers
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+
+	"github.com/golang/protobuf/proto"
 )
 
 func TestUpdate(t *testing.T) {
-	err := update("/tmp/")
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := testTarGz(t, "libphonenumber-master/resources/")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	}))
+	defer server.Close()
+
+	defer CurrentMetadata().store()
+	err = update(context.Background(), dir, NewArchiveSource(server.URL+"/master.tar.gz"), testLogger{t})
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// the metadata from the archive is in use and cached
+	if getVersion() == BUILTIN_METADATA_VERSION {
+		t.Errorf("[test version] failed: still using built-in metadata\n")
+	}
+	if languages := CurrentMetadata().raw.CarrierMapData; len(languages) != 2 {
+		t.Errorf("[test carriers] failed: %v\n", languages)
+	}
+	cached := new(metadataRaw)
+	if err := readFromFileCache(cached, dir); err != nil || cached.Version != getVersion() {
+		t.Errorf("[test cache] failed: %v, %s != %s\n", err, cached.Version, getVersion())
+	}
+}
+
+// Updates from upstream, which needs network access so is only run when
+// PHONENUMBERS_TEST_UPSTREAM is set.
+func TestUpdateFromUpstream(t *testing.T) {
+	if os.Getenv("PHONENUMBERS_TEST_UPSTREAM") == "" {
+		t.Skip("set PHONENUMBERS_TEST_UPSTREAM to update from upstream")
+	}
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer CurrentMetadata().store()
+	err = update(context.Background(), dir, NewArchiveSource(DEFAULT_METADATA_SOURCE), testLogger{t})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	if err := CurrentMetadata().validateMetadata(); err != nil {
+		t.Errorf("built-in metadata failed validation: %s", err)
+	}
+}
+
+func TestCommitMetadata(t *testing.T) {
+	original := CurrentMetadata()
+	defer original.store()
+
+	// metadata where the US fixed line example number isn't valid
+	collection := proto.Clone(getCurrMetadataColl()).(*PhoneMetadataCollection)
+	for _, metadata := range collection.GetMetadata() {
+		if metadata.GetId() == "US" {
+			metadata.FixedLine.NationalNumberPattern = proto.String("9{10}")
+		}
+	}
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := *original.raw
+	invalid.MetadataData = gzipBytesAndBase64(data)
+	invalid.Version = "2030-01-01T00:00:00Z"
+
+	corrupt := *original.raw
+	corrupt.RegionMapData = "not base64"
+	corrupt.Version = "2030-01-01T00:00:00Z"
+
+	for i, m := range []*metadataRaw{&invalid, &corrupt} {
+		if err := commitMetadata(m, ""); err == nil {
+			t.Errorf("[test %d:commit] failed: expected error\n", i)
+		}
+
+		// the metadata in use before is still in use
+		if CurrentMetadata() != original {
+			t.Errorf("[test %d:current] failed: metadata replaced by version %s\n", i, getVersion())
+		}
+		number, err := Parse("2015550123", "US")
+		if err != nil || !IsValidNumber(number) {
+			t.Errorf("[test %d:valid] failed: %v\n", i, err)
+		}
+	}
+
+	// valid metadata is put in use
+	valid := *original.raw
+	valid.Version = "2030-01-01T00:00:00Z"
+	if err := commitMetadata(&valid, ""); err != nil {
+		t.Errorf("[test valid:commit] failed: %v\n", err)
+	}
+	if getVersion() != valid.Version {
+		t.Errorf("[test valid:version] failed: %s != %s\n", getVersion(), valid.Version)
+	}
+}
+
+func TestMetadataSnapshot(t *testing.T) {
+	original := CurrentMetadata()
+	defer original.store()
+
+	// metadata where no US numbers are valid
+	collection := proto.Clone(getCurrMetadataColl()).(*PhoneMetadataCollection)
+	for _, metadata := range collection.GetMetadata() {
+		if metadata.GetId() == "US" {
+			metadata.GeneralDesc.NationalNumberPattern = proto.String("9{10}")
+		}
+	}
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := *original.raw
+	raw.MetadataData = gzipBytesAndBase64(data)
+	raw.Version = "2030-01-01T00:00:00Z"
+	updated, err := loadMetadata(&raw, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated.store()
+
+	// the snapshot taken before the update still uses the old metadata
+	number, err := original.Parse("2015550123", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !original.IsValidNumber(number) {
+		t.Errorf("[test original:valid] failed: %s not valid\n", original.Format(number, E164))
+	}
+	if original.Version() != BUILTIN_METADATA_VERSION {
+		t.Errorf("[test original:version] failed: %s != %s\n", original.Version(), BUILTIN_METADATA_VERSION)
+	}
+
+	// while the package level functions use the updated metadata
+	if CurrentMetadata() != updated {
+		t.Errorf("[test current] failed: updated metadata not in use\n")
+	}
+	if IsValidNumber(number) {
+		t.Errorf("[test updated:valid] failed: %s valid\n", Format(number, E164))
+	}
+	if getVersion() != raw.Version {
+		t.Errorf("[test updated:version] failed: %s != %s\n", getVersion(), raw.Version)
+	}
 }
@@ -0,0 +1,267 @@
+package phonenumbers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DEFAULT_UPDATE_INTERVAL is how often updaters update metadata by default
+	DEFAULT_UPDATE_INTERVAL = time.Hour * 24
+
+	// DEFAULT_UPDATE_CACHE_DIR is where updaters cache metadata by default
+	DEFAULT_UPDATE_CACHE_DIR = "/tmp/phonenumbersCacheDir"
+)
+
+// ErrUpdaterStarted is returned when starting an updater which is already running
+var ErrUpdaterStarted = errors.New("updater already started")
+
+// Logger is what an Updater logs through, satisfied by *log.Logger
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// stdLogger logs through the standard logger of the log package
+type stdLogger struct{}
+
+func (stdLogger) Printf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+}
+
+// UpdaterOptions are the options an Updater is built from. Zero values
+// are replaced by defaults.
+type UpdaterOptions struct {
+	// Interval is how often metadata is updated, DEFAULT_UPDATE_INTERVAL by default
+	Interval time.Duration
+
+	// CacheDir is the directory metadata is cached in between runs,
+	// DEFAULT_UPDATE_CACHE_DIR by default
+	CacheDir string
+
+	// Source is where metadata is fetched from, the archive at
+	// DEFAULT_METADATA_SOURCE by default
+	Source MetadataSource
+
+	// Logger is what the updater logs through, the standard logger of the
+	// log package by default
+	Logger Logger
+
+	// OnUpdate is called with the new version after each successful update
+	OnUpdate func(version string)
+
+	// OnError is called with the error after each failed update
+	OnError func(err error)
+}
+
+// UpdaterStatus is the state of an Updater
+type UpdaterStatus struct {
+	// LastSuccess is when metadata was last loaded, zero if it never was
+	LastSuccess time.Time
+
+	// LastError is the error of the last failed update, nil if the last
+	// update succeeded
+	LastError error
+
+	// Version is the version of the metadata currently in use
+	Version string
+}
+
+// Updater keeps metadata up to date by fetching it from its source at an
+// interval, taking effect atomically, and caching it on disk so that it is
+// available straight away on the next run.
+type Updater struct {
+	options UpdaterOptions
+
+	// held while updating, so updates never overlap
+	updateMutex sync.Mutex
+
+	mutex       sync.Mutex
+	cancel      context.CancelFunc
+	done        chan struct{}
+	lastSuccess time.Time
+	lastError   error
+}
+
+// NewUpdater returns a new Updater built from the passed in options. It
+// does nothing until started or asked to update.
+func NewUpdater(options UpdaterOptions) *Updater {
+	if options.Interval <= 0 {
+		options.Interval = DEFAULT_UPDATE_INTERVAL
+	}
+	if options.CacheDir == "" {
+		options.CacheDir = DEFAULT_UPDATE_CACHE_DIR
+	}
+	if options.Source == nil {
+		options.Source = NewArchiveSource(DEFAULT_METADATA_SOURCE)
+	}
+	if options.Logger == nil {
+		options.Logger = stdLogger{}
+	}
+	return &Updater{options: options}
+}
+
+// Start loads the metadata cached by previous runs, then starts updating
+// metadata in the background at the updater's interval until the passed
+// in context is done or Stop is called. If there is no cached metadata an
+// update is started straight away. Returns ErrUpdaterStarted if the
+// updater is already running.
+func (u *Updater) Start(ctx context.Context) error {
+	return u.start(ctx, false)
+}
+
+// Starts the updater, making the update when there is no cached metadata
+// before returning if waitForUpdate is set, and in the background if not.
+func (u *Updater) start(ctx context.Context, waitForUpdate bool) error {
+	u.mutex.Lock()
+	if u.cancel != nil {
+		u.mutex.Unlock()
+		return ErrUpdaterStarted
+	}
+
+	err := os.MkdirAll(u.options.CacheDir, 0755)
+	if err != nil && !os.IsExist(err) {
+		u.options.Logger.Printf("[E]failed to fMkdirAll, err:%s, dir:%s", err, u.options.CacheDir)
+	}
+
+	updateNow := false
+	err = initFromCache(u.options.CacheDir)
+	if err != nil {
+		u.options.Logger.Printf("[E]failed to initFromcache, err:%s, try force update phonenumbers metadata...", err)
+		updateNow = true
+	} else {
+		u.options.Logger.Printf("[I]initFromcache, version:%s", getVersion())
+		u.lastSuccess = time.Now()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	u.cancel = cancel
+	u.done = done
+	u.mutex.Unlock()
+
+	if updateNow && waitForUpdate {
+		_ = u.UpdateNow(ctx)
+		updateNow = false
+	}
+
+	go func() {
+		defer close(done)
+
+		if updateNow {
+			_ = u.UpdateNow(ctx)
+		}
+
+		ticker := time.NewTicker(u.options.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				_ = u.UpdateNow(ctx)
+			case <-ctx.Done():
+				// stopped by the passed in context, unless Stop was called
+				// or the updater was started again since
+				u.mutex.Lock()
+				if u.done == done {
+					u.cancel, u.done = nil, nil
+				}
+				u.mutex.Unlock()
+				cancel()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the updater, cancelling any update in progress, and waits
+// for it to finish. It can then be started again. Updaters whose context
+// is done stop by themselves, and can be started again without Stop.
+func (u *Updater) Stop() {
+	u.mutex.Lock()
+	cancel, done := u.cancel, u.done
+	u.cancel, u.done = nil, nil
+	u.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// UpdateNow fetches the latest metadata from the updater's source, caches
+// it and puts it into effect, waiting for any other update in progress to
+// finish first. Returns the error the update failed with, if any. Updates
+// cancelled by the passed in context return its error, and aren't
+// reported as failures.
+func (u *Updater) UpdateNow(ctx context.Context) error {
+	u.updateMutex.Lock()
+	defer u.updateMutex.Unlock()
+
+	err := os.MkdirAll(u.options.CacheDir, 0755)
+	if err == nil {
+		err = update(ctx, u.options.CacheDir, u.options.Source, u.options.Logger)
+	}
+	upToDate := err == errMetadataUpToDate
+	if upToDate {
+		err = nil
+	}
+
+	// updates cancelled by stopping the updater haven't failed
+	if err != nil && ctx.Err() != nil {
+		u.options.Logger.Printf("[I]update phonenumbers metadata cancelled, err:%s", err)
+		return ctx.Err()
+	}
+
+	u.mutex.Lock()
+	if err == nil {
+		u.lastSuccess = time.Now()
+	}
+	u.lastError = err
+	u.mutex.Unlock()
+
+	if err != nil {
+		u.options.Logger.Printf("[E]failed to update, err:%s", err)
+		if u.options.OnError != nil {
+			u.options.OnError(err)
+		}
+		return err
+	}
+
+	version := getVersion()
+	if upToDate {
+		u.options.Logger.Printf("[I]phonenumbers metadata is up to date, version:%s", version)
+		return nil
+	}
+	u.options.Logger.Printf("[I]update phonenumbers metadata, version:%s", version)
+	if u.options.OnUpdate != nil {
+		u.options.OnUpdate(version)
+	}
+	return nil
+}
+
+// Status returns the current state of the updater
+func (u *Updater) Status() UpdaterStatus {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	return UpdaterStatus{
+		LastSuccess: u.lastSuccess,
+		LastError:   u.lastError,
+		Version:     getVersion(),
+	}
+}
+
+// InitAutoUpdateDaemon will start a daemon and interval update all metadata and then take effect atomic.
+// If there is no cached metadata, the first update is made before it returns.
+//
+// Deprecated: use NewUpdater, which can be stopped and reports its status
+func InitAutoUpdateDaemon(interval time.Duration, fileCacheDir string) {
+	_ = NewUpdater(UpdaterOptions{
+		Interval: interval,
+		CacheDir: fileCacheDir,
+	}).start(context.Background(), true)
+}
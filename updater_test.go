@@ -0,0 +1,335 @@
+package phonenumbers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testLogger struct {
+	t *testing.T
+}
+
+func (l testLogger) Printf(format string, v ...interface{}) {
+	l.t.Logf(format, v...)
+}
+
+func TestUpdaterFromCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// cache the metadata we're built with under another version
+	defer CurrentMetadata().store()
+	err = storeFileCache(&metadataRaw{
+		MetadataData:            getMetadataData(),
+		AlternateFormatsData:    getAlternateFormatData(),
+		ShortNumberMetadataData: getShortNumberMetadataData(),
+		RegionMapData:           getRegionMapData(),
+		TimezoneMapData:         getTimezoneMapData(),
+		CarrierMapData:          getCarrierMapData(),
+		GeocodingMapData:        getGeocodingMapData(),
+		Version:                 "2020-01-02T00:00:00Z",
+	}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updater := NewUpdater(UpdaterOptions{
+		Interval: time.Hour,
+		CacheDir: dir,
+		Logger:   testLogger{t},
+	})
+	if err := updater.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := updater.Start(context.Background()); err != ErrUpdaterStarted {
+		t.Errorf("[test start] failed: %v != %v\n", err, ErrUpdaterStarted)
+	}
+	updater.Stop()
+
+	status := updater.Status()
+	if status.Version != "2020-01-02T00:00:00Z" {
+		t.Errorf("[test version] failed: %s != %s\n", status.Version, "2020-01-02T00:00:00Z")
+	}
+	if status.LastSuccess.IsZero() || status.LastError != nil {
+		t.Errorf("[test status] failed: %v\n", status)
+	}
+
+	// can be started again once stopped
+	if err := updater.Start(context.Background()); err != nil {
+		t.Errorf("[test restart] failed: %v\n", err)
+	}
+	updater.Stop()
+}
+
+func TestUpdaterErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	version := getVersion()
+	errs := make(chan error, 1)
+	updater := NewUpdater(UpdaterOptions{
+		CacheDir: dir,
+		Source:   NewHTTPSource(server.URL),
+		Logger:   testLogger{t},
+		OnUpdate: func(string) { t.Error("unexpected update") },
+		OnError:  func(err error) { errs <- err },
+	})
+
+	// nothing is cached, so an update is started straight away
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := updater.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Errorf("[test onerror] failed: expected error\n")
+		}
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for update")
+	}
+
+	// cancelling the context stops it
+	cancel()
+	updater.Stop()
+
+	status := updater.Status()
+	if status.LastError == nil || !status.LastSuccess.IsZero() {
+		t.Errorf("[test status] failed: %v\n", status)
+	}
+	if status.Version != version {
+		t.Errorf("[test version] failed: %s != %s\n", status.Version, version)
+	}
+
+	go func() { <-errs }()
+	if err := updater.UpdateNow(context.Background()); err == nil {
+		t.Errorf("[test updatenow] failed: expected error\n")
+	}
+
+	// InitAutoUpdateDaemon makes the first update before returning
+	waiting := NewUpdater(UpdaterOptions{
+		CacheDir: dir,
+		Source:   NewHTTPSource(server.URL),
+		Logger:   testLogger{t},
+	})
+	if err := waiting.start(context.Background(), true); err != nil {
+		t.Fatal(err)
+	}
+	if status := waiting.Status(); status.LastError == nil {
+		t.Errorf("[test wait for update] failed: %v\n", status)
+	}
+	waiting.Stop()
+}
+
+func TestUpdaterBadSource(t *testing.T) {
+	tests := []string{
+		// truncated
+		"<phoneNumberMetadata>\n<territories>",
+		// invalid possible lengths, which the builder panics on
+		`<phoneNumberMetadata><territories>
+		   <territory id="GB" countryCode="44">
+		     <fixedLine>
+		       <nationalNumberPattern>\d{10}</nationalNumberPattern>
+		       <possibleLengths national=""/>
+		     </fixedLine>
+		   </territory>
+		 </territories></phoneNumberMetadata>`,
+	}
+
+	for i, test := range tests {
+		dir, err := ioutil.TempDir("", "phonenumbers")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		writeTestResources(t, dir)
+		if err := ioutil.WriteFile(filepath.Join(dir, "PhoneNumberMetadata.xml"), []byte(test), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		version := getVersion()
+		updater := NewUpdater(UpdaterOptions{
+			CacheDir: filepath.Join(dir, "cache"),
+			Source:   NewDirectorySource(dir),
+			Logger:   testLogger{t},
+		})
+		if err := updater.UpdateNow(context.Background()); err == nil {
+			t.Errorf("[test %d:update] failed: expected error\n", i)
+		}
+		if getVersion() != version {
+			t.Errorf("[test %d:version] failed: %s != %s\n", i, getVersion(), version)
+		}
+	}
+}
+
+func TestUpdaterUnchangedSource(t *testing.T) {
+	archive := testTarGz(t, "libphonenumber-master/resources/")
+	modified := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	gets := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+		}
+		http.ServeContent(w, r, "master.tar.gz", modified, bytes.NewReader(archive))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	source := NewArchiveSource(server.URL + "/master.tar.gz")
+	version, err := source.(metadataSourceVersioner).version(ctx)
+	if err != nil || version == "" {
+		t.Fatalf("[test version] failed: %q, %v\n", version, err)
+	}
+
+	// metadata built from the archive as it is now isn't fetched again
+	original := CurrentMetadata()
+	defer original.store()
+	metadata := *original
+	raw := *original.raw
+	raw.SourceVersion = version
+	metadata.raw = &raw
+	metadata.store()
+
+	updated := false
+	updater := NewUpdater(UpdaterOptions{
+		CacheDir: filepath.Join(dir, "cache"),
+		Source:   source,
+		Logger:   testLogger{t},
+		OnUpdate: func(string) { updated = true },
+	})
+	if err := updater.UpdateNow(ctx); err != nil || gets != 0 || updated {
+		t.Errorf("[test unchanged] failed: %v, %d gets, updated %v\n", err, gets, updated)
+	}
+	if status := updater.Status(); status.LastSuccess.IsZero() || status.LastError != nil {
+		t.Errorf("[test status] failed: %v\n", status)
+	}
+
+	// but is once it changes
+	modified = modified.Add(time.Hour)
+	updater.UpdateNow(ctx)
+	if gets != 1 {
+		t.Errorf("[test changed] failed: %d gets != 1\n", gets)
+	}
+}
+
+func TestUpdaterUnwritableCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestResources(t, dir)
+
+	// a directory where the cache file should be can't be written
+	cacheDir := filepath.Join(dir, "cache")
+	if err := os.MkdirAll(filepath.Join(cacheDir, metadataCacheFilename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	original := CurrentMetadata()
+	defer original.store()
+	updater := NewUpdater(UpdaterOptions{
+		CacheDir: cacheDir,
+		Source:   NewDirectorySource(dir),
+		Logger:   testLogger{t},
+	})
+	if err := updater.UpdateNow(context.Background()); err == nil {
+		t.Errorf("[test update] failed: expected error\n")
+	}
+
+	// the metadata in use before is still in use
+	if CurrentMetadata() != original {
+		t.Errorf("[test current] failed: metadata replaced by version %s\n", getVersion())
+	}
+	if status := updater.Status(); !status.LastSuccess.IsZero() || status.LastError == nil || status.Version != original.Version() {
+		t.Errorf("[test status] failed: %+v\n", status)
+	}
+}
+
+// a source whose reads block until they are cancelled
+type blockingSource struct {
+	reading chan struct{}
+}
+
+func (s blockingSource) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	s.reading <- struct{}{}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s blockingSource) ReadDir(ctx context.Context, path string) ([]string, error) {
+	return nil, errors.New("not a directory")
+}
+
+func TestUpdaterCancelled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phonenumbers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := blockingSource{make(chan struct{})}
+	updater := NewUpdater(UpdaterOptions{
+		CacheDir: dir,
+		Source:   source,
+		Logger:   testLogger{t},
+		OnError:  func(err error) { t.Errorf("unexpected error: %v", err) },
+	})
+
+	// stopping cancels the update in progress, which isn't a failure
+	if err := updater.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	<-source.reading
+	updater.Stop()
+	if status := updater.Status(); status.LastError != nil {
+		t.Errorf("[test stop] failed: %v\n", status.LastError)
+	}
+
+	// cancelling the context stops the updater so it can be started again
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := updater.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-source.reading
+	cancel()
+	deadline := time.Now().Add(time.Minute)
+	for {
+		err := updater.Start(context.Background())
+		if err == nil {
+			break
+		}
+		if err != ErrUpdaterStarted || time.Now().After(deadline) {
+			t.Fatalf("[test restart] failed: %v\n", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	<-source.reading
+	updater.Stop()
+	if status := updater.Status(); status.LastError != nil {
+		t.Errorf("[test cancel] failed: %v\n", status.LastError)
+	}
+}
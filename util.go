@@ -0,0 +1,183 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"regexp"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// PrefixData is the carrier, geocoding and timezone data a Util can be
+// built with. Prefixes start with the country calling code, such as 1212
+// for numbers in New York.
+type PrefixData struct {
+	// Carriers maps languages to prefixes to carrier names
+	Carriers map[string]map[int]string
+
+	// Geocodings maps languages to prefixes to the names of the places
+	// numbers with them are in
+	Geocodings map[string]map[int]string
+
+	// Timezones maps prefixes to the timezones numbers with them are in
+	Timezones map[int][]string
+}
+
+// LoadPrefixData reads the carrier, geocoding and timezone data of the
+// passed in source, such as for a Util or to compare with DiffPrefixData.
+func LoadPrefixData(ctx context.Context, source MetadataSource) (*PrefixData, error) {
+	logger := log.New(ioutil.Discard, "", 0)
+
+	timezones, err := readTimezones(ctx, source, logger)
+	if err != nil {
+		return nil, err
+	}
+	carriers, err := readPrefixData(ctx, source, &carrier, logger)
+	if err != nil {
+		return nil, err
+	}
+	geocodings, err := readPrefixData(ctx, source, &geocoding, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &PrefixData{Carriers: carriers, Geocodings: geocodings, Timezones: timezones}, nil
+}
+
+// PrefixData returns the carrier, geocoding and timezone data of this
+// metadata, decoding all of it, which for the built in data takes a few
+// seconds. Data left out with build tags is empty.
+func (md *Metadata) PrefixData() (*PrefixData, error) {
+	carriers, err := decodePrefixMaps(md.raw.CarrierMapData)
+	if err != nil {
+		return nil, err
+	}
+	geocodings, err := decodePrefixMaps(md.raw.GeocodingMapData)
+	if err != nil {
+		return nil, err
+	}
+	return &PrefixData{Carriers: carriers, Geocodings: geocodings, Timezones: md.timezoneMap.Map}, nil
+}
+
+// Util parses, formats, validates and looks up numbers using the metadata
+// it was built with, through the methods of its Metadata. Several can be
+// used side by side, such as one with the current metadata and one with a
+// candidate version, or one with test metadata in unit tests.
+type Util struct {
+	*Metadata
+}
+
+// NewUtil returns a Util using the passed in metadata, such as that
+// returned by BuildPhoneMetadataCollection, and the passed in prefix data
+// if it isn't nil. Without prefix data, carrier, geocoding and timezone
+// lookups return no results. The alternate formats and short number
+// metadata are those built in to the package. Returns an error if the
+// metadata is empty or any of its patterns are invalid.
+func NewUtil(collection *PhoneMetadataCollection, prefixData *PrefixData) (*Util, error) {
+	if len(collection.GetMetadata()) == 0 {
+		return nil, ErrEmptyMetadata
+	}
+	if err := checkMetadataPatterns(collection); err != nil {
+		return nil, err
+	}
+	if prefixData == nil {
+		prefixData = &PrefixData{}
+	}
+
+	data, err := proto.Marshal(collection)
+	if err != nil {
+		return nil, err
+	}
+	regionMapData, err := buildRegions(collection)
+	if err != nil {
+		return nil, err
+	}
+	timezoneMapData, err := intStringArrayMapToString(prefixData.Timezones)
+	if err != nil {
+		return nil, err
+	}
+	carrierMapData, err := prefixMapsToStrings(prefixData.Carriers)
+	if err != nil {
+		return nil, err
+	}
+	geocodingMapData, err := prefixMapsToStrings(prefixData.Geocodings)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := loadMetadata(&metadataRaw{
+		MetadataData:            gzipBytesAndBase64(data),
+		AlternateFormatsData:    getAlternateFormatData(),
+		ShortNumberMetadataData: getShortNumberMetadataData(),
+		RegionMapData:           regionMapData,
+		TimezoneMapData:         timezoneMapData,
+		CarrierMapData:          carrierMapData,
+		GeocodingMapData:        geocodingMapData,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Util{metadata}, nil
+}
+
+// DefaultUtil returns a Util using the metadata in use, which is what the
+// package level functions delegate to.
+func DefaultUtil() *Util {
+	return &Util{CurrentMetadata()}
+}
+
+// Encodes the passed in prefix maps of each language.
+func prefixMapsToStrings(maps map[string]map[int]string) (map[string]string, error) {
+	data := make(map[string]string, len(maps))
+	for lang, mappings := range maps {
+		encoded, err := intStringMapToString(mappings)
+		if err != nil {
+			return nil, err
+		}
+		data[lang] = encoded
+	}
+	return data, nil
+}
+
+// Decodes the passed in prefix maps of each language.
+func decodePrefixMaps(data map[string]string) (map[string]map[int]string, error) {
+	maps := make(map[string]map[int]string, len(data))
+	for lang, encoded := range data {
+		prefixMap, err := loadPrefixMap(encoded)
+		if err != nil {
+			return nil, err
+		}
+		maps[lang] = prefixMap.Map
+	}
+	return maps, nil
+}
+
+// Returns an error for the first pattern of the passed in metadata which
+// isn't a valid regular expression, as those would panic when used.
+func checkMetadataPatterns(collection *PhoneMetadataCollection) error {
+	for _, metadata := range collection.GetMetadata() {
+		patterns := []string{
+			metadata.GetGeneralDesc().GetNationalNumberPattern(),
+			metadata.GetInternationalPrefix(),
+			metadata.GetNationalPrefixForParsing(),
+			metadata.GetLeadingDigits(),
+		}
+		for _, numberType := range EXAMPLE_NUMBER_TYPES {
+			patterns = append(patterns, getNumberDescByType(metadata, numberType).GetNationalNumberPattern())
+		}
+		for _, formats := range [][]*NumberFormat{metadata.GetNumberFormat(), metadata.GetIntlNumberFormat()} {
+			for _, format := range formats {
+				patterns = append(patterns, format.GetPattern())
+				patterns = append(patterns, format.GetLeadingDigitsPattern()...)
+			}
+		}
+
+		for _, pattern := range patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid pattern in metadata for %s: %s", metadata.GetId(), err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package phonenumbers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestNewUtil(t *testing.T) {
+	// metadata with only GB in it
+	collection := &PhoneMetadataCollection{}
+	for _, metadata := range getCurrMetadataColl().GetMetadata() {
+		if metadata.GetId() == "GB" {
+			collection.Metadata = append(collection.Metadata, metadata)
+		}
+	}
+	util, err := NewUtil(collection, &PrefixData{
+		Carriers:  map[string]map[int]string{"en": {447400: "Test Mobile"}},
+		Timezones: map[int][]string{44: {"Europe/London"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		num       string
+		valid     bool
+		numType   PhoneNumberType
+		formatted string
+		carrier   string
+		timezones []string
+	}{
+		{"+447400123456", true, MOBILE, "07400 123456", "Test Mobile", []string{"Europe/London"}},
+		{"+442071838750", true, FIXED_LINE, "020 7183 8750", "", []string{"Europe/London"}},
+	}
+
+	for i, test := range tests {
+		num, err := util.Parse(test.num, "")
+		if err != nil {
+			t.Errorf("[test %d:parse] failed: %v\n", i, err)
+			continue
+		}
+		if valid := util.IsValidNumber(num); valid != test.valid {
+			t.Errorf("[test %d:valid] failed: %v != %v\n", i, valid, test.valid)
+		}
+		if numType := util.GetNumberType(num); numType != test.numType {
+			t.Errorf("[test %d:type] failed: %v != %v\n", i, numType, test.numType)
+		}
+		if formatted := util.Format(num, NATIONAL); formatted != test.formatted {
+			t.Errorf("[test %d:format] failed: %s != %s\n", i, formatted, test.formatted)
+		}
+		if carrier, err := util.GetCarrierForNumber(num, "en"); err != nil || carrier != test.carrier {
+			t.Errorf("[test %d:carrier] failed: %s, %v != %s\n", i, carrier, err, test.carrier)
+		}
+		if timezones, err := util.GetTimezonesForNumber(num); err != nil || !reflect.DeepEqual(timezones, test.timezones) {
+			t.Errorf("[test %d:timezones] failed: %v, %v != %v\n", i, timezones, err, test.timezones)
+		}
+	}
+
+	// US numbers are unknown to it
+	if _, err := util.Parse("+12015550123", ""); !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("[test unknown] failed: %v != %v\n", err, ErrInvalidCountryCode)
+	}
+
+	// while the package level functions still use the metadata in use
+	num, err := Parse("+12015550123", "")
+	if err != nil || !IsValidNumber(num) {
+		t.Errorf("[test default] failed: %v\n", err)
+	}
+	if DefaultUtil().Metadata != CurrentMetadata() {
+		t.Errorf("[test default util] failed: not using the metadata in use\n")
+	}
+}
+
+func TestNewUtilErrors(t *testing.T) {
+	if _, err := NewUtil(&PhoneMetadataCollection{}, nil); err != ErrEmptyMetadata {
+		t.Errorf("[test empty] failed: %v != %v\n", err, ErrEmptyMetadata)
+	}
+
+	invalid := &PhoneMetadataCollection{}
+	for _, metadata := range getCurrMetadataColl().GetMetadata() {
+		if metadata.GetId() == "GB" {
+			metadata = proto.Clone(metadata).(*PhoneMetadata)
+			metadata.Mobile.NationalNumberPattern = proto.String("7(")
+			invalid.Metadata = append(invalid.Metadata, metadata)
+		}
+	}
+	if _, err := NewUtil(invalid, nil); err == nil {
+		t.Errorf("[test invalid pattern] failed: expected error\n")
+	}
+}